@@ -45,16 +45,29 @@ func (c *cartClient) AddToCart(product_id int, user_id int, quantity int) (model
 	var carts []models.Cart
 	for _, cartDetails := range res.Cart {
 		cart := models.Cart{
-			ProductID:  uint(cartDetails.ProductID),
-			Quantity:   float64(cartDetails.Quantity),
-			TotalPrice: float64(cartDetails.TotalPrice),
+			ProductID:    uint(cartDetails.ProductID),
+			Quantity:     float64(cartDetails.Quantity),
+			TotalPrice:   float64(cartDetails.TotalPrice),
+			Discount:     float64(cartDetails.Discount),
+			FinalPrice:   float64(cartDetails.FinalPrice),
+			Offer:        cartDetails.Offer,
+			UnitPrice:    float64(cartDetails.UnitPrice),
+			AddedPrice:   float64(cartDetails.AddedPrice),
+			PriceChanged: cartDetails.PriceChanged,
+			HeldUntil:    cartDetails.HeldUntil,
 		}
 		carts = append(carts, cart)
 	}
 
 	return models.CartResponse{
-		TotalPrice: float64(res.Price),
-		Cart:       carts,
+		TotalPrice:    float64(res.Price),
+		OfferDiscount: float64(res.OfferDiscount),
+		Discount:      float64(res.Discount),
+		FinalPrice:    float64(res.FinalPrice),
+		CouponCode:    res.CouponCode,
+		CouponNotice:  res.CouponNotice,
+		PriceNotices:  res.PriceNotices,
+		Cart:          carts,
 	}, nil
 }
 func (c *cartClient) GetCart(user_id int) (models.CartResponse, error) {
@@ -70,15 +83,88 @@ func (c *cartClient) GetCart(user_id int) (models.CartResponse, error) {
 	var carts []models.Cart
 	for _, cartDetails := range res.Cart {
 		cart := models.Cart{
-			ProductID:  uint(cartDetails.ProductID),
-			Quantity:   float64(cartDetails.Quantity),
-			TotalPrice: float64(cartDetails.TotalPrice),
+			ProductID:    uint(cartDetails.ProductID),
+			Quantity:     float64(cartDetails.Quantity),
+			TotalPrice:   float64(cartDetails.TotalPrice),
+			Discount:     float64(cartDetails.Discount),
+			FinalPrice:   float64(cartDetails.FinalPrice),
+			Offer:        cartDetails.Offer,
+			UnitPrice:    float64(cartDetails.UnitPrice),
+			AddedPrice:   float64(cartDetails.AddedPrice),
+			PriceChanged: cartDetails.PriceChanged,
+			HeldUntil:    cartDetails.HeldUntil,
 		}
 		carts = append(carts, cart)
 	}
 
 	return models.CartResponse{
-		TotalPrice: float64(res.Price),
-		Cart:       carts,
+		TotalPrice:    float64(res.Price),
+		OfferDiscount: float64(res.OfferDiscount),
+		Discount:      float64(res.Discount),
+		FinalPrice:    float64(res.FinalPrice),
+		CouponCode:    res.CouponCode,
+		CouponNotice:  res.CouponNotice,
+		PriceNotices:  res.PriceNotices,
+		Cart:          carts,
 	}, nil
 }
+
+func (c *cartClient) UpdateCartItemQuantity(userID, productID, quantity int) (models.CartResponse, error) {
+	res, err := c.Client.UpdateCartItemQuantity(context.Background(), &pb.UpdateCartItemQuantityRequest{
+		UserID:    int64(userID),
+		ProductID: int64(productID),
+		Quantity:  int64(quantity),
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func (c *cartClient) RemoveFromCart(userID, productID int) (models.CartResponse, error) {
+	res, err := c.Client.RemoveFromCart(context.Background(), &pb.RemoveFromCartRequest{
+		UserID:    int64(userID),
+		ProductID: int64(productID),
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+// ClearCart removes every product from the user's cart.
+func (c *cartClient) ClearCart(userID int) error {
+	_, err := c.Client.ClearCart(context.Background(), &pb.ClearCartRequest{UserID: int64(userID)})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
+func cartFromProto(res *pb.GetCartResponse) models.CartResponse {
+	var carts []models.Cart
+	for _, cartDetails := range res.Cart {
+		carts = append(carts, models.Cart{
+			ProductID:    uint(cartDetails.ProductID),
+			Quantity:     float64(cartDetails.Quantity),
+			TotalPrice:   float64(cartDetails.TotalPrice),
+			Discount:     float64(cartDetails.Discount),
+			FinalPrice:   float64(cartDetails.FinalPrice),
+			Offer:        cartDetails.Offer,
+			UnitPrice:    float64(cartDetails.UnitPrice),
+			AddedPrice:   float64(cartDetails.AddedPrice),
+			PriceChanged: cartDetails.PriceChanged,
+			HeldUntil:    cartDetails.HeldUntil,
+		})
+	}
+	return models.CartResponse{
+		TotalPrice:    float64(res.Price),
+		OfferDiscount: float64(res.OfferDiscount),
+		Discount:      float64(res.Discount),
+		FinalPrice:    float64(res.FinalPrice),
+		CouponCode:    res.CouponCode,
+		CouponNotice:  res.CouponNotice,
+		PriceNotices:  res.PriceNotices,
+		Cart:          carts,
+	}
+}
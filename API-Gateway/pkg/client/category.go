@@ -0,0 +1,83 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/product"
+	"api-gateway/pkg/utils/models"
+	"context"
+)
+
+func (c *productClient) AddCategory(category models.CategoryInput) (models.Category, error) {
+	res, err := c.Client.AddCategory(context.Background(), &pb.CategoryRequest{
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    int64(category.ParentID),
+	})
+	if err != nil {
+		return models.Category{}, handleGrpcError(err)
+	}
+	return categoryFromProto(res.Category), nil
+}
+
+func (c *productClient) UpdateCategory(id int, category models.CategoryInput) (models.Category, error) {
+	res, err := c.Client.UpdateCategory(context.Background(), &pb.CategoryRequest{
+		ID:          int64(id),
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    int64(category.ParentID),
+	})
+	if err != nil {
+		return models.Category{}, handleGrpcError(err)
+	}
+	return categoryFromProto(res.Category), nil
+}
+
+func (c *productClient) DeleteCategory(id int) error {
+	_, err := c.Client.DeleteCategory(context.Background(), &pb.DeleteCategoryRequest{ID: int64(id)})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
+func (c *productClient) ListCategories() ([]models.Category, error) {
+	res, err := c.Client.ListCategories(context.Background(), &pb.ListCategoriesRequest{})
+	if err != nil {
+		return []models.Category{}, handleGrpcError(err)
+	}
+	result := []models.Category{}
+	for _, category := range res.Categories {
+		result = append(result, categoryFromProto(category))
+	}
+	return result, nil
+}
+
+func (c *productClient) GetCategoryTree() ([]models.CategoryNode, error) {
+	res, err := c.Client.GetCategoryTree(context.Background(), &pb.ListCategoriesRequest{})
+	if err != nil {
+		return []models.CategoryNode{}, handleGrpcError(err)
+	}
+	return categoryNodesFromProto(res.Categories), nil
+}
+
+func categoryFromProto(c *pb.Category) models.Category {
+	return models.Category{
+		ID:          int(c.GetID()),
+		Name:        c.GetName(),
+		Slug:        c.GetSlug(),
+		Description: c.GetDescription(),
+		ParentID:    int(c.GetParentID()),
+	}
+}
+
+func categoryNodesFromProto(nodes []*pb.CategoryNode) []models.CategoryNode {
+	result := []models.CategoryNode{}
+	for _, n := range nodes {
+		result = append(result, models.CategoryNode{
+			Category: categoryFromProto(n.Category),
+			Children: categoryNodesFromProto(n.Children),
+		})
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/cart"
+	"api-gateway/pkg/utils/models"
+	"context"
+	"time"
+)
+
+func (c *cartClient) AddCoupon(coupon models.CouponInput, actor string) (models.Coupon, error) {
+	res, err := c.Client.AddCoupon(context.Background(), &pb.AddCouponRequest{
+		Coupon: couponToProto(coupon),
+		Actor:  actor,
+	})
+	if err != nil {
+		return models.Coupon{}, handleGrpcError(err)
+	}
+	return couponFromProto(res.Coupon), nil
+}
+
+func (c *cartClient) UpdateCoupon(id int, coupon models.CouponInput) (models.Coupon, error) {
+	res, err := c.Client.UpdateCoupon(context.Background(), &pb.UpdateCouponRequest{
+		ID:     int64(id),
+		Coupon: couponToProto(coupon),
+	})
+	if err != nil {
+		return models.Coupon{}, handleGrpcError(err)
+	}
+	return couponFromProto(res.Coupon), nil
+}
+
+func (c *cartClient) ListCoupons(page, count int) ([]models.Coupon, error) {
+	res, err := c.Client.ListCoupons(context.Background(), &pb.ListCouponsRequest{
+		Page:  int64(page),
+		Count: int64(count),
+	})
+	if err != nil {
+		return []models.Coupon{}, handleGrpcError(err)
+	}
+	coupons := []models.Coupon{}
+	for _, coupon := range res.Coupons {
+		coupons = append(coupons, couponFromProto(coupon))
+	}
+	return coupons, nil
+}
+
+func (c *cartClient) DeleteCoupon(id int) error {
+	_, err := c.Client.DeleteCoupon(context.Background(), &pb.DeleteCouponRequest{ID: int64(id)})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
+func (c *cartClient) ApplyCoupon(userID int, code string) (models.CartResponse, error) {
+	res, err := c.Client.ApplyCoupon(context.Background(), &pb.ApplyCouponRequest{
+		UserID: int64(userID),
+		Code:   code,
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func (c *cartClient) RemoveCoupon(userID int) (models.CartResponse, error) {
+	res, err := c.Client.RemoveCoupon(context.Background(), &pb.RemoveCouponRequest{UserID: int64(userID)})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func couponToProto(coupon models.CouponInput) *pb.Coupon {
+	req := &pb.Coupon{
+		Code:          coupon.Code,
+		Description:   coupon.Description,
+		DiscountType:  coupon.DiscountType,
+		DiscountValue: float32(coupon.DiscountValue),
+		MinCartValue:  float32(coupon.MinCartValue),
+		MaxDiscount:   float32(coupon.MaxDiscount),
+		UsageLimit:    int64(coupon.UsageLimit),
+		PerUserLimit:  int64(coupon.PerUserLimit),
+		Active:        coupon.Active == nil || *coupon.Active,
+	}
+	if coupon.ValidFrom != nil {
+		req.ValidFrom = coupon.ValidFrom.Format(time.RFC3339)
+	}
+	if coupon.ValidUntil != nil {
+		req.ValidUntil = coupon.ValidUntil.Format(time.RFC3339)
+	}
+	return req
+}
+
+func couponFromProto(c *pb.Coupon) models.Coupon {
+	return models.Coupon{
+		ID:            int(c.GetID()),
+		Code:          c.GetCode(),
+		Description:   c.GetDescription(),
+		DiscountType:  c.GetDiscountType(),
+		DiscountValue: float64(c.GetDiscountValue()),
+		MinCartValue:  float64(c.GetMinCartValue()),
+		MaxDiscount:   float64(c.GetMaxDiscount()),
+		ValidFrom:     c.GetValidFrom(),
+		ValidUntil:    c.GetValidUntil(),
+		UsageLimit:    int(c.GetUsageLimit()),
+		PerUserLimit:  int(c.GetPerUserLimit()),
+		Active:        c.GetActive(),
+		CreatedBy:     c.GetCreatedBy(),
+	}
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/cart"
+	"api-gateway/pkg/utils/models"
+	"context"
+)
+
+func (c *cartClient) AddToGuestCart(guestID string, productID, quantity int) (models.CartResponse, error) {
+	res, err := c.Client.AddToGuestCart(context.Background(), &pb.GuestCartRequest{
+		GuestID:   guestID,
+		ProductID: int64(productID),
+		Quantity:  int64(quantity),
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func (c *cartClient) UpdateGuestCartItemQuantity(guestID string, productID, quantity int) (models.CartResponse, error) {
+	res, err := c.Client.UpdateGuestCartItemQuantity(context.Background(), &pb.GuestCartRequest{
+		GuestID:   guestID,
+		ProductID: int64(productID),
+		Quantity:  int64(quantity),
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func (c *cartClient) RemoveFromGuestCart(guestID string, productID int) (models.CartResponse, error) {
+	res, err := c.Client.RemoveFromGuestCart(context.Background(), &pb.GuestCartRequest{
+		GuestID:   guestID,
+		ProductID: int64(productID),
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func (c *cartClient) GetGuestCart(guestID string) (models.CartResponse, error) {
+	res, err := c.Client.GetGuestCart(context.Background(), &pb.GuestCartRequest{GuestID: guestID})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+// MergeGuestCart moves a guest cart into the user's cart. The notices name the
+// products whose quantities had to be lowered or left out.
+func (c *cartClient) MergeGuestCart(guestID string, userID int) (models.CartResponse, []string, error) {
+	res, err := c.Client.MergeGuestCart(context.Background(), &pb.MergeGuestCartRequest{
+		GuestID: guestID,
+		UserID:  int64(userID),
+	})
+	if err != nil {
+		return models.CartResponse{}, nil, handleGrpcError(err)
+	}
+	return cartFromProto(res.Cart), res.Notices, nil
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/product"
+	"api-gateway/pkg/utils/models"
+	"context"
+)
+
+func (c *productClient) UploadProductImage(productID int, data []byte, primary bool) (models.ProductImage, error) {
+	res, err := c.Client.UploadProductImage(context.Background(), &pb.UploadProductImageRequest{
+		ProductID: int64(productID),
+		Data:      data,
+		Primary:   primary,
+	})
+	if err != nil {
+		return models.ProductImage{}, handleGrpcError(err)
+	}
+	return productImage(res.Image), nil
+}
+
+func (c *productClient) ListProductImages(productID int) ([]models.ProductImage, error) {
+	res, err := c.Client.ListProductImages(context.Background(), &pb.ProductIDRequest{ID: int64(productID)})
+	if err != nil {
+		return []models.ProductImage{}, handleGrpcError(err)
+	}
+	return productImages(res.Images), nil
+}
+
+func (c *productClient) SetPrimaryImage(productID, imageID int) ([]models.ProductImage, error) {
+	res, err := c.Client.SetPrimaryImage(context.Background(), &pb.ProductImageRequest{
+		ProductID: int64(productID),
+		ImageID:   int64(imageID),
+	})
+	if err != nil {
+		return []models.ProductImage{}, handleGrpcError(err)
+	}
+	return productImages(res.Images), nil
+}
+
+func (c *productClient) ReorderProductImages(productID int, imageIDs []int) ([]models.ProductImage, error) {
+	ids := make([]int64, 0, len(imageIDs))
+	for _, id := range imageIDs {
+		ids = append(ids, int64(id))
+	}
+	res, err := c.Client.ReorderProductImages(context.Background(), &pb.ReorderProductImagesRequest{
+		ProductID: int64(productID),
+		ImageIDs:  ids,
+	})
+	if err != nil {
+		return []models.ProductImage{}, handleGrpcError(err)
+	}
+	return productImages(res.Images), nil
+}
+
+func (c *productClient) DeleteProductImage(productID, imageID int) ([]models.ProductImage, error) {
+	res, err := c.Client.DeleteProductImage(context.Background(), &pb.ProductImageRequest{
+		ProductID: int64(productID),
+		ImageID:   int64(imageID),
+	})
+	if err != nil {
+		return []models.ProductImage{}, handleGrpcError(err)
+	}
+	return productImages(res.Images), nil
+}
+
+func productImage(i *pb.ProductImage) models.ProductImage {
+	return models.ProductImage{
+		ID:           int(i.GetID()),
+		ProductID:    int(i.GetProductID()),
+		URL:          i.GetURL(),
+		ThumbnailURL: i.GetThumbnailURL(),
+		Position:     int(i.GetPosition()),
+		IsPrimary:    i.GetIsPrimary(),
+	}
+}
+
+func productImages(images []*pb.ProductImage) []models.ProductImage {
+	result := []models.ProductImage{}
+	for _, i := range images {
+		result = append(result, productImage(i))
+	}
+	return result
+}
@@ -5,4 +5,26 @@ import "api-gateway/pkg/utils/models"
 type CartClient interface {
 	AddToCart(product_id, user_id, quantity int) (models.CartResponse, error)
 	GetCart(user_id int) (models.CartResponse, error)
+	UpdateCartItemQuantity(userID, productID, quantity int) (models.CartResponse, error)
+	RemoveFromCart(userID, productID int) (models.CartResponse, error)
+	ClearCart(userID int) error
+
+	AddToGuestCart(guestID string, productID, quantity int) (models.CartResponse, error)
+	UpdateGuestCartItemQuantity(guestID string, productID, quantity int) (models.CartResponse, error)
+	RemoveFromGuestCart(guestID string, productID int) (models.CartResponse, error)
+	GetGuestCart(guestID string) (models.CartResponse, error)
+	MergeGuestCart(guestID string, userID int) (models.CartResponse, []string, error)
+
+	AddToWishlist(userID, productID int) (models.WishlistItem, error)
+	RemoveFromWishlist(userID, productID int) error
+	ListWishlist(userID, page, count int) ([]models.WishlistItem, error)
+	MoveToCart(userID, productID, quantity int) (models.CartResponse, error)
+	SaveForLater(userID, productID int) (models.WishlistItem, error)
+
+	AddCoupon(coupon models.CouponInput, actor string) (models.Coupon, error)
+	UpdateCoupon(id int, coupon models.CouponInput) (models.Coupon, error)
+	ListCoupons(page, count int) ([]models.Coupon, error)
+	DeleteCoupon(id int) error
+	ApplyCoupon(userID int, code string) (models.CartResponse, error)
+	RemoveCoupon(userID int) (models.CartResponse, error)
 }
@@ -5,4 +5,16 @@ import "api-gateway/pkg/utils/models"
 type OrderClient interface {
 	OrderItemsFromCart(orderFromCart models.OrderFromCart, userID int) (models.OrderSuccessResponse, error)
 	GetOrderDetails(userId int, page int, count int) ([]models.FullOrderDetails, error)
+	UpdateOrderStatus(orderID int, status string, actor string) (models.OrderSuccessResponse, error)
+	GetOrderTimeline(orderID int, userID int) (models.OrderTimeline, error)
+	CancelOrder(orderID int, userID int) (models.OrderSuccessResponse, error)
+	RequestReturn(orderID int, userID int, request models.ReturnRequest) (models.ReturnRequestDetails, error)
+	ListReturnRequests(userID int, status string, page int, count int) ([]models.ReturnRequestDetails, error)
+	ReviewReturnRequest(returnID int, approve bool, actor string, note string) (models.ReturnRequestDetails, error)
+	PayOrder(orderID int, userID int, paymentID int) (models.Payment, error)
+	GetOrderPayments(orderID int, userID int) ([]models.Payment, error)
+	HandlePaymentWebhook(provider string, payload []byte, signature string) (models.Payment, error)
+	SimulatePayment(paymentID int, outcome string) (models.PaymentWebhook, error)
+	GetWallet(userID int) (models.Wallet, error)
+	ListWalletTransactions(userID int, page int, count int) ([]models.WalletTransaction, error)
 }
@@ -3,8 +3,31 @@ package interfaces
 import "api-gateway/pkg/utils/models"
 
 type ProductClient interface {
-	ShowAllProducts(page int, count int) ([]models.ProductBrief, error)
+	ShowAllProducts(page int, count int, filter models.ProductFilter) (models.ProductListing, error)
 	AddProducts(product models.Product) (models.Products, error)
 	DeleteProduct(id int) error
-	UpdateProducts(pid int, stock int) (models.ProductUpdateReciever, error)
+	GetProduct(id int) (models.Products, error)
+	RestoreProduct(id int) (models.Products, error)
+	UpdateProducts(pid int, stock int, actor string) (models.ProductUpdateReciever, error)
+	PatchProduct(id int, patch models.ProductPatch, paths []string, actor string) (models.Products, error)
+	ListStockMovements(productID int, page int, count int) ([]models.StockMovement, error)
+	ReconcileStock(productID int, fix bool, actor string) ([]models.StockReconciliation, error)
+	UploadProductImage(productID int, data []byte, primary bool) (models.ProductImage, error)
+	ListProductImages(productID int) ([]models.ProductImage, error)
+	SetPrimaryImage(productID, imageID int) ([]models.ProductImage, error)
+	ReorderProductImages(productID int, imageIDs []int) ([]models.ProductImage, error)
+	DeleteProductImage(productID, imageID int) ([]models.ProductImage, error)
+	SchedulePriceChange(productID int, input models.PriceScheduleInput, actor string) (models.PriceSchedule, error)
+	CancelPriceSchedule(id int, actor string) (models.PriceSchedule, error)
+	ListPriceSchedules(productID int) ([]models.PriceSchedule, error)
+	ListPriceHistory(productID int, page int, count int) ([]models.PriceChange, error)
+	AddOffer(offer models.OfferInput, actor string) (models.Offer, error)
+	UpdateOffer(id int, offer models.OfferInput) (models.Offer, error)
+	ListOffers(page int, count int) ([]models.Offer, error)
+	DeleteOffer(id int) error
+	AddCategory(category models.CategoryInput) (models.Category, error)
+	UpdateCategory(id int, category models.CategoryInput) (models.Category, error)
+	DeleteCategory(id int) error
+	ListCategories() ([]models.Category, error)
+	GetCategoryTree() ([]models.CategoryNode, error)
 }
@@ -0,0 +1,13 @@
+package interfaces
+
+import "time"
+
+// RevocationClient keeps track of access tokens revoked before they expired.
+// Single tokens are revoked by their jti claim, and all tokens of an account
+// can be revoked at once. The revocations are stored by the user service, so
+// every gateway instance sees them.
+type RevocationClient interface {
+	RevokeToken(tokenID string, expiresAt time.Time) error
+	RevokeAccount(account string, tokenLifetime time.Duration) error
+	IsRevoked(tokenID string, account string, issuedAt int64) (bool, error)
+}
@@ -5,6 +5,9 @@ import "api-gateway/pkg/utils/models"
 type UserClient interface {
 	UsersSignUp(user models.UserSignUp) (models.TokenUser, error)
 	UserLogin(user models.UserLogin) (models.TokenUser, error)
+	RefreshToken(refreshToken string) (models.TokenPair, error)
+	RevokeRefreshToken(refreshToken string) error
+	RevokeUserSessions(userID int) error
 
 	AddAddress(address models.Address) (models.Address, error)
 	GetAddress(id uint) (models.Address, error)
@@ -0,0 +1,86 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/product"
+	"api-gateway/pkg/utils/models"
+	"context"
+	"time"
+)
+
+func (c *productClient) AddOffer(offer models.OfferInput, actor string) (models.Offer, error) {
+	res, err := c.Client.AddOffer(context.Background(), &pb.AddOfferRequest{
+		Offer: offerToProto(offer),
+		Actor: actor,
+	})
+	if err != nil {
+		return models.Offer{}, handleGrpcError(err)
+	}
+	return offerFromProto(res.Offer), nil
+}
+
+func (c *productClient) UpdateOffer(id int, offer models.OfferInput) (models.Offer, error) {
+	res, err := c.Client.UpdateOffer(context.Background(), &pb.UpdateOfferRequest{
+		ID:    int64(id),
+		Offer: offerToProto(offer),
+	})
+	if err != nil {
+		return models.Offer{}, handleGrpcError(err)
+	}
+	return offerFromProto(res.Offer), nil
+}
+
+func (c *productClient) ListOffers(page int, count int) ([]models.Offer, error) {
+	res, err := c.Client.ListOffers(context.Background(), &pb.ListOffersRequest{
+		Page:  int64(page),
+		Count: int64(count),
+	})
+	if err != nil {
+		return []models.Offer{}, handleGrpcError(err)
+	}
+	offers := []models.Offer{}
+	for _, o := range res.Offers {
+		offers = append(offers, offerFromProto(o))
+	}
+	return offers, nil
+}
+
+func (c *productClient) DeleteOffer(id int) error {
+	_, err := c.Client.DeleteOffer(context.Background(), &pb.DeleteOfferRequest{ID: int64(id)})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
+func offerToProto(offer models.OfferInput) *pb.Offer {
+	req := &pb.Offer{
+		Name:          offer.Name,
+		ProductID:     int64(offer.ProductID),
+		CategoryID:    int64(offer.CategoryID),
+		DiscountType:  offer.DiscountType,
+		DiscountValue: float32(offer.DiscountValue),
+		Active:        offer.Active == nil || *offer.Active,
+	}
+	if offer.ValidFrom != nil {
+		req.ValidFrom = offer.ValidFrom.Format(time.RFC3339)
+	}
+	if offer.ValidUntil != nil {
+		req.ValidUntil = offer.ValidUntil.Format(time.RFC3339)
+	}
+	return req
+}
+
+func offerFromProto(o *pb.Offer) models.Offer {
+	return models.Offer{
+		ID:            int(o.GetID()),
+		Name:          o.GetName(),
+		ProductID:     int(o.GetProductID()),
+		CategoryID:    int(o.GetCategoryID()),
+		DiscountType:  o.GetDiscountType(),
+		DiscountValue: float64(o.GetDiscountValue()),
+		ValidFrom:     o.GetValidFrom(),
+		ValidUntil:    o.GetValidUntil(),
+		Active:        o.GetActive(),
+		CreatedBy:     o.GetCreatedBy(),
+	}
+}
@@ -37,15 +37,21 @@ func (c *orderClient) OrderItemsFromCart(orderFromCart models.OrderFromCart, use
 		UserID:        int64(userID),
 	})
 	if err != nil {
-		return models.OrderSuccessResponse{}, err
+		return models.OrderSuccessResponse{}, handleGrpcError(err)
 	}
 	if res.Error != "" {
 		return models.OrderSuccessResponse{}, err
 	}
-	return models.OrderSuccessResponse{
+	result := models.OrderSuccessResponse{
 		OrderID:        uint(res.OrderID), // Convert the OrderID to uint
-		ShipmentStatus: "delivered",       // Set the default value for ShipmentStatus
-	}, nil
+		ShipmentStatus: res.Shipmentstatus,
+		PaymentStatus:  res.Paymentstatus,
+	}
+	if res.Payment != nil {
+		payment := paymentDetails(res.Payment)
+		result.Payment = &payment
+	}
+	return result, nil
 }
 func (c *orderClient) GetOrderDetails(userId int, page int, count int) ([]models.FullOrderDetails, error) {
 	res, err := c.Client.GetOrderDetails(context.Background(), &pb.GetOrderDetailsRequest{
@@ -65,7 +71,9 @@ func (c *orderClient) GetOrderDetails(userId int, page int, count int) ([]models
 		orderDetails := models.OrderDetails{
 			OrderId:        int(v.Orderdetails.OrderID),
 			FinalPrice:     float64(v.Orderdetails.Price),
-			ShipmentStatus: "delivered",
+			Discount:       float64(v.Orderdetails.Discount),
+			CouponCode:     v.Orderdetails.CouponCode,
+			ShipmentStatus: v.Orderdetails.Shipmentstatus,
 			PaymentStatus:  v.Orderdetails.Paymentstatus,
 		}
 
@@ -86,3 +94,109 @@ func (c *orderClient) GetOrderDetails(userId int, page int, count int) ([]models
 	}
 	return result, nil
 }
+func (c *orderClient) UpdateOrderStatus(orderID int, status string, actor string) (models.OrderSuccessResponse, error) {
+	res, err := c.Client.UpdateOrderStatus(context.Background(), &pb.UpdateOrderStatusRequest{
+		OrderID: int64(orderID),
+		Status:  status,
+		Actor:   actor,
+	})
+	if err != nil {
+		return models.OrderSuccessResponse{}, handleGrpcError(err)
+	}
+	return models.OrderSuccessResponse{
+		OrderID:        uint(res.OrderID),
+		ShipmentStatus: res.Shipmentstatus,
+	}, nil
+}
+func (c *orderClient) GetOrderTimeline(orderID int, userID int) (models.OrderTimeline, error) {
+	res, err := c.Client.GetOrderTimeline(context.Background(), &pb.GetOrderTimelineRequest{
+		OrderID: int64(orderID),
+		UserID:  int64(userID),
+	})
+	if err != nil {
+		return models.OrderTimeline{}, handleGrpcError(err)
+	}
+	result := models.OrderTimeline{
+		OrderID:        int(res.OrderID),
+		ShipmentStatus: res.Shipmentstatus,
+	}
+	for _, e := range res.Events {
+		result.Events = append(result.Events, models.OrderStatusEvent{
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			Actor:      e.Actor,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	return result, nil
+}
+func (c *orderClient) CancelOrder(orderID int, userID int) (models.OrderSuccessResponse, error) {
+	res, err := c.Client.CancelOrder(context.Background(), &pb.CancelOrderRequest{
+		OrderID: int64(orderID),
+		UserID:  int64(userID),
+	})
+	if err != nil {
+		return models.OrderSuccessResponse{}, handleGrpcError(err)
+	}
+	return models.OrderSuccessResponse{
+		OrderID:        uint(res.OrderID),
+		ShipmentStatus: res.Shipmentstatus,
+		PaymentStatus:  res.Paymentstatus,
+	}, nil
+}
+func (c *orderClient) RequestReturn(orderID int, userID int, request models.ReturnRequest) (models.ReturnRequestDetails, error) {
+	res, err := c.Client.RequestReturn(context.Background(), &pb.RequestReturnRequest{
+		OrderID:    int64(orderID),
+		UserID:     int64(userID),
+		ProductID:  int64(request.ProductID),
+		ReasonCode: request.ReasonCode,
+		Comment:    request.Comment,
+	})
+	if err != nil {
+		return models.ReturnRequestDetails{}, handleGrpcError(err)
+	}
+	return returnDetails(res.Return), nil
+}
+func (c *orderClient) ListReturnRequests(userID int, status string, page int, count int) ([]models.ReturnRequestDetails, error) {
+	res, err := c.Client.ListReturnRequests(context.Background(), &pb.ListReturnRequestsRequest{
+		UserID: int64(userID),
+		Status: status,
+		Page:   int64(page),
+		Count:  int64(count),
+	})
+	if err != nil {
+		return []models.ReturnRequestDetails{}, handleGrpcError(err)
+	}
+	var result []models.ReturnRequestDetails
+	for _, r := range res.Returns {
+		result = append(result, returnDetails(r))
+	}
+	return result, nil
+}
+func (c *orderClient) ReviewReturnRequest(returnID int, approve bool, actor string, note string) (models.ReturnRequestDetails, error) {
+	res, err := c.Client.ReviewReturnRequest(context.Background(), &pb.ReviewReturnRequestRequest{
+		ReturnID: int64(returnID),
+		Approve:  approve,
+		Actor:    actor,
+		Note:     note,
+	})
+	if err != nil {
+		return models.ReturnRequestDetails{}, handleGrpcError(err)
+	}
+	return returnDetails(res.Return), nil
+}
+func returnDetails(r *pb.ReturnRequestDetails) models.ReturnRequestDetails {
+	return models.ReturnRequestDetails{
+		ID:          int(r.GetID()),
+		OrderID:     int(r.GetOrderID()),
+		OrderItemID: int(r.GetOrderItemID()),
+		ProductID:   int(r.GetProductID()),
+		Quantity:    int(r.GetQuantity()),
+		Amount:      float64(r.GetAmount()),
+		ReasonCode:  r.GetReasonCode(),
+		Comment:     r.GetComment(),
+		Status:      r.GetStatus(),
+		ReviewNote:  r.GetReviewNote(),
+		CreatedAt:   r.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/order"
+	"api-gateway/pkg/utils/models"
+	"context"
+)
+
+func (c *orderClient) PayOrder(orderID int, userID int, paymentID int) (models.Payment, error) {
+	res, err := c.Client.PayOrder(context.Background(), &pb.PayOrderRequest{
+		OrderID:   int64(orderID),
+		UserID:    int64(userID),
+		PaymentID: int64(paymentID),
+	})
+	if err != nil {
+		return models.Payment{}, handleGrpcError(err)
+	}
+	return paymentDetails(res.Payment), nil
+}
+
+func (c *orderClient) GetOrderPayments(orderID int, userID int) ([]models.Payment, error) {
+	res, err := c.Client.GetOrderPayments(context.Background(), &pb.GetOrderPaymentsRequest{
+		OrderID: int64(orderID),
+		UserID:  int64(userID),
+	})
+	if err != nil {
+		return []models.Payment{}, handleGrpcError(err)
+	}
+	var result []models.Payment
+	for _, p := range res.Payments {
+		result = append(result, paymentDetails(p))
+	}
+	return result, nil
+}
+
+func (c *orderClient) HandlePaymentWebhook(provider string, payload []byte, signature string) (models.Payment, error) {
+	res, err := c.Client.HandlePaymentWebhook(context.Background(), &pb.PaymentWebhookRequest{
+		Provider:  provider,
+		Payload:   payload,
+		Signature: signature,
+	})
+	if err != nil {
+		return models.Payment{}, handleGrpcError(err)
+	}
+	return paymentDetails(res.Payment), nil
+}
+
+func (c *orderClient) SimulatePayment(paymentID int, outcome string) (models.PaymentWebhook, error) {
+	res, err := c.Client.SimulatePayment(context.Background(), &pb.SimulatePaymentRequest{
+		PaymentID: int64(paymentID),
+		Outcome:   outcome,
+	})
+	if err != nil {
+		return models.PaymentWebhook{}, handleGrpcError(err)
+	}
+	return models.PaymentWebhook{
+		Provider:  res.Provider,
+		Payload:   string(res.Payload),
+		Signature: res.Signature,
+	}, nil
+}
+
+func paymentDetails(p *pb.PaymentDetails) models.Payment {
+	return models.Payment{
+		ID:            int(p.GetID()),
+		OrderID:       int(p.GetOrderID()),
+		Method:        p.GetMethod(),
+		Provider:      p.GetProvider(),
+		Reference:     p.GetReference(),
+		Amount:        float64(p.GetAmount()),
+		Status:        p.GetStatus(),
+		CheckoutURL:   p.GetCheckoutURL(),
+		FailureReason: p.GetFailureReason(),
+		CreatedAt:     p.GetCreatedAt(),
+		UpdatedAt:     p.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/product"
+	"api-gateway/pkg/utils/models"
+	"context"
+	"time"
+)
+
+func (c *productClient) SchedulePriceChange(productID int, input models.PriceScheduleInput, actor string) (models.PriceSchedule, error) {
+	req := &pb.SchedulePriceRequest{
+		ProductID: int64(productID),
+		Label:     input.Label,
+		Price:     float32(input.Price),
+		Actor:     actor,
+	}
+	if input.StartsAt != nil {
+		req.StartsAt = input.StartsAt.Format(time.RFC3339)
+	}
+	if input.EndsAt != nil {
+		req.EndsAt = input.EndsAt.Format(time.RFC3339)
+	}
+	res, err := c.Client.SchedulePriceChange(context.Background(), req)
+	if err != nil {
+		return models.PriceSchedule{}, handleGrpcError(err)
+	}
+	return priceSchedule(res.Schedule), nil
+}
+
+func (c *productClient) CancelPriceSchedule(id int, actor string) (models.PriceSchedule, error) {
+	res, err := c.Client.CancelPriceSchedule(context.Background(), &pb.CancelPriceScheduleRequest{
+		ID:    int64(id),
+		Actor: actor,
+	})
+	if err != nil {
+		return models.PriceSchedule{}, handleGrpcError(err)
+	}
+	return priceSchedule(res.Schedule), nil
+}
+
+func (c *productClient) ListPriceSchedules(productID int) ([]models.PriceSchedule, error) {
+	res, err := c.Client.ListPriceSchedules(context.Background(), &pb.ProductIDRequest{ID: int64(productID)})
+	if err != nil {
+		return []models.PriceSchedule{}, handleGrpcError(err)
+	}
+	result := []models.PriceSchedule{}
+	for _, s := range res.Schedules {
+		result = append(result, priceSchedule(s))
+	}
+	return result, nil
+}
+
+func (c *productClient) ListPriceHistory(productID int, page int, count int) ([]models.PriceChange, error) {
+	res, err := c.Client.ListPriceHistory(context.Background(), &pb.ListPriceHistoryRequest{
+		ProductID: int64(productID),
+		Page:      int64(page),
+		Count:     int64(count),
+	})
+	if err != nil {
+		return []models.PriceChange{}, handleGrpcError(err)
+	}
+	result := []models.PriceChange{}
+	for _, h := range res.Changes {
+		result = append(result, models.PriceChange{
+			ID:         int(h.ID),
+			ProductID:  int(h.ProductID),
+			OldPrice:   float64(h.OldPrice),
+			NewPrice:   float64(h.NewPrice),
+			Reason:     h.Reason,
+			ScheduleID: int(h.ScheduleID),
+			Actor:      h.Actor,
+			CreatedAt:  h.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+func priceSchedule(s *pb.PriceSchedule) models.PriceSchedule {
+	return models.PriceSchedule{
+		ID:            int(s.GetID()),
+		ProductID:     int(s.GetProductID()),
+		Label:         s.GetLabel(),
+		Price:         float64(s.GetPrice()),
+		PreviousPrice: float64(s.GetPreviousPrice()),
+		StartsAt:      s.GetStartsAt(),
+		EndsAt:        s.GetEndsAt(),
+		Status:        s.GetStatus(),
+		CreatedBy:     s.GetCreatedBy(),
+	}
+}
@@ -10,15 +10,21 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// maxImageMessage is the largest message sent to the product service: an image
+// upload plus room for the rest of the request.
+const maxImageMessage = 6 << 20
+
 type productClient struct {
 	Client pb.ProductClient
 }
 
 func NewProductClient(cfg config.Config) interfaces.ProductClient {
 
-	grpcConnection, err := grpc.Dial(cfg.ProductSvcUrl, grpc.WithInsecure())
+	grpcConnection, err := grpc.Dial(cfg.ProductSvcUrl, grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxImageMessage)))
 	if err != nil {
 		fmt.Println("Could not connect", err)
 	}
@@ -30,15 +36,45 @@ func NewProductClient(cfg config.Config) interfaces.ProductClient {
 	}
 
 }
-func (c *productClient) ShowAllProducts(page int, count int) ([]models.ProductBrief, error) {
+func (c *productClient) ShowAllProducts(page int, count int, filter models.ProductFilter) (models.ProductListing, error) {
 	res, err := c.Client.ListProducts(context.Background(), &pb.ListProductRequest{
 		Page:  int64(page),
 		Count: int64(count),
+		Sort:  filter.Sort,
+		Filter: &pb.ProductFilter{
+			Query:            filter.Query,
+			CategoryID:       int64(filter.CategoryID),
+			MinPrice:         float32(filter.MinPrice),
+			MaxPrice:         float32(filter.MaxPrice),
+			InStock:          filter.InStock,
+			Status:           filter.Status,
+			Brand:            filter.Brand,
+			CPU:              filter.CPU,
+			MinRAMGB:         int64(filter.MinRAMGB),
+			MaxRAMGB:         int64(filter.MaxRAMGB),
+			StorageType:      filter.StorageType,
+			MinStorageGB:     int64(filter.MinStorageGB),
+			GPU:              filter.GPU,
+			ScreenResolution: filter.ScreenResolution,
+			MaxWeightKg:      float32(filter.MaxWeightKg),
+			MinBatteryWh:     float32(filter.MinBatteryWh),
+			OS:               filter.OS,
+		},
 	})
 	if err != nil {
-		return []models.ProductBrief{}, err
+		return models.ProductListing{}, handleGrpcError(err)
+	}
+	result := models.ProductListing{
+		Products: []models.ProductBrief{},
+		Total:    int(res.Total),
+	}
+	for _, f := range res.Facets {
+		facet := models.Facet{Name: f.Name, Values: []models.FacetValue{}}
+		for _, v := range f.Values {
+			facet.Values = append(facet.Values, models.FacetValue{Value: v.Value, Count: int(v.Count)})
+		}
+		result.Facets = append(result.Facets, facet)
 	}
-	var result []models.ProductBrief
 
 	for _, v := range res.Details {
 		product := models.ProductBrief{
@@ -50,8 +86,12 @@ func (c *productClient) ShowAllProducts(page int, count int) ([]models.ProductBr
 			Stock:         int(v.Stock),
 			Price:         float64(v.Price),
 			ProductStatus: v.ProductStatus,
+			Status:        v.Status,
+			DeletedAt:     v.DeletedAt,
+			Images:        productImages(v.Images),
+			LaptopSpecs:   laptopSpecs(v.Specs),
 		}
-		result = append(result, product)
+		result.Products = append(result.Products, product)
 	}
 
 	return result, nil
@@ -64,6 +104,18 @@ func (c *productClient) AddProducts(product models.Product) (models.Products, er
 		Size:        int64(product.Size),
 		Stock:       int64(product.Stock),
 		Price:       float32(product.Price),
+		Specs: &pb.LaptopSpecs{
+			Brand:            product.Brand,
+			CPU:              product.CPU,
+			RAMGB:            int64(product.RAMGB),
+			StorageType:      product.StorageType,
+			StorageGB:        int64(product.StorageGB),
+			GPU:              product.GPU,
+			ScreenResolution: product.ScreenResolution,
+			WeightKg:         float32(product.WeightKg),
+			BatteryWh:        float32(product.BatteryWh),
+			OS:               product.OS,
+		},
 	})
 	if err != nil {
 		return models.Products{}, err
@@ -79,24 +131,104 @@ func (c *productClient) AddProducts(product models.Product) (models.Products, er
 		Size:        int(res.Size),
 		Stock:       int(res.Stock),
 		Price:       float64(res.Price),
+		LaptopSpecs: laptopSpecs(res.Specs),
 	}, nil
 }
+func (c *productClient) PatchProduct(id int, patch models.ProductPatch, paths []string, actor string) (models.Products, error) {
+	res, err := c.Client.PatchProduct(context.Background(), &pb.PatchProductRequest{
+		ID: int64(id),
+		Product: &pb.ProductPatch{
+			Name:        patch.Name,
+			Description: patch.Description,
+			CategoryID:  int64(patch.CategoryID),
+			Size:        int64(patch.Size),
+			Stock:       int64(patch.Stock),
+			Price:       float32(patch.Price),
+			Specs: &pb.LaptopSpecs{
+				Brand:            patch.Brand,
+				CPU:              patch.CPU,
+				RAMGB:            int64(patch.RAMGB),
+				StorageType:      patch.StorageType,
+				StorageGB:        int64(patch.StorageGB),
+				GPU:              patch.GPU,
+				ScreenResolution: patch.ScreenResolution,
+				WeightKg:         float32(patch.WeightKg),
+				BatteryWh:        float32(patch.BatteryWh),
+				OS:               patch.OS,
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		Actor:      actor,
+	})
+	if err != nil {
+		return models.Products{}, handleGrpcError(err)
+	}
+	return productFromProto(res.Product), nil
+}
+
+// GetProduct returns a product by id, including archived products.
+func (c *productClient) GetProduct(id int) (models.Products, error) {
+	res, err := c.Client.GetProduct(context.Background(), &pb.ProductIDRequest{ID: int64(id)})
+	if err != nil {
+		return models.Products{}, handleGrpcError(err)
+	}
+	return productFromProto(res.Product), nil
+}
+
+func (c *productClient) RestoreProduct(id int) (models.Products, error) {
+	res, err := c.Client.RestoreProduct(context.Background(), &pb.ProductIDRequest{ID: int64(id)})
+	if err != nil {
+		return models.Products{}, handleGrpcError(err)
+	}
+	return productFromProto(res.Product), nil
+}
+
+func productFromProto(p *pb.ProductDetails) models.Products {
+	return models.Products{
+		ID:          uint(p.GetID()),
+		Name:        p.GetName(),
+		Description: p.GetDescription(),
+		CategoryID:  uint(p.GetCategoryID()),
+		Size:        int(p.GetSize()),
+		Stock:       int(p.GetStock()),
+		Price:       float64(p.GetPrice()),
+		Status:      p.GetStatus(),
+		DeletedAt:   p.GetDeletedAt(),
+		Images:      productImages(p.GetImages()),
+		LaptopSpecs: laptopSpecs(p.GetSpecs()),
+	}
+}
+func laptopSpecs(s *pb.LaptopSpecs) models.LaptopSpecs {
+	return models.LaptopSpecs{
+		Brand:            s.GetBrand(),
+		CPU:              s.GetCPU(),
+		RAMGB:            int(s.GetRAMGB()),
+		StorageType:      s.GetStorageType(),
+		StorageGB:        int(s.GetStorageGB()),
+		GPU:              s.GetGPU(),
+		ScreenResolution: s.GetScreenResolution(),
+		WeightKg:         float64(s.GetWeightKg()),
+		BatteryWh:        float64(s.GetBatteryWh()),
+		OS:               s.GetOS(),
+	}
+}
 func (c *productClient) DeleteProduct(id int) error {
 	res, err := c.Client.DeleteProduct(context.Background(), &pb.DeleteProductRequest{
 		ID: int64(id),
 	})
 	if err != nil {
-		return err
+		return handleGrpcError(err)
 	}
 	if res.Error != "" {
 		return errors.New(res.Error)
 	}
 	return nil
 }
-func (c *productClient) UpdateProducts(pid int, stock int) (models.ProductUpdateReciever, error) {
+func (c *productClient) UpdateProducts(pid int, stock int, actor string) (models.ProductUpdateReciever, error) {
 	res, err := c.Client.UpdateProducts(context.Background(), &pb.UpdateProductRequest{
 		ID:    int64(pid),
 		Stock: int64(stock),
+		Actor: actor,
 	})
 	if err != nil {
 		return models.ProductUpdateReciever{}, err
@@ -109,3 +241,46 @@ func (c *productClient) UpdateProducts(pid int, stock int) (models.ProductUpdate
 		Stock:     int(res.Stock),
 	}, nil
 }
+func (c *productClient) ListStockMovements(productID int, page int, count int) ([]models.StockMovement, error) {
+	res, err := c.Client.ListStockMovements(context.Background(), &pb.ListStockMovementsRequest{
+		ProductID: int64(productID),
+		Page:      int64(page),
+		Count:     int64(count),
+	})
+	if err != nil {
+		return []models.StockMovement{}, err
+	}
+	var result []models.StockMovement
+	for _, m := range res.Movements {
+		result = append(result, models.StockMovement{
+			ID:        int(m.ID),
+			ProductID: int(m.ProductID),
+			Delta:     int(m.Delta),
+			Reason:    m.Reason,
+			Reference: m.Reference,
+			Actor:     m.Actor,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return result, nil
+}
+func (c *productClient) ReconcileStock(productID int, fix bool, actor string) ([]models.StockReconciliation, error) {
+	res, err := c.Client.ReconcileStock(context.Background(), &pb.ReconcileStockRequest{
+		ProductID: int64(productID),
+		Fix:       fix,
+		Actor:     actor,
+	})
+	if err != nil {
+		return []models.StockReconciliation{}, err
+	}
+	result := []models.StockReconciliation{}
+	for _, m := range res.Mismatches {
+		result = append(result, models.StockReconciliation{
+			ProductID:   int(m.ProductID),
+			Stock:       int(m.Stock),
+			LedgerStock: int(m.LedgerStock),
+			Difference:  int(m.Difference),
+		})
+	}
+	return result, nil
+}
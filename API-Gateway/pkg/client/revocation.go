@@ -0,0 +1,160 @@
+package client
+
+import (
+	"api-gateway/pkg/client/interfaces"
+	"api-gateway/pkg/config"
+	"api-gateway/pkg/helper"
+	pb "api-gateway/pkg/pb/user"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// revocationFreshFor is how long a token found not revoked is trusted before
+// the user service is asked again, so a revocation made through another
+// gateway takes at most this long to apply here. revocationStaleFor is how
+// long such an answer is still used while the user service cannot be reached.
+const (
+	revocationFreshFor = 10 * time.Second
+	revocationStaleFor = 5 * time.Minute
+)
+
+type revocationClient struct {
+	Client pb.UserClient
+
+	// The answers of the user service are cached by jti, and the revocations
+	// made through this gateway apply here before they are asked for.
+	mu       sync.Mutex
+	tokens   map[string]revocationAnswer
+	accounts map[string]int64
+	sweptAt  time.Time
+	now      func() time.Time
+}
+
+// revocationAnswer is what the user service said about a token and when.
+type revocationAnswer struct {
+	revoked   bool
+	checkedAt time.Time
+}
+
+func NewRevocationClient(cfg config.Config) interfaces.RevocationClient {
+	grpcConnection, err := grpc.Dial(cfg.UserSvcUrl, grpc.WithInsecure())
+	if err != nil {
+		log.Printf("Error connecting to user service: %v", err)
+		return nil
+	}
+
+	return newRevocationClient(pb.NewUserClient(grpcConnection))
+}
+
+func newRevocationClient(userClient pb.UserClient) *revocationClient {
+	return &revocationClient{
+		Client:   userClient,
+		tokens:   make(map[string]revocationAnswer),
+		accounts: make(map[string]int64),
+		now:      time.Now,
+	}
+}
+
+// RevokeToken revokes a single access token until it expires.
+func (c *revocationClient) RevokeToken(tokenID string, expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.Client.RevokeAccessToken(ctx, &pb.RevokeAccessTokenRequest{
+		TokenId:   tokenID,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	c.remember(tokenID, true)
+	return nil
+}
+
+// RevokeAccount revokes every access token issued to an account so far. The
+// revocation is kept for the lifetime of the account's tokens.
+func (c *revocationClient) RevokeAccount(account string, tokenLifetime time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.Client.RevokeAccount(ctx, &pb.RevokeAccountRequest{
+		Account:       account,
+		TokenLifetime: int64(tokenLifetime / time.Second),
+	})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	c.mu.Lock()
+	c.accounts[account] = max(c.accounts[account], res.RevokedAt)
+	c.mu.Unlock()
+	return nil
+}
+
+// IsRevoked reports whether a token was revoked, either by its jti or because
+// its iat (in Unix seconds) is before the cutoff of its account. A revoked
+// token stays revoked, and a token found valid is not asked about again for
+// revocationFreshFor. While the user service is unreachable, an answer up to
+// revocationStaleFor old is used instead of failing the request.
+func (c *revocationClient) IsRevoked(tokenID string, account string, issuedAt int64) (bool, error) {
+	c.mu.Lock()
+	answer, known := c.tokens[tokenID]
+	cutoff := c.accounts[account]
+	c.mu.Unlock()
+	if issuedAt < cutoff || known && answer.revoked {
+		return true, nil
+	}
+	if known && c.now().Sub(answer.checkedAt) < revocationFreshFor {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.Client.IsAccessTokenRevoked(ctx, &pb.IsAccessTokenRevokedRequest{
+		TokenId:  tokenID,
+		Account:  account,
+		IssuedAt: issuedAt,
+	})
+	if err != nil {
+		if known && c.now().Sub(answer.checkedAt) < revocationStaleFor {
+			log.Printf("checking token revocation: %v; using the answer from %s", err, answer.checkedAt.Format(time.RFC3339))
+			return false, nil
+		}
+		return false, handleGrpcError(err)
+	}
+	c.remember(tokenID, res.Revoked)
+	return res.Revoked, nil
+}
+
+// remember caches an answer about a token. Every revocationStaleFor the
+// answers that can no longer be used are dropped: a token found valid after
+// revocationStaleFor, and a revoked token or account cutoff once the tokens it
+// covers have expired, which no token outlives helper.AdminTokenLifetime.
+func (c *revocationClient) remember(tokenID string, revoked bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if previous, ok := c.tokens[tokenID]; ok && previous.revoked {
+		revoked = true
+	}
+	c.tokens[tokenID] = revocationAnswer{revoked: revoked, checkedAt: now}
+	if now.Sub(c.sweptAt) < revocationStaleFor {
+		return
+	}
+	c.sweptAt = now
+	for id, answer := range c.tokens {
+		if answer.revoked && now.Sub(answer.checkedAt) > helper.AdminTokenLifetime ||
+			!answer.revoked && now.Sub(answer.checkedAt) > revocationStaleFor {
+			delete(c.tokens, id)
+		}
+	}
+	for account, cutoff := range c.accounts {
+		if now.Sub(time.Unix(cutoff, 0)) > helper.AdminTokenLifetime {
+			delete(c.accounts, account)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/user"
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeUserClient answers revocation checks from a set of revoked jtis, or
+// fails them all while down.
+type fakeUserClient struct {
+	pb.UserClient
+
+	revoked map[string]bool
+	down    bool
+	checks  int
+}
+
+func (f *fakeUserClient) RevokeAccessToken(ctx context.Context, in *pb.RevokeAccessTokenRequest, opts ...grpc.CallOption) (*pb.RevokeAccessTokenResponse, error) {
+	f.revoked[in.TokenId] = true
+	return &pb.RevokeAccessTokenResponse{}, nil
+}
+
+func (f *fakeUserClient) RevokeAccount(ctx context.Context, in *pb.RevokeAccountRequest, opts ...grpc.CallOption) (*pb.RevokeAccountResponse, error) {
+	return &pb.RevokeAccountResponse{RevokedAt: 1000}, nil
+}
+
+func (f *fakeUserClient) IsAccessTokenRevoked(ctx context.Context, in *pb.IsAccessTokenRevokedRequest, opts ...grpc.CallOption) (*pb.IsAccessTokenRevokedResponse, error) {
+	f.checks++
+	if f.down {
+		return nil, status.Error(codes.Unavailable, "user service unavailable")
+	}
+	return &pb.IsAccessTokenRevokedResponse{Revoked: f.revoked[in.TokenId]}, nil
+}
+
+func TestIsRevokedCache(t *testing.T) {
+	users := &fakeUserClient{revoked: map[string]bool{}}
+	c := newRevocationClient(users)
+	now := time.Unix(2000, 0)
+	c.now = func() time.Time { return now }
+
+	check := func(step, tokenID string, issuedAt int64, want bool, wantErr bool) {
+		t.Helper()
+		revoked, err := c.IsRevoked(tokenID, "user:1", issuedAt)
+		if (err != nil) != wantErr {
+			t.Fatalf("%s: IsRevoked error = %v, want error %v", step, err, wantErr)
+		}
+		if revoked != want {
+			t.Errorf("%s: IsRevoked = %v, want %v", step, revoked, want)
+		}
+	}
+
+	check("first check", "a", 1500, false, false)
+	check("cached", "a", 1500, false, false)
+	if users.checks != 1 {
+		t.Errorf("user service asked %d times, want 1", users.checks)
+	}
+
+	// Revoked through another gateway: seen once the answer is no longer fresh.
+	users.revoked["a"] = true
+	now = now.Add(revocationFreshFor)
+	check("after the fresh period", "a", 1500, true, false)
+	users.down = true
+	check("revoked stays revoked", "a", 1500, true, false)
+
+	users.down = false
+	check("other token", "b", 1500, false, false)
+	users.down = true
+	now = now.Add(revocationFreshFor)
+	check("outage, recent answer", "b", 1500, false, false)
+	now = now.Add(revocationStaleFor)
+	check("outage, answer too old", "b", 1500, false, true)
+	check("outage, unknown token", "c", 1500, false, true)
+}
+
+func TestRevocationsApplyLocally(t *testing.T) {
+	users := &fakeUserClient{revoked: map[string]bool{}}
+	c := newRevocationClient(users)
+	for _, tokenID := range []string{"a", "b"} {
+		if revoked, err := c.IsRevoked(tokenID, "user:1", 1500); err != nil || revoked {
+			t.Fatalf("IsRevoked(%s) = %v, %v before any revocation", tokenID, revoked, err)
+		}
+	}
+
+	if err := c.RevokeToken("a", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RevokeAccount("user:2", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	users.down = true
+	tests := []struct {
+		name     string
+		tokenID  string
+		account  string
+		issuedAt int64
+		want     bool
+	}{
+		{"revoked token", "a", "user:1", 1500, true},
+		{"issued before the account cutoff", "d", "user:2", 999, true},
+	}
+	for _, tt := range tests {
+		if revoked, err := c.IsRevoked(tt.tokenID, tt.account, tt.issuedAt); err != nil || revoked != tt.want {
+			t.Errorf("%s: IsRevoked = %v, %v, want %v", tt.name, revoked, err, tt.want)
+		}
+	}
+	if revoked, _ := c.IsRevoked("b", "user:1", 1500); revoked {
+		t.Error("a token of another account was revoked")
+	}
+}
@@ -63,8 +63,9 @@ func (c *userClient) UsersSignUp(user models.UserSignUp) (models.TokenUser, erro
 	}
 
 	return models.TokenUser{
-		User:        userDetails,
-		AccessToken: res.AccessToken,
+		User:         userDetails,
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
 	}, nil
 }
 
@@ -94,11 +95,58 @@ func (c *userClient) UserLogin(user models.UserLogin) (models.TokenUser, error)
 	}
 
 	return models.TokenUser{
-		User:        userDetails,
-		AccessToken: res.AccessToken,
+		User:         userDetails,
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
 	}, nil
 }
 
+// RefreshToken exchanges a refresh token for a new access token and a rotated refresh token.
+func (c *userClient) RefreshToken(refreshToken string) (models.TokenPair, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := c.Client.RefreshToken(ctx, &pb.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return models.TokenPair{}, handleGrpcError(err)
+	}
+
+	return models.TokenPair{
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
+	}, nil
+}
+
+// RevokeRefreshToken revokes the session a refresh token belongs to.
+func (c *userClient) RevokeRefreshToken(refreshToken string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.Client.RevokeRefreshToken(ctx, &pb.RevokeRefreshTokenRequest{
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
+// RevokeUserSessions revokes every refresh token of a user.
+func (c *userClient) RevokeUserSessions(userID int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.Client.RevokeUserSessions(ctx, &pb.RevokeUserSessionsRequest{
+		UserId: uint64(userID),
+	})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
 // AddAddress adds a new address for a user.
 func (c *userClient) AddAddress(address models.Address) (models.Address, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
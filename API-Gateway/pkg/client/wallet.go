@@ -0,0 +1,44 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/order"
+	"api-gateway/pkg/utils/models"
+	"context"
+)
+
+func (c *orderClient) GetWallet(userID int) (models.Wallet, error) {
+	res, err := c.Client.GetWallet(context.Background(), &pb.GetWalletRequest{
+		UserID: int64(userID),
+	})
+	if err != nil {
+		return models.Wallet{}, handleGrpcError(err)
+	}
+	return models.Wallet{
+		UserID:  int(res.UserID),
+		Balance: res.Balance,
+	}, nil
+}
+
+func (c *orderClient) ListWalletTransactions(userID int, page int, count int) ([]models.WalletTransaction, error) {
+	res, err := c.Client.ListWalletTransactions(context.Background(), &pb.ListWalletTransactionsRequest{
+		UserID: int64(userID),
+		Page:   int64(page),
+		Count:  int64(count),
+	})
+	if err != nil {
+		return []models.WalletTransaction{}, handleGrpcError(err)
+	}
+	var result []models.WalletTransaction
+	for _, t := range res.Transactions {
+		result = append(result, models.WalletTransaction{
+			ID:           int(t.ID),
+			Type:         t.Type,
+			Amount:       t.Amount,
+			BalanceAfter: t.BalanceAfter,
+			Reason:       t.Reason,
+			Reference:    t.Reference,
+			CreatedAt:    t.CreatedAt,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	pb "api-gateway/pkg/pb/cart"
+	"api-gateway/pkg/utils/models"
+	"context"
+)
+
+func (c *cartClient) AddToWishlist(userID, productID int) (models.WishlistItem, error) {
+	res, err := c.Client.AddToWishlist(context.Background(), &pb.WishlistRequest{
+		UserID:    int64(userID),
+		ProductID: int64(productID),
+	})
+	if err != nil {
+		return models.WishlistItem{}, handleGrpcError(err)
+	}
+	return wishlistItemFromProto(res.Item), nil
+}
+
+func (c *cartClient) RemoveFromWishlist(userID, productID int) error {
+	_, err := c.Client.RemoveFromWishlist(context.Background(), &pb.WishlistRequest{
+		UserID:    int64(userID),
+		ProductID: int64(productID),
+	})
+	if err != nil {
+		return handleGrpcError(err)
+	}
+	return nil
+}
+
+func (c *cartClient) ListWishlist(userID, page, count int) ([]models.WishlistItem, error) {
+	res, err := c.Client.ListWishlist(context.Background(), &pb.ListWishlistRequest{
+		UserID: int64(userID),
+		Page:   int64(page),
+		Count:  int64(count),
+	})
+	if err != nil {
+		return []models.WishlistItem{}, handleGrpcError(err)
+	}
+	items := []models.WishlistItem{}
+	for _, item := range res.Items {
+		items = append(items, wishlistItemFromProto(item))
+	}
+	return items, nil
+}
+
+func (c *cartClient) MoveToCart(userID, productID, quantity int) (models.CartResponse, error) {
+	res, err := c.Client.MoveToCart(context.Background(), &pb.WishlistRequest{
+		UserID:    int64(userID),
+		ProductID: int64(productID),
+		Quantity:  int64(quantity),
+	})
+	if err != nil {
+		return models.CartResponse{}, handleGrpcError(err)
+	}
+	return cartFromProto(res), nil
+}
+
+func (c *cartClient) SaveForLater(userID, productID int) (models.WishlistItem, error) {
+	res, err := c.Client.SaveForLater(context.Background(), &pb.WishlistRequest{
+		UserID:    int64(userID),
+		ProductID: int64(productID),
+	})
+	if err != nil {
+		return models.WishlistItem{}, handleGrpcError(err)
+	}
+	return wishlistItemFromProto(res.Item), nil
+}
+
+func wishlistItemFromProto(item *pb.WishlistItem) models.WishlistItem {
+	return models.WishlistItem{
+		ProductID:    uint(item.GetProductID()),
+		Price:        float64(item.GetPrice()),
+		AddedPrice:   float64(item.GetAddedPrice()),
+		PriceChanged: item.GetPriceChanged(),
+		InStock:      item.GetInStock(),
+		StockStatus:  item.GetStockStatus(),
+		AddedAt:      item.GetAddedAt(),
+	}
+}
@@ -9,10 +9,14 @@ type Config struct {
 	OrderSvcUrl   string `mapstructure:"ORDER_SVC_URL"`
 	AdminSvcUrl   string `mapstructure:"ADMIN_SVC_URL"`
 	CartSvcUrl    string `mapstructure:"CART_SVC_URL"`
+
+	// GuestCartSecret signs the cart tokens of shoppers who are not logged in.
+	GuestCartSecret string `mapstructure:"GUEST_CART_SECRET"`
 }
 
 var envs = []string{
 	"PORT", "USER_SVC_URL", "PRODUCT_SVC_URL", "ORDER_SVC_URL", "ADMIN_SVC_URL", "CART_SVC_URL",
+	"GUEST_CART_SECRET",
 }
 
 func LoadConfig() (Config, error) {
@@ -4,27 +4,31 @@ import (
 	"api-gateway/pkg/client"
 	"api-gateway/pkg/config"
 	"api-gateway/pkg/handler"
+	"api-gateway/pkg/helper"
 	"api-gateway/pkg/server"
 )
 
 func InitializeAPI(cfg config.Config) (*server.ServerHTTP, error) {
 
+	revocations := client.NewRevocationClient(cfg)
+
 	adminClient := client.NewAdminClient(cfg)
-	adminHandler := handler.NewAdminHandler(adminClient)
+	adminHandler := handler.NewAdminHandler(adminClient, revocations)
 
 	productClient := client.NewProductClient(cfg)
 	productHandler := handler.NewProductHandler(productClient)
 
-	userClient := client.NewUserClient(cfg)
-	userHandler := handler.NewUserHandler(userClient)
-
+	guestCartSecret := helper.GuestCartSecret(cfg.GuestCartSecret)
 	cartClient := client.NewCartClient(cfg)
 	cartHandler := handler.NewCartHandler(cartClient)
 
+	userClient := client.NewUserClient(cfg)
+	userHandler := handler.NewUserHandler(userClient, revocations, cartClient, guestCartSecret)
+
 	orderClient := client.NewOrderClient(cfg)
 	orderHandler := handler.NewOrderHandler(orderClient)
 
-	serverHTTP := server.NewServerHTTP(adminHandler, productHandler, userHandler, cartHandler, orderHandler)
+	serverHTTP := server.NewServerHTTP(revocations, guestCartSecret, adminHandler, productHandler, userHandler, cartHandler, orderHandler)
 
 	return serverHTTP, nil
 }
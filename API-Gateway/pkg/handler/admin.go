@@ -2,21 +2,25 @@ package handler
 
 import (
 	"api-gateway/pkg/client/interfaces"
+	"api-gateway/pkg/helper"
 	"api-gateway/pkg/utils/models"
 	"api-gateway/pkg/utils/response"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 type AdminHandler struct {
 	GRPC_Client interfaces.AdminClient
+	Revocations interfaces.RevocationClient
 }
 
-func NewAdminHandler(adminClient interfaces.AdminClient) *AdminHandler {
+func NewAdminHandler(adminClient interfaces.AdminClient, revocations interfaces.RevocationClient) *AdminHandler {
 	return &AdminHandler{
 		GRPC_Client: adminClient,
+		Revocations: revocations,
 	}
 }
 
@@ -58,3 +62,28 @@ func (ad *AdminHandler) AdminSignUp(c *gin.Context) {
 	success := response.ClientResponse(http.StatusOK, "Admin created successfully", admin, nil)
 	c.JSON(http.StatusOK, success)
 }
+
+func (ad *AdminHandler) Logout(c *gin.Context) {
+	tokenID, _ := c.Get("token_id")
+	expiresAt, _ := c.Get("token_expires_at")
+	if err := ad.Revocations.RevokeToken(tokenID.(string), expiresAt.(time.Time)); err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Could not log out", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+
+	success := response.ClientResponse(http.StatusOK, "Admin successfully logged out", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ad *AdminHandler) LogoutAll(c *gin.Context) {
+	email, _ := c.Get("admin_email")
+	if err := ad.Revocations.RevokeAccount(helper.AdminAccount(email.(string)), helper.AdminTokenLifetime); err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Could not log out all sessions", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+
+	success := response.ClientResponse(http.StatusOK, "All admin sessions logged out", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
@@ -55,3 +55,132 @@ func (ct *CartHandler) GetCart(c *gin.Context) {
 	success := response.ClientResponse(http.StatusOK, "Cart items displayed successfully", cart, nil)
 	c.JSON(http.StatusOK, success)
 }
+
+// UpdateCartItemQuantity sets the quantity of a product that is in the cart
+func (ct *CartHandler) UpdateCartItemQuantity(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	quantity, err := strconv.Atoi(c.Query("quantity"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Quantity is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	cart, err := ct.GRPC_Client.UpdateCartItemQuantity(userID.(int), productID, quantity)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not update the quantity", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Quantity updated successfully", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) RemoveFromCart(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	cart, err := ct.GRPC_Client.RemoveFromCart(userID.(int), productID)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not remove product from the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Removed product successfully from the cart", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) ClearCart(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	if err := ct.GRPC_Client.ClearCart(userID.(int)); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not clear the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Cart cleared successfully", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) AddToGuestCart(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	quantity, err := strconv.Atoi(c.Query("quantity"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Quantity is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	guestID := c.GetString("guest_id")
+	cart, err := ct.GRPC_Client.AddToGuestCart(guestID, productID, quantity)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not add product to the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Added product successfully to the cart", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) GetGuestCart(c *gin.Context) {
+	cart, err := ct.GRPC_Client.GetGuestCart(c.GetString("guest_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "cannot display cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Cart items displayed successfully", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) UpdateGuestCartItemQuantity(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	quantity, err := strconv.Atoi(c.Query("quantity"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Quantity is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	cart, err := ct.GRPC_Client.UpdateGuestCartItemQuantity(c.GetString("guest_id"), productID, quantity)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not update the quantity", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Quantity updated successfully", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) RemoveFromGuestCart(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	cart, err := ct.GRPC_Client.RemoveFromGuestCart(c.GetString("guest_id"), productID)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not remove product from the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Removed product successfully from the cart", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/models"
+	"api-gateway/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+)
+
+// GetCategoryTree lists every category with its subcategories nested below it
+func (pt *ProductHandler) GetCategoryTree(c *gin.Context) {
+	tree, err := pt.GRPC_Client.GetCategoryTree()
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve categories", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved categories", tree, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) ListCategories(c *gin.Context) {
+	categories, err := pt.GRPC_Client.ListCategories()
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve categories", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved categories", categories, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) AddCategory(c *gin.Context) {
+	category, ok := bindCategory(c)
+	if !ok {
+		return
+	}
+	added, err := pt.GRPC_Client.AddCategory(category)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not add the category", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully added category", added, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) UpdateCategory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid category ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	category, ok := bindCategory(c)
+	if !ok {
+		return
+	}
+	updated, err := pt.GRPC_Client.UpdateCategory(id, category)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not update the category", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully updated category", updated, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// DeleteCategory deletes a category that has no products or subcategories
+func (pt *ProductHandler) DeleteCategory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid category ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	if err := pt.GRPC_Client.DeleteCategory(id); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not delete the category", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully deleted the category", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func bindCategory(c *gin.Context) (models.CategoryInput, bool) {
+	var category models.CategoryInput
+	if err := c.ShouldBindJSON(&category); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return models.CategoryInput{}, false
+	}
+	if err := validator.New().Struct(category); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return models.CategoryInput{}, false
+	}
+	return category, true
+}
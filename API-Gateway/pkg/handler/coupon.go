@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/models"
+	"api-gateway/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+)
+
+func (ct *CartHandler) ListCoupons(c *gin.Context) {
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	coupons, err := ct.GRPC_Client.ListCoupons(page, count)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve coupons", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved coupons", coupons, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) AddCoupon(c *gin.Context) {
+	coupon, ok := bindCoupon(c)
+	if !ok {
+		return
+	}
+	email, _ := c.Get("admin_email")
+	added, err := ct.GRPC_Client.AddCoupon(coupon, "admin:"+email.(string))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not add the coupon", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully added coupon", added, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// UpdateCoupon replaces the settings of a coupon; set active to false to stop
+// a coupon that was already redeemed
+func (ct *CartHandler) UpdateCoupon(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid coupon ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	coupon, ok := bindCoupon(c)
+	if !ok {
+		return
+	}
+	updated, err := ct.GRPC_Client.UpdateCoupon(id, coupon)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not update the coupon", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully updated coupon", updated, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// DeleteCoupon deletes a coupon that was never redeemed
+func (ct *CartHandler) DeleteCoupon(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid coupon ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	if err := ct.GRPC_Client.DeleteCoupon(id); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not delete the coupon", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully deleted the coupon", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) ApplyCoupon(c *gin.Context) {
+	var input models.ApplyCoupon
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	if err := validator.New().Struct(input); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	cart, err := ct.GRPC_Client.ApplyCoupon(userID.(int), input.Code)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not apply the coupon", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Coupon applied successfully", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) RemoveCoupon(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	cart, err := ct.GRPC_Client.RemoveCoupon(userID.(int))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not remove the coupon", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Coupon removed successfully", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func bindCoupon(c *gin.Context) (models.CouponInput, bool) {
+	var coupon models.CouponInput
+	if err := c.ShouldBindJSON(&coupon); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return models.CouponInput{}, false
+	}
+	if err := validator.New().Struct(coupon); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return models.CouponInput{}, false
+	}
+	return coupon, true
+}
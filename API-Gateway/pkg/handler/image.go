@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/models"
+	"api-gateway/pkg/utils/response"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+)
+
+const (
+	// maxImageBytes is the largest image file that can be uploaded.
+	maxImageBytes = 5 << 20
+	// maxImagesPerUpload is how many images one request can upload.
+	maxImagesPerUpload = 10
+)
+
+// UploadProductImages uploads the files of the multipart field "images" in
+// order. With primary=true the first of them becomes the primary image.
+func (pt *ProductHandler) UploadProductImages(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImagesPerUpload*maxImageBytes+1<<20)
+	form, err := c.MultipartForm()
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Images must be sent as multipart form data", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	files := form.File["images"]
+	if len(files) == 0 || len(files) > maxImagesPerUpload {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Upload between 1 and 10 files in the images field", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	primary := c.PostForm("primary") == "true"
+
+	uploaded := []models.ProductImage{}
+	for i, file := range files {
+		data, err := readImageFile(file)
+		if err != nil {
+			errorRes := response.ClientResponse(http.StatusBadRequest, "Could not read "+file.Filename, uploaded, err.Error())
+			c.JSON(http.StatusBadRequest, errorRes)
+			return
+		}
+		image, err := pt.GRPC_Client.UploadProductImage(productID, data, primary && i == 0)
+		if err != nil {
+			errorRes := response.ClientResponse(http.StatusBadRequest, "Could not upload "+file.Filename, uploaded, err.Error())
+			c.JSON(http.StatusBadRequest, errorRes)
+			return
+		}
+		uploaded = append(uploaded, image)
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully uploaded the images", uploaded, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) ListProductImages(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	images, err := pt.GRPC_Client.ListProductImages(productID)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve the images", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved the images", images, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) SetPrimaryImage(c *gin.Context) {
+	productID, imageID, ok := imageParams(c)
+	if !ok {
+		return
+	}
+	images, err := pt.GRPC_Client.SetPrimaryImage(productID, imageID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not set the primary image", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully set the primary image", images, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// ReorderProductImages puts the images of a product in the order of image_ids
+func (pt *ProductHandler) ReorderProductImages(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	var order models.ImageOrder
+	if err := c.ShouldBindJSON(&order); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	if err := validator.New().Struct(order); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	images, err := pt.GRPC_Client.ReorderProductImages(productID, order.ImageIDs)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not reorder the images", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully reordered the images", images, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) DeleteProductImage(c *gin.Context) {
+	productID, imageID, ok := imageParams(c)
+	if !ok {
+		return
+	}
+	images, err := pt.GRPC_Client.DeleteProductImage(productID, imageID)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not delete the image", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully deleted the image", images, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func imageParams(c *gin.Context) (productID, imageID int, ok bool) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, 0, false
+	}
+	imageID, err = strconv.Atoi(c.Param("image_id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid image ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return 0, 0, false
+	}
+	return productID, imageID, true
+}
+
+func readImageFile(file *multipart.FileHeader) ([]byte, error) {
+	if file.Size > maxImageBytes {
+		return nil, errors.New("images can be at most 5 MB")
+	}
+	f, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(io.LimitReader(f, maxImageBytes))
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/models"
+	"api-gateway/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+)
+
+func (pt *ProductHandler) ListOffers(c *gin.Context) {
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	offers, err := pt.GRPC_Client.ListOffers(page, count)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve offers", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved offers", offers, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// AddOffer adds a discount that carts apply by themselves to a product or to
+// every product of a category
+func (pt *ProductHandler) AddOffer(c *gin.Context) {
+	offer, ok := bindOffer(c)
+	if !ok {
+		return
+	}
+	email, _ := c.Get("admin_email")
+	added, err := pt.GRPC_Client.AddOffer(offer, "admin:"+email.(string))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not add the offer", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully added offer", added, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) UpdateOffer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid offer ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	offer, ok := bindOffer(c)
+	if !ok {
+		return
+	}
+	updated, err := pt.GRPC_Client.UpdateOffer(id, offer)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not update the offer", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully updated offer", updated, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) DeleteOffer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid offer ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	if err := pt.GRPC_Client.DeleteOffer(id); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not delete the offer", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully deleted the offer", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func bindOffer(c *gin.Context) (models.OfferInput, bool) {
+	var offer models.OfferInput
+	if err := c.ShouldBindJSON(&offer); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return models.OfferInput{}, false
+	}
+	if err := validator.New().Struct(offer); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return models.OfferInput{}, false
+	}
+	return offer, true
+}
@@ -67,3 +67,168 @@ func (or *OrderHandler) GetOrderDetails(c *gin.Context) {
 	successRes := response.ClientResponse(http.StatusOK, "Full Order Details", OrderDetails, nil)
 	c.JSON(http.StatusOK, successRes)
 }
+
+// UpdateOrderStatus lets an admin move an order to its next status
+func (or *OrderHandler) UpdateOrderStatus(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "order id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	var update models.OrderStatusUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "bad request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+
+	email, _ := c.Get("admin_email")
+	result, err := or.GRPC_Client.UpdateOrderStatus(orderID, update.Status, "admin:"+email.(string))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not update the order status", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Order status updated", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// GetOrderTimeline returns the status history of one of the user's orders
+func (or *OrderHandler) GetOrderTimeline(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "order id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	id, _ := c.Get("user_id")
+	timeline, err := or.GRPC_Client.GetOrderTimeline(orderID, id.(int))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusNotFound, "Could not get the order timeline", nil, err.Error())
+		c.JSON(http.StatusNotFound, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Order timeline", timeline, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// CancelOrder cancels one of the user's orders that has not been shipped yet
+func (or *OrderHandler) CancelOrder(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "order id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	id, _ := c.Get("user_id")
+	result, err := or.GRPC_Client.CancelOrder(orderID, id.(int))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not cancel the order", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Order cancelled", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// RequestReturn opens a return request for one product of a delivered order
+func (or *OrderHandler) RequestReturn(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "order id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	var request models.ReturnRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "bad request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	id, _ := c.Get("user_id")
+	result, err := or.GRPC_Client.RequestReturn(orderID, id.(int), request)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not request the return", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Return requested", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// GetReturnRequests lists the return requests of the logged in user
+func (or *OrderHandler) GetReturnRequests(c *gin.Context) {
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	id, _ := c.Get("user_id")
+	result, err := or.GRPC_Client.ListReturnRequests(id.(int), c.Query("status"), page, count)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusInternalServerError, "Could not get the return requests", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Return requests", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// ListReturnRequests lists the return requests of all users for admins
+func (or *OrderHandler) ListReturnRequests(c *gin.Context) {
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	result, err := or.GRPC_Client.ListReturnRequests(0, c.Query("status"), page, count)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusInternalServerError, "Could not get the return requests", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Return requests", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// ReviewReturnRequest lets an admin approve or reject a return request
+func (or *OrderHandler) ReviewReturnRequest(c *gin.Context) {
+	returnID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "return id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	var review models.ReturnReview
+	if err := c.ShouldBindJSON(&review); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "bad request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	email, _ := c.Get("admin_email")
+	result, err := or.GRPC_Client.ReviewReturnRequest(returnID, review.Action == "approve", "admin:"+email.(string), review.Note)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not review the return request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Return request reviewed", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// pagination reads the page and count query parameters and writes a bad
+// request response when they are malformed
+func pagination(c *gin.Context) (int, int, bool) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "page number not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return 0, 0, false
+	}
+	count, err := strconv.Atoi(c.DefaultQuery("count", "10"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "page count not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return 0, 0, false
+	}
+	return page, count, true
+}
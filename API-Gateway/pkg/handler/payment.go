@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/models"
+	"api-gateway/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentSignatureHeader carries the signature of a payment webhook call.
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+// PayOrder opens a new payment for one of the user's unpaid orders
+func (or *OrderHandler) PayOrder(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "order id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	var pay models.PayOrder
+	if err := c.ShouldBindJSON(&pay); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "bad request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	id, _ := c.Get("user_id")
+	result, err := or.GRPC_Client.PayOrder(orderID, id.(int), int(pay.PaymentID))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not pay the order", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Payment started", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// GetOrderPayments lists the payments of one of the user's orders
+func (or *OrderHandler) GetOrderPayments(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "order id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	id, _ := c.Get("user_id")
+	result, err := or.GRPC_Client.GetOrderPayments(orderID, id.(int))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusInternalServerError, "Could not get the payments", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Order payments", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// PaymentWebhook receives the payment outcomes a provider reports. The body is
+// passed on untouched, since the signature covers its exact bytes
+func (or *OrderHandler) PaymentWebhook(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "bad request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	result, err := or.GRPC_Client.HandlePaymentWebhook(c.Param("provider"), payload, c.GetHeader(PaymentSignatureHeader))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not process the webhook", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Webhook processed", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// SimulatePayment returns the signed webhook call a mock provider would send
+// for a payment, so admins can try payments without a real gateway
+func (or *OrderHandler) SimulatePayment(c *gin.Context) {
+	paymentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "payment id not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	var simulation models.PaymentSimulation
+	if err := c.ShouldBindJSON(&simulation); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "bad request", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	result, err := or.GRPC_Client.SimulatePayment(paymentID, simulation.Outcome)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not simulate the payment", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Simulated webhook", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
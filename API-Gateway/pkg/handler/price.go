@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/models"
+	"api-gateway/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator"
+)
+
+// SchedulePriceChange schedules a price for a product, such as a sale that
+// starts and ends at set times
+func (pt *ProductHandler) SchedulePriceChange(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	var input models.PriceScheduleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	if err := validator.New().Struct(input); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Constraints not satisfied", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	email, _ := c.Get("admin_email")
+	schedule, err := pt.GRPC_Client.SchedulePriceChange(productID, input, "admin:"+email.(string))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not schedule the price change", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully scheduled the price change", schedule, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// CancelPriceSchedule cancels a schedule; a running one puts the previous
+// price back
+func (pt *ProductHandler) CancelPriceSchedule(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid schedule ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	email, _ := c.Get("admin_email")
+	schedule, err := pt.GRPC_Client.CancelPriceSchedule(id, "admin:"+email.(string))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not cancel the price schedule", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully cancelled the price schedule", schedule, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) ListPriceSchedules(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	schedules, err := pt.GRPC_Client.ListPriceSchedules(productID)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve price schedules", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved price schedules", schedules, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) ListPriceHistory(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	history, err := pt.GRPC_Client.ListPriceHistory(productID, page, count)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve price history", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved price history", history, nil)
+	c.JSON(http.StatusOK, success)
+}
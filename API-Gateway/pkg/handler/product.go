@@ -4,7 +4,10 @@ import (
 	"api-gateway/pkg/client/interfaces"
 	"api-gateway/pkg/utils/models"
 	"api-gateway/pkg/utils/response"
+	"encoding/json"
+	"io"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -59,6 +62,25 @@ func (pt *ProductHandler) AddProducts(c *gin.Context) {
 }
 
 func (pt *ProductHandler) ShowAllProducts(c *gin.Context) {
+	pt.listProducts(c, "")
+}
+
+// AdminListProducts lists products of any status; status may be active,
+// archived or all
+func (pt *ProductHandler) AdminListProducts(c *gin.Context) {
+	status := c.DefaultQuery("status", "all")
+	if status != "active" && status != "archived" && status != "all" {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "status must be active, archived or all", nil, nil)
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	if status == "active" {
+		status = ""
+	}
+	pt.listProducts(c, status)
+}
+
+func (pt *ProductHandler) listProducts(c *gin.Context, status string) {
 	pageStr := c.DefaultQuery("page", "1")
 	page, err := strconv.Atoi(pageStr)
 	if err != nil {
@@ -73,7 +95,14 @@ func (pt *ProductHandler) ShowAllProducts(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, errorRes)
 		return
 	}
-	products, err := pt.GRPC_Client.ShowAllProducts(page, count)
+	var filter models.ProductFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "filters not in right format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	filter.Status = status
+	products, err := pt.GRPC_Client.ShowAllProducts(page, count, filter)
 	if err != nil {
 		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve products", nil, err.Error())
 		c.JSON(http.StatusInternalServerError, errs)
@@ -105,7 +134,43 @@ func (pt *ProductHandler) DeleteProduct(c *gin.Context) {
 		return
 	}
 
-	success := response.ClientResponse(http.StatusOK, "Successfully deleted the product", nil, nil)
+	success := response.ClientResponse(http.StatusOK, "Successfully archived the product", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// GetProduct returns a product by id, including archived products so that
+// past orders can still show what was bought
+func (pt *ProductHandler) GetProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	product, err := pt.GRPC_Client.GetProduct(id)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusNotFound, "Could not find the product", nil, err.Error())
+		c.JSON(http.StatusNotFound, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved the product", product, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (pt *ProductHandler) RestoreProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	product, err := pt.GRPC_Client.RestoreProduct(id)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Could not restore the product", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully restored the product", product, nil)
 	c.JSON(http.StatusOK, success)
 }
 
@@ -123,7 +188,8 @@ func (pt *ProductHandler) UpdateProducts(c *gin.Context) {
 		return
 	}
 
-	a, err := pt.GRPC_Client.UpdateProducts(p.ProductId, p.Stock)
+	email, _ := c.Get("admin_email")
+	a, err := pt.GRPC_Client.UpdateProducts(p.ProductId, p.Stock, "admin:"+email.(string))
 	if err != nil {
 		errorRes := response.ClientResponse(http.StatusInternalServerError, "Could not update the product quantity", nil, err.Error())
 		c.JSON(http.StatusInternalServerError, errorRes)
@@ -134,6 +200,116 @@ func (pt *ProductHandler) UpdateProducts(c *gin.Context) {
 	c.JSON(http.StatusOK, successRes)
 }
 
+// patchPaths maps the JSON fields of a product patch to update mask paths.
+var patchPaths = map[string]string{
+	"name":              "name",
+	"description":       "description",
+	"category_id":       "category_id",
+	"Sizeinch":          "size",
+	"stock":             "stock",
+	"price":             "price",
+	"brand":             "specs.brand",
+	"cpu":               "specs.cpu",
+	"ram_gb":            "specs.ram_gb",
+	"storage_type":      "specs.storage_type",
+	"storage_gb":        "specs.storage_gb",
+	"gpu":               "specs.gpu",
+	"screen_resolution": "specs.screen_resolution",
+	"weight_kg":         "specs.weight_kg",
+	"battery_wh":        "specs.battery_wh",
+	"os":                "specs.os",
+}
+
+// PatchProduct updates only the product fields present in the request body
+func (pt *ProductHandler) PatchProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not read the request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	var fields map[string]json.RawMessage
+	var patch models.ProductPatch
+	if err := json.Unmarshal(body, &fields); err == nil {
+		err = json.Unmarshal(body, &patch)
+	}
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	var paths []string
+	for field := range fields {
+		path, ok := patchPaths[field]
+		if !ok {
+			errorRes := response.ClientResponse(http.StatusBadRequest, "Unknown product field", nil, field)
+			c.JSON(http.StatusBadRequest, errorRes)
+			return
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	email, _ := c.Get("admin_email")
+	product, err := pt.GRPC_Client.PatchProduct(id, patch, paths, "admin:"+email.(string))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Could not update the product", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Successfully updated the product", product, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// ListStockMovements lists the inventory ledger, optionally for one product
+func (pt *ProductHandler) ListStockMovements(c *gin.Context) {
+	productID, err := strconv.Atoi(c.DefaultQuery("product_id", "0"))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusBadRequest, "Invalid product ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errorRes)
+		return
+	}
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	movements, err := pt.GRPC_Client.ListStockMovements(productID, page, count)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve stock movements", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved stock movements", movements, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// ReconcileStock compares product stock with the inventory ledger
+func (pt *ProductHandler) ReconcileStock(c *gin.Context) {
+	var req models.ReconcileStock
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errorRes := response.ClientResponse(http.StatusBadRequest, "Fields provided are in wrong format", nil, err.Error())
+			c.JSON(http.StatusBadRequest, errorRes)
+			return
+		}
+	}
+	email, _ := c.Get("admin_email")
+	mismatches, err := pt.GRPC_Client.ReconcileStock(req.ProductID, req.Fix, "admin:"+email.(string))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Could not reconcile stock", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Stock reconciled against the inventory ledger", mismatches, nil)
+	c.JSON(http.StatusOK, success)
+}
+
 // isAdmin checks if the request context is from an admin user
 func isAdmin(c *gin.Context) bool {
 	// You can check the user role from the context here
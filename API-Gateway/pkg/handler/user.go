@@ -2,10 +2,13 @@ package handler
 
 import (
 	"api-gateway/pkg/client/interfaces"
+	"api-gateway/pkg/helper"
 	"api-gateway/pkg/utils/models"
 	"api-gateway/pkg/utils/response"
+	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -13,13 +16,19 @@ import (
 
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
-	GRPC_Client interfaces.UserClient
+	GRPC_Client     interfaces.UserClient
+	Revocations     interfaces.RevocationClient
+	Cart            interfaces.CartClient
+	GuestCartSecret []byte
 }
 
 // NewUserHandler creates a new instance of UserHandler
-func NewUserHandler(UserClient interfaces.UserClient) *UserHandler {
+func NewUserHandler(UserClient interfaces.UserClient, revocations interfaces.RevocationClient, cartClient interfaces.CartClient, guestCartSecret []byte) *UserHandler {
 	return &UserHandler{
-		GRPC_Client: UserClient,
+		GRPC_Client:     UserClient,
+		Revocations:     revocations,
+		Cart:            cartClient,
+		GuestCartSecret: guestCartSecret,
 	}
 }
 
@@ -47,6 +56,7 @@ func (ur *UserHandler) UserSignup(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, errs)
 		return
 	}
+	user.CartNotices = ur.mergeGuestCart(c, int(user.User.ID))
 
 	success := response.ClientResponse(http.StatusCreated, "User successfully signed up", user, nil)
 	c.JSON(http.StatusCreated, success)
@@ -76,11 +86,126 @@ func (ur *UserHandler) Userlogin(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, errs)
 		return
 	}
+	user.CartNotices = ur.mergeGuestCart(c, int(user.User.ID))
 
 	success := response.ClientResponse(http.StatusOK, "User successfully logged in", user, nil)
 	c.JSON(http.StatusOK, success)
 }
 
+// mergeGuestCart moves the guest cart of the request, if it has one, into the
+// cart of the user who just logged in and forgets its cart token. The login
+// itself succeeds even when the merge fails; the guest cart is then kept for
+// another try.
+func (ur *UserHandler) mergeGuestCart(c *gin.Context, userID int) []string {
+	token := helper.GuestCartToken(c.Request)
+	if token == "" {
+		return nil
+	}
+	guestID, err := helper.ParseGuestCartToken(ur.GuestCartSecret, token)
+	if err != nil {
+		return nil
+	}
+	_, notices, err := ur.Cart.MergeGuestCart(guestID, userID)
+	if err != nil {
+		log.Printf("merging guest cart into the cart of user %d: %v", userID, err)
+		return []string{"your guest cart could not be merged into your cart"}
+	}
+	c.SetCookie(helper.GuestCartCookie, "", -1, "/", "", false, true)
+	return notices
+}
+
+// RefreshToken exchanges a refresh token for a new token pair
+func (ur *UserHandler) RefreshToken(c *gin.Context) {
+	var req models.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Details not in correct format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+
+	tokens, err := ur.GRPC_Client.RefreshToken(req.RefreshToken)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusUnauthorized, "Could not refresh token", nil, err.Error())
+		c.JSON(http.StatusUnauthorized, errs)
+		return
+	}
+
+	success := response.ClientResponse(http.StatusOK, "Token refreshed successfully", tokens, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// Logout revokes the access token of the request and, when given, the refresh token of the session
+func (ur *UserHandler) Logout(c *gin.Context) {
+	var req models.LogoutRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errs := response.ClientResponse(http.StatusBadRequest, "Details not in correct format", nil, err.Error())
+			c.JSON(http.StatusBadRequest, errs)
+			return
+		}
+	}
+
+	tokenID, _ := c.Get("token_id")
+	expiresAt, _ := c.Get("token_expires_at")
+	if err := ur.Revocations.RevokeToken(tokenID.(string), expiresAt.(time.Time)); err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Could not log out", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+
+	if req.RefreshToken != "" {
+		if err := ur.GRPC_Client.RevokeRefreshToken(req.RefreshToken); err != nil {
+			errs := response.ClientResponse(http.StatusBadRequest, "Logged out, but the refresh token could not be revoked", nil, err.Error())
+			c.JSON(http.StatusBadRequest, errs)
+			return
+		}
+	}
+
+	success := response.ClientResponse(http.StatusOK, "User successfully logged out", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// LogoutAll ends every session of the logged in user
+func (ur *UserHandler) LogoutAll(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	if err := ur.revokeUserSessions(userID.(int)); err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Could not log out all sessions", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+
+	success := response.ClientResponse(http.StatusOK, "All sessions logged out", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// RevokeUserSessions lets an admin end every session of a user account
+func (ur *UserHandler) RevokeUserSessions(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Invalid user ID", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+
+	if err := ur.revokeUserSessions(userID); err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Could not log out all sessions", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+
+	success := response.ClientResponse(http.StatusOK, "All sessions of the user logged out", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// revokeUserSessions rejects every access token issued to the user so far and
+// revokes the user's refresh tokens so the sessions cannot be renewed
+func (ur *UserHandler) revokeUserSessions(userID int) error {
+	if err := ur.Revocations.RevokeAccount(helper.UserAccount(userID), helper.UserTokenLifetime); err != nil {
+		return err
+	}
+	return ur.GRPC_Client.RevokeUserSessions(userID)
+}
+
 // AddAddress handles the addition of a new address
 func (ur *UserHandler) AddAddress(c *gin.Context) {
 	var addressDetail models.Address
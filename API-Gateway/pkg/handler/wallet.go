@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetWallet returns the wallet balance of the logged in user
+func (or *OrderHandler) GetWallet(c *gin.Context) {
+	id, _ := c.Get("user_id")
+	wallet, err := or.GRPC_Client.GetWallet(id.(int))
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusInternalServerError, "Could not get the wallet", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Wallet", wallet, nil)
+	c.JSON(http.StatusOK, successRes)
+}
+
+// ListWalletTransactions lists the wallet ledger of the logged in user, newest first
+func (or *OrderHandler) ListWalletTransactions(c *gin.Context) {
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	id, _ := c.Get("user_id")
+	result, err := or.GRPC_Client.ListWalletTransactions(id.(int), page, count)
+	if err != nil {
+		errorRes := response.ClientResponse(http.StatusInternalServerError, "Could not get the wallet transactions", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errorRes)
+		return
+	}
+	successRes := response.ClientResponse(http.StatusOK, "Wallet transactions", result, nil)
+	c.JSON(http.StatusOK, successRes)
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"api-gateway/pkg/utils/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (ct *CartHandler) ListWishlist(c *gin.Context) {
+	page, count, ok := pagination(c)
+	if !ok {
+		return
+	}
+	userID, _ := c.Get("user_id")
+	items, err := ct.GRPC_Client.ListWishlist(userID.(int), page, count)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusInternalServerError, "Couldn't retrieve the wishlist", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Successfully retrieved the wishlist", items, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) AddToWishlist(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	item, err := ct.GRPC_Client.AddToWishlist(userID.(int), productID)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not add product to the wishlist", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Added product successfully to the wishlist", item, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+func (ct *CartHandler) RemoveFromWishlist(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	if err := ct.GRPC_Client.RemoveFromWishlist(userID.(int), productID); err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not remove product from the wishlist", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Removed product successfully from the wishlist", nil, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// MoveToCart puts a product of the wishlist in the cart, one unit unless a
+// quantity is given.
+func (ct *CartHandler) MoveToCart(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	quantity, err := strconv.Atoi(c.DefaultQuery("quantity", "1"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Quantity is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	cart, err := ct.GRPC_Client.MoveToCart(userID.(int), productID, quantity)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not move product to the cart", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Moved product successfully to the cart", cart, nil)
+	c.JSON(http.StatusOK, success)
+}
+
+// SaveForLater moves a product from the cart to the wishlist
+func (ct *CartHandler) SaveForLater(c *gin.Context) {
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "Product id is given in the wrong format", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	userID, _ := c.Get("user_id")
+	item, err := ct.GRPC_Client.SaveForLater(userID.(int), productID)
+	if err != nil {
+		errs := response.ClientResponse(http.StatusBadRequest, "could not save product for later", nil, err.Error())
+		c.JSON(http.StatusBadRequest, errs)
+		return
+	}
+	success := response.ClientResponse(http.StatusOK, "Saved product for later", item, nil)
+	c.JSON(http.StatusOK, success)
+}
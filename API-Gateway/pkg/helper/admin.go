@@ -2,6 +2,8 @@ package helper
 
 import (
 	"api-gateway/pkg/utils/models"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -22,12 +24,17 @@ type authCustomClaims struct {
 
 // GenerateToken generates a JWT token with role information.
 func GenerateToken(admin models.AdminDetailsResponse) (string, error) {
+	tokenID := make([]byte, 16)
+	if _, err := rand.Read(tokenID); err != nil {
+		return "", err
+	}
 	claims := &authCustomClaims{
 		Firstname: admin.Firstname,
 		Lastname:  admin.Lastname,
 		Email:     admin.Email,
 		Role:      "admin", // Assign role here
 		StandardClaims: jwt.StandardClaims{
+			Id:        hex.EncodeToString(tokenID), // jti, checked against the revocation store
 			ExpiresAt: time.Now().Add(48 * time.Hour).Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
@@ -14,6 +14,16 @@ func GetTokenFromHeader(header string) string {
 	return header
 }
 func ExtractUserIDFromToken(tokenString string) (int, string, error) {
+	claims, err := ParseUserToken(tokenString)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return claims.Id, claims.Email, nil
+}
+
+// ParseUserToken validates a user access token and returns its claims.
+func ParseUserToken(tokenString string) (*AuthUserClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &AuthUserClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("invalid signing method")
@@ -22,14 +32,18 @@ func ExtractUserIDFromToken(tokenString string) (int, string, error) {
 	})
 
 	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
 
 	claims, ok := token.Claims.(*AuthUserClaims)
 	if !ok {
-		return 0, "", fmt.Errorf("invalid token claims")
+		return nil, fmt.Errorf("invalid token claims")
+	}
+	// refresh tokens may only be exchanged at /user/token/refresh
+	if claims.TokenType == "refresh" {
+		return nil, fmt.Errorf("refresh token cannot be used for authentication")
 	}
 
-	return claims.Id, claims.Email, nil
+	return claims, nil
 
 }
@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Guest carts are identified by a cart token, sent in the GuestCartHeader
+// header or the GuestCartCookie cookie. The token is a random guest ID signed
+// with an HMAC, so shoppers cannot pick the ID of someone else's cart.
+const (
+	GuestCartHeader   = "X-Cart-Token"
+	GuestCartCookie   = "cart_token"
+	GuestCartLifetime = 30 * 24 * time.Hour
+)
+
+var ErrInvalidCartToken = errors.New("invalid cart token")
+
+// GuestCartSecret returns the key cart tokens are signed with. Without a
+// configured secret a random one is used, and cart tokens stop working when
+// the gateway restarts.
+func GuestCartSecret(configured string) []byte {
+	if configured != "" {
+		return []byte(configured)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatal("cannot generate a guest cart secret: ", err)
+	}
+	log.Println("GUEST_CART_SECRET is not set, guest carts will not survive a restart")
+	return secret
+}
+
+// NewGuestCartToken returns a new guest ID and the cart token for it.
+func NewGuestCartToken(secret []byte) (string, string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", err
+	}
+	guestID := hex.EncodeToString(id)
+	return guestID, guestID + "." + signGuestID(secret, guestID), nil
+}
+
+// ParseGuestCartToken checks the signature of a cart token and returns its
+// guest ID.
+func ParseGuestCartToken(secret []byte, token string) (string, error) {
+	guestID, signature, ok := strings.Cut(token, ".")
+	if !ok || guestID == "" {
+		return "", ErrInvalidCartToken
+	}
+	if !hmac.Equal([]byte(signature), []byte(signGuestID(secret, guestID))) {
+		return "", ErrInvalidCartToken
+	}
+	return guestID, nil
+}
+
+// GuestCartToken returns the cart token of a request, or an empty string when
+// it has none.
+func GuestCartToken(r *http.Request) string {
+	if token := r.Header.Get(GuestCartHeader); token != "" {
+		return token
+	}
+	if cookie, err := r.Cookie(GuestCartCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+func signGuestID(secret []byte, guestID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(guestID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
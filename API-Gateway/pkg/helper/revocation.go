@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"strconv"
+	"time"
+)
+
+// UserTokenLifetime and AdminTokenLifetime are how long the access tokens of
+// each kind of account stay valid. A revocation of every token of an account
+// only needs to be kept that long, after which the tokens it covers have
+// expired anyway.
+const (
+	UserTokenLifetime  = 15 * time.Minute
+	AdminTokenLifetime = 48 * time.Hour
+)
+
+// UserAccount and AdminAccount build the account keys used by the revocation store.
+func UserAccount(userID int) string {
+	return "user:" + strconv.Itoa(userID)
+}
+
+func AdminAccount(email string) string {
+	return "admin:" + email
+}
@@ -11,9 +11,10 @@ import (
 
 // AuthUserClaims represents the claims for user tokens
 type AuthUserClaims struct {
-	Id    int    `json:"id"`
-	Email string `json:"email"`
-	Role  string `json:"role"`
+	Id        int    `json:"id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	TokenType string `json:"token_type,omitempty"`
 	jwt.StandardClaims
 }
 
@@ -1,15 +1,17 @@
 package middleware
 
 import (
+	"api-gateway/pkg/client/interfaces"
 	"api-gateway/pkg/helper"
 	"api-gateway/pkg/utils/response"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 // AdminAuthMiddleware is a middleware for validating admin tokens.
-func AdminAuthMiddleware() gin.HandlerFunc {
+func AdminAuthMiddleware(revocations interfaces.RevocationClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenHeader := c.GetHeader("Authorization")
 		if tokenHeader == "" {
@@ -35,7 +37,26 @@ func AdminAuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Tokens are rejected when their iat is before the account cutoff; both
+		// are whole Unix seconds, so they are compared as they are.
+		revoked, err := revocations.IsRevoked(claims.StandardClaims.Id, helper.AdminAccount(claims.Email), claims.IssuedAt)
+		if err != nil {
+			resp := response.ClientResponse(http.StatusServiceUnavailable, "Could not verify token", nil, err.Error())
+			c.JSON(http.StatusServiceUnavailable, resp)
+			c.Abort()
+			return
+		}
+		if revoked {
+			resp := response.ClientResponse(http.StatusUnauthorized, "Invalid Token", nil, "token has been revoked")
+			c.JSON(http.StatusUnauthorized, resp)
+			c.Abort()
+			return
+		}
+
 		c.Set("user_role", claims.Role)
+		c.Set("admin_email", claims.Email)
+		c.Set("token_id", claims.StandardClaims.Id)
+		c.Set("token_expires_at", time.Unix(claims.ExpiresAt, 0))
 		c.Set("tokenClaims", claims)
 		c.Next()
 	}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"api-gateway/pkg/helper"
+	"api-gateway/pkg/utils/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GuestCartMiddleware identifies the guest cart of a request by its cart
+// token. A request without a token gets a new cart, and its token is sent
+// back in the X-Cart-Token header and the cart_token cookie.
+func GuestCartMiddleware(secret []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := helper.GuestCartToken(c.Request)
+		if token == "" {
+			guestID, newToken, err := helper.NewGuestCartToken(secret)
+			if err != nil {
+				response := response.ClientResponse(http.StatusInternalServerError, "Could not create a cart", nil, err.Error())
+				c.JSON(http.StatusInternalServerError, response)
+				c.Abort()
+				return
+			}
+			c.Header(helper.GuestCartHeader, newToken)
+			c.SetCookie(helper.GuestCartCookie, newToken, int(helper.GuestCartLifetime.Seconds()), "/", "", false, true)
+			c.Set("guest_id", guestID)
+			c.Next()
+			return
+		}
+
+		guestID, err := helper.ParseGuestCartToken(secret, token)
+		if err != nil {
+			response := response.ClientResponse(http.StatusUnauthorized, "Invalid cart token", nil, err.Error())
+			c.JSON(http.StatusUnauthorized, response)
+			c.Abort()
+			return
+		}
+		c.Set("guest_id", guestID)
+		c.Next()
+	}
+}
@@ -1,15 +1,17 @@
 package middleware
 
 import (
+	"api-gateway/pkg/client/interfaces"
 	"api-gateway/pkg/helper"
 	"api-gateway/pkg/utils/response"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 // UserAuthMiddleware ensures that a request is authenticated as a user
-func UserAuthMiddleware() gin.HandlerFunc {
+func UserAuthMiddleware(revocations interfaces.RevocationClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -24,7 +26,7 @@ func UserAuthMiddleware() gin.HandlerFunc {
 		}
 
 		tokenString := helper.GetTokenFromHeader(authHeader)
-		userID, userEmail, err := helper.ExtractUserIDFromToken(tokenString)
+		claims, err := helper.ParseUserToken(tokenString)
 		if err != nil {
 			response := response.ClientResponse(http.StatusUnauthorized, "Invalid Token", nil, err.Error())
 			c.JSON(http.StatusUnauthorized, response)
@@ -32,8 +34,26 @@ func UserAuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		c.Set("user_id", userID)
-		c.Set("user_email", userEmail)
+		// Tokens are rejected when their iat is before the account cutoff; both
+		// are whole Unix seconds, so they are compared as they are.
+		revoked, err := revocations.IsRevoked(claims.StandardClaims.Id, helper.UserAccount(claims.Id), claims.IssuedAt)
+		if err != nil {
+			response := response.ClientResponse(http.StatusServiceUnavailable, "Could not verify token", nil, err.Error())
+			c.JSON(http.StatusServiceUnavailable, response)
+			c.Abort()
+			return
+		}
+		if revoked {
+			response := response.ClientResponse(http.StatusUnauthorized, "Invalid Token", nil, "token has been revoked")
+			c.JSON(http.StatusUnauthorized, response)
+			c.Abort()
+			return
+		}
+
+		c.Set("user_id", claims.Id)
+		c.Set("user_email", claims.Email)
+		c.Set("token_id", claims.StandardClaims.Id)
+		c.Set("token_expires_at", time.Unix(claims.ExpiresAt, 0))
 		c.Next()
 	}
 }
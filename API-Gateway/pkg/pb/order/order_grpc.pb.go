@@ -19,8 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Order_OrderItemsFromCart_FullMethodName = "/order.Order/OrderItemsFromCart"
-	Order_GetOrderDetails_FullMethodName    = "/order.Order/GetOrderDetails"
+	Order_OrderItemsFromCart_FullMethodName     = "/order.Order/OrderItemsFromCart"
+	Order_GetOrderDetails_FullMethodName        = "/order.Order/GetOrderDetails"
+	Order_UpdateOrderStatus_FullMethodName      = "/order.Order/UpdateOrderStatus"
+	Order_GetOrderTimeline_FullMethodName       = "/order.Order/GetOrderTimeline"
+	Order_CancelOrder_FullMethodName            = "/order.Order/CancelOrder"
+	Order_RequestReturn_FullMethodName          = "/order.Order/RequestReturn"
+	Order_ListReturnRequests_FullMethodName     = "/order.Order/ListReturnRequests"
+	Order_ReviewReturnRequest_FullMethodName    = "/order.Order/ReviewReturnRequest"
+	Order_PayOrder_FullMethodName               = "/order.Order/PayOrder"
+	Order_GetOrderPayments_FullMethodName       = "/order.Order/GetOrderPayments"
+	Order_HandlePaymentWebhook_FullMethodName   = "/order.Order/HandlePaymentWebhook"
+	Order_SimulatePayment_FullMethodName        = "/order.Order/SimulatePayment"
+	Order_GetWallet_FullMethodName              = "/order.Order/GetWallet"
+	Order_ListWalletTransactions_FullMethodName = "/order.Order/ListWalletTransactions"
 )
 
 // OrderClient is the client API for Order service.
@@ -29,6 +41,18 @@ const (
 type OrderClient interface {
 	OrderItemsFromCart(ctx context.Context, in *OrderItemsFromCartRequest, opts ...grpc.CallOption) (*OrderItemsFromCartResponse, error)
 	GetOrderDetails(ctx context.Context, in *GetOrderDetailsRequest, opts ...grpc.CallOption) (*GetOrderDetailsResponse, error)
+	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
+	GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error)
+	ListReturnRequests(ctx context.Context, in *ListReturnRequestsRequest, opts ...grpc.CallOption) (*ListReturnRequestsResponse, error)
+	ReviewReturnRequest(ctx context.Context, in *ReviewReturnRequestRequest, opts ...grpc.CallOption) (*ReviewReturnRequestResponse, error)
+	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	GetOrderPayments(ctx context.Context, in *GetOrderPaymentsRequest, opts ...grpc.CallOption) (*GetOrderPaymentsResponse, error)
+	HandlePaymentWebhook(ctx context.Context, in *PaymentWebhookRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	SimulatePayment(ctx context.Context, in *SimulatePaymentRequest, opts ...grpc.CallOption) (*SimulatePaymentResponse, error)
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error)
 }
 
 type orderClient struct {
@@ -59,12 +83,144 @@ func (c *orderClient) GetOrderDetails(ctx context.Context, in *GetOrderDetailsRe
 	return out, nil
 }
 
+func (c *orderClient) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrderStatusResponse)
+	err := c.cc.Invoke(ctx, Order_UpdateOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderTimelineResponse)
+	err := c.cc.Invoke(ctx, Order_GetOrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, Order_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestReturnResponse)
+	err := c.cc.Invoke(ctx, Order_RequestReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListReturnRequests(ctx context.Context, in *ListReturnRequestsRequest, opts ...grpc.CallOption) (*ListReturnRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReturnRequestsResponse)
+	err := c.cc.Invoke(ctx, Order_ListReturnRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ReviewReturnRequest(ctx context.Context, in *ReviewReturnRequestRequest, opts ...grpc.CallOption) (*ReviewReturnRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewReturnRequestResponse)
+	err := c.cc.Invoke(ctx, Order_ReviewReturnRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, Order_PayOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetOrderPayments(ctx context.Context, in *GetOrderPaymentsRequest, opts ...grpc.CallOption) (*GetOrderPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderPaymentsResponse)
+	err := c.cc.Invoke(ctx, Order_GetOrderPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) HandlePaymentWebhook(ctx context.Context, in *PaymentWebhookRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, Order_HandlePaymentWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) SimulatePayment(ctx context.Context, in *SimulatePaymentRequest, opts ...grpc.CallOption) (*SimulatePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulatePaymentResponse)
+	err := c.cc.Invoke(ctx, Order_SimulatePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, Order_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletTransactionsResponse)
+	err := c.cc.Invoke(ctx, Order_ListWalletTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility.
 type OrderServer interface {
 	OrderItemsFromCart(context.Context, *OrderItemsFromCartRequest) (*OrderItemsFromCartResponse, error)
 	GetOrderDetails(context.Context, *GetOrderDetailsRequest) (*GetOrderDetailsResponse, error)
+	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error)
+	ListReturnRequests(context.Context, *ListReturnRequestsRequest) (*ListReturnRequestsResponse, error)
+	ReviewReturnRequest(context.Context, *ReviewReturnRequestRequest) (*ReviewReturnRequestResponse, error)
+	PayOrder(context.Context, *PayOrderRequest) (*PaymentResponse, error)
+	GetOrderPayments(context.Context, *GetOrderPaymentsRequest) (*GetOrderPaymentsResponse, error)
+	HandlePaymentWebhook(context.Context, *PaymentWebhookRequest) (*PaymentResponse, error)
+	SimulatePayment(context.Context, *SimulatePaymentRequest) (*SimulatePaymentResponse, error)
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -81,6 +237,42 @@ func (UnimplementedOrderServer) OrderItemsFromCart(context.Context, *OrderItemsF
 func (UnimplementedOrderServer) GetOrderDetails(context.Context, *GetOrderDetailsRequest) (*GetOrderDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderDetails not implemented")
 }
+func (UnimplementedOrderServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+}
+func (UnimplementedOrderServer) GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderTimeline not implemented")
+}
+func (UnimplementedOrderServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderServer) RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedOrderServer) ListReturnRequests(context.Context, *ListReturnRequestsRequest) (*ListReturnRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturnRequests not implemented")
+}
+func (UnimplementedOrderServer) ReviewReturnRequest(context.Context, *ReviewReturnRequestRequest) (*ReviewReturnRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewReturnRequest not implemented")
+}
+func (UnimplementedOrderServer) PayOrder(context.Context, *PayOrderRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+}
+func (UnimplementedOrderServer) GetOrderPayments(context.Context, *GetOrderPaymentsRequest) (*GetOrderPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderPayments not implemented")
+}
+func (UnimplementedOrderServer) HandlePaymentWebhook(context.Context, *PaymentWebhookRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePaymentWebhook not implemented")
+}
+func (UnimplementedOrderServer) SimulatePayment(context.Context, *SimulatePaymentRequest) (*SimulatePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePayment not implemented")
+}
+func (UnimplementedOrderServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedOrderServer) ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletTransactions not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 func (UnimplementedOrderServer) testEmbeddedByValue()               {}
 
@@ -138,6 +330,222 @@ func _Order_GetOrderDetails_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).UpdateOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_UpdateOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).UpdateOrderStatus(ctx, req.(*UpdateOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetOrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetOrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetOrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetOrderTimeline(ctx, req.(*GetOrderTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_RequestReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListReturnRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListReturnRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListReturnRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListReturnRequests(ctx, req.(*ListReturnRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ReviewReturnRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewReturnRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ReviewReturnRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ReviewReturnRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ReviewReturnRequest(ctx, req.(*ReviewReturnRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).PayOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_PayOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).PayOrder(ctx, req.(*PayOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetOrderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetOrderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetOrderPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetOrderPayments(ctx, req.(*GetOrderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_HandlePaymentWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).HandlePaymentWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_HandlePaymentWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).HandlePaymentWebhook(ctx, req.(*PaymentWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_SimulatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).SimulatePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_SimulatePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).SimulatePayment(ctx, req.(*SimulatePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListWalletTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListWalletTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListWalletTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListWalletTransactions(ctx, req.(*ListWalletTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +561,54 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrderDetails",
 			Handler:    _Order_GetOrderDetails_Handler,
 		},
+		{
+			MethodName: "UpdateOrderStatus",
+			Handler:    _Order_UpdateOrderStatus_Handler,
+		},
+		{
+			MethodName: "GetOrderTimeline",
+			Handler:    _Order_GetOrderTimeline_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Order_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _Order_RequestReturn_Handler,
+		},
+		{
+			MethodName: "ListReturnRequests",
+			Handler:    _Order_ListReturnRequests_Handler,
+		},
+		{
+			MethodName: "ReviewReturnRequest",
+			Handler:    _Order_ReviewReturnRequest_Handler,
+		},
+		{
+			MethodName: "PayOrder",
+			Handler:    _Order_PayOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderPayments",
+			Handler:    _Order_GetOrderPayments_Handler,
+		},
+		{
+			MethodName: "HandlePaymentWebhook",
+			Handler:    _Order_HandlePaymentWebhook_Handler,
+		},
+		{
+			MethodName: "SimulatePayment",
+			Handler:    _Order_SimulatePayment_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _Order_GetWallet_Handler,
+		},
+		{
+			MethodName: "ListWalletTransactions",
+			Handler:    _Order_ListWalletTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/order/order.proto",
@@ -22,11 +22,41 @@ const (
 	Product_AddProduct_FullMethodName               = "/product.Product/AddProduct"
 	Product_ListProducts_FullMethodName             = "/product.Product/ListProducts"
 	Product_UpdateProducts_FullMethodName           = "/product.Product/UpdateProducts"
+	Product_PatchProduct_FullMethodName             = "/product.Product/PatchProduct"
 	Product_DeleteProduct_FullMethodName            = "/product.Product/DeleteProduct"
+	Product_RestoreProduct_FullMethodName           = "/product.Product/RestoreProduct"
+	Product_GetProduct_FullMethodName               = "/product.Product/GetProduct"
+	Product_UploadProductImage_FullMethodName       = "/product.Product/UploadProductImage"
+	Product_ListProductImages_FullMethodName        = "/product.Product/ListProductImages"
+	Product_SetPrimaryImage_FullMethodName          = "/product.Product/SetPrimaryImage"
+	Product_ReorderProductImages_FullMethodName     = "/product.Product/ReorderProductImages"
+	Product_DeleteProductImage_FullMethodName       = "/product.Product/DeleteProductImage"
+	Product_SchedulePriceChange_FullMethodName      = "/product.Product/SchedulePriceChange"
+	Product_CancelPriceSchedule_FullMethodName      = "/product.Product/CancelPriceSchedule"
+	Product_ListPriceSchedules_FullMethodName       = "/product.Product/ListPriceSchedules"
+	Product_ListPriceHistory_FullMethodName         = "/product.Product/ListPriceHistory"
 	Product_GetQuantityFromProductID_FullMethodName = "/product.Product/GetQuantityFromProductID"
 	Product_GetPriceofProductFromID_FullMethodName  = "/product.Product/GetPriceofProductFromID"
 	Product_ProductStockMinus_FullMethodName        = "/product.Product/ProductStockMinus"
+	Product_ProductStockPlus_FullMethodName         = "/product.Product/ProductStockPlus"
+	Product_ProductStockMinusBulk_FullMethodName    = "/product.Product/ProductStockMinusBulk"
+	Product_ProductStockPlusBulk_FullMethodName     = "/product.Product/ProductStockPlusBulk"
+	Product_ReserveStock_FullMethodName             = "/product.Product/ReserveStock"
+	Product_ReleaseStock_FullMethodName             = "/product.Product/ReleaseStock"
+	Product_CommitReservation_FullMethodName        = "/product.Product/CommitReservation"
+	Product_ListStockMovements_FullMethodName       = "/product.Product/ListStockMovements"
+	Product_ReconcileStock_FullMethodName           = "/product.Product/ReconcileStock"
 	Product_CheckProduct_FullMethodName             = "/product.Product/CheckProduct"
+	Product_AddCategory_FullMethodName              = "/product.Product/AddCategory"
+	Product_UpdateCategory_FullMethodName           = "/product.Product/UpdateCategory"
+	Product_DeleteCategory_FullMethodName           = "/product.Product/DeleteCategory"
+	Product_ListCategories_FullMethodName           = "/product.Product/ListCategories"
+	Product_GetCategoryTree_FullMethodName          = "/product.Product/GetCategoryTree"
+	Product_AddOffer_FullMethodName                 = "/product.Product/AddOffer"
+	Product_UpdateOffer_FullMethodName              = "/product.Product/UpdateOffer"
+	Product_ListOffers_FullMethodName               = "/product.Product/ListOffers"
+	Product_DeleteOffer_FullMethodName              = "/product.Product/DeleteOffer"
+	Product_GetProductOffers_FullMethodName         = "/product.Product/GetProductOffers"
 )
 
 // ProductClient is the client API for Product service.
@@ -36,11 +66,41 @@ type ProductClient interface {
 	AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error)
 	ListProducts(ctx context.Context, in *ListProductRequest, opts ...grpc.CallOption) (*ListProductResponse, error)
 	UpdateProducts(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
+	PatchProduct(ctx context.Context, in *PatchProductRequest, opts ...grpc.CallOption) (*PatchProductResponse, error)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	RestoreProduct(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	GetProduct(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	UploadProductImage(ctx context.Context, in *UploadProductImageRequest, opts ...grpc.CallOption) (*ProductImageResponse, error)
+	ListProductImages(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error)
+	SetPrimaryImage(ctx context.Context, in *ProductImageRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error)
+	ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error)
+	DeleteProductImage(ctx context.Context, in *ProductImageRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceRequest, opts ...grpc.CallOption) (*PriceScheduleResponse, error)
+	CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*PriceScheduleResponse, error)
+	ListPriceSchedules(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error)
+	ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error)
 	GetQuantityFromProductID(ctx context.Context, in *GetQuantityFromProductIDRequest, opts ...grpc.CallOption) (*GetQuantityFromProductIDResponse, error)
 	GetPriceofProductFromID(ctx context.Context, in *GetPriceofProductFromIDRequest, opts ...grpc.CallOption) (*GetPriceofProductFromIDResponse, error)
 	ProductStockMinus(ctx context.Context, in *ProductStockMinusRequest, opts ...grpc.CallOption) (*ProductStockMinusReponse, error)
+	ProductStockPlus(ctx context.Context, in *ProductStockPlusRequest, opts ...grpc.CallOption) (*ProductStockPlusResponse, error)
+	ProductStockMinusBulk(ctx context.Context, in *ProductStockBulkRequest, opts ...grpc.CallOption) (*ProductStockBulkResponse, error)
+	ProductStockPlusBulk(ctx context.Context, in *ProductStockBulkRequest, opts ...grpc.CallOption) (*ProductStockBulkResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
+	ReleaseStock(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
+	CommitReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error)
 	CheckProduct(ctx context.Context, in *CheckProductRequest, opts ...grpc.CallOption) (*CheckProductResponse, error)
+	AddCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	UpdateCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	GetCategoryTree(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*CategoryTreeResponse, error)
+	AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error)
+	UpdateOffer(ctx context.Context, in *UpdateOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error)
+	ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error)
+	DeleteOffer(ctx context.Context, in *DeleteOfferRequest, opts ...grpc.CallOption) (*DeleteOfferResponse, error)
+	GetProductOffers(ctx context.Context, in *ProductOffersRequest, opts ...grpc.CallOption) (*ProductOffersResponse, error)
 }
 
 type productClient struct {
@@ -81,6 +141,16 @@ func (c *productClient) UpdateProducts(ctx context.Context, in *UpdateProductReq
 	return out, nil
 }
 
+func (c *productClient) PatchProduct(ctx context.Context, in *PatchProductRequest, opts ...grpc.CallOption) (*PatchProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchProductResponse)
+	err := c.cc.Invoke(ctx, Product_PatchProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteProductResponse)
@@ -91,6 +161,116 @@ func (c *productClient) DeleteProduct(ctx context.Context, in *DeleteProductRequ
 	return out, nil
 }
 
+func (c *productClient) RestoreProduct(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetProduct(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_GetProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UploadProductImage(ctx context.Context, in *UploadProductImageRequest, opts ...grpc.CallOption) (*ProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImageResponse)
+	err := c.cc.Invoke(ctx, Product_UploadProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListProductImages(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImagesResponse)
+	err := c.cc.Invoke(ctx, Product_ListProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetPrimaryImage(ctx context.Context, in *ProductImageRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImagesResponse)
+	err := c.cc.Invoke(ctx, Product_SetPrimaryImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImagesResponse)
+	err := c.cc.Invoke(ctx, Product_ReorderProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteProductImage(ctx context.Context, in *ProductImageRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImagesResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceRequest, opts ...grpc.CallOption) (*PriceScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceScheduleResponse)
+	err := c.cc.Invoke(ctx, Product_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*PriceScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceScheduleResponse)
+	err := c.cc.Invoke(ctx, Product_CancelPriceSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListPriceSchedules(ctx context.Context, in *ProductIDRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceSchedulesResponse)
+	err := c.cc.Invoke(ctx, Product_ListPriceSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Product_ListPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productClient) GetQuantityFromProductID(ctx context.Context, in *GetQuantityFromProductIDRequest, opts ...grpc.CallOption) (*GetQuantityFromProductIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetQuantityFromProductIDResponse)
@@ -121,6 +301,86 @@ func (c *productClient) ProductStockMinus(ctx context.Context, in *ProductStockM
 	return out, nil
 }
 
+func (c *productClient) ProductStockPlus(ctx context.Context, in *ProductStockPlusRequest, opts ...grpc.CallOption) (*ProductStockPlusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductStockPlusResponse)
+	err := c.cc.Invoke(ctx, Product_ProductStockPlus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ProductStockMinusBulk(ctx context.Context, in *ProductStockBulkRequest, opts ...grpc.CallOption) (*ProductStockBulkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductStockBulkResponse)
+	err := c.cc.Invoke(ctx, Product_ProductStockMinusBulk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ProductStockPlusBulk(ctx context.Context, in *ProductStockBulkRequest, opts ...grpc.CallOption) (*ProductStockBulkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductStockBulkResponse)
+	err := c.cc.Invoke(ctx, Product_ProductStockPlusBulk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservationResponse)
+	err := c.cc.Invoke(ctx, Product_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReleaseStock(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*ReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservationResponse)
+	err := c.cc.Invoke(ctx, Product_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CommitReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*ReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservationResponse)
+	err := c.cc.Invoke(ctx, Product_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, Product_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileStockResponse)
+	err := c.cc.Invoke(ctx, Product_ReconcileStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productClient) CheckProduct(ctx context.Context, in *CheckProductRequest, opts ...grpc.CallOption) (*CheckProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckProductResponse)
@@ -131,6 +391,106 @@ func (c *productClient) CheckProduct(ctx context.Context, in *CheckProductReques
 	return out, nil
 }
 
+func (c *productClient) AddCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, Product_AddCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UpdateCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, Product_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, Product_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetCategoryTree(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*CategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryTreeResponse)
+	err := c.cc.Invoke(ctx, Product_GetCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferResponse)
+	err := c.cc.Invoke(ctx, Product_AddOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UpdateOffer(ctx context.Context, in *UpdateOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferResponse)
+	err := c.cc.Invoke(ctx, Product_UpdateOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOffersResponse)
+	err := c.cc.Invoke(ctx, Product_ListOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteOffer(ctx context.Context, in *DeleteOfferRequest, opts ...grpc.CallOption) (*DeleteOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOfferResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetProductOffers(ctx context.Context, in *ProductOffersRequest, opts ...grpc.CallOption) (*ProductOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductOffersResponse)
+	err := c.cc.Invoke(ctx, Product_GetProductOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServer is the server API for Product service.
 // All implementations must embed UnimplementedProductServer
 // for forward compatibility.
@@ -138,11 +498,41 @@ type ProductServer interface {
 	AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error)
 	ListProducts(context.Context, *ListProductRequest) (*ListProductResponse, error)
 	UpdateProducts(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	PatchProduct(context.Context, *PatchProductRequest) (*PatchProductResponse, error)
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	RestoreProduct(context.Context, *ProductIDRequest) (*ProductResponse, error)
+	GetProduct(context.Context, *ProductIDRequest) (*ProductResponse, error)
+	UploadProductImage(context.Context, *UploadProductImageRequest) (*ProductImageResponse, error)
+	ListProductImages(context.Context, *ProductIDRequest) (*ProductImagesResponse, error)
+	SetPrimaryImage(context.Context, *ProductImageRequest) (*ProductImagesResponse, error)
+	ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ProductImagesResponse, error)
+	DeleteProductImage(context.Context, *ProductImageRequest) (*ProductImagesResponse, error)
+	SchedulePriceChange(context.Context, *SchedulePriceRequest) (*PriceScheduleResponse, error)
+	CancelPriceSchedule(context.Context, *CancelPriceScheduleRequest) (*PriceScheduleResponse, error)
+	ListPriceSchedules(context.Context, *ProductIDRequest) (*ListPriceSchedulesResponse, error)
+	ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error)
 	GetQuantityFromProductID(context.Context, *GetQuantityFromProductIDRequest) (*GetQuantityFromProductIDResponse, error)
 	GetPriceofProductFromID(context.Context, *GetPriceofProductFromIDRequest) (*GetPriceofProductFromIDResponse, error)
 	ProductStockMinus(context.Context, *ProductStockMinusRequest) (*ProductStockMinusReponse, error)
+	ProductStockPlus(context.Context, *ProductStockPlusRequest) (*ProductStockPlusResponse, error)
+	ProductStockMinusBulk(context.Context, *ProductStockBulkRequest) (*ProductStockBulkResponse, error)
+	ProductStockPlusBulk(context.Context, *ProductStockBulkRequest) (*ProductStockBulkResponse, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReservationResponse, error)
+	ReleaseStock(context.Context, *ReservationRequest) (*ReservationResponse, error)
+	CommitReservation(context.Context, *ReservationRequest) (*ReservationResponse, error)
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error)
 	CheckProduct(context.Context, *CheckProductRequest) (*CheckProductResponse, error)
+	AddCategory(context.Context, *CategoryRequest) (*CategoryResponse, error)
+	UpdateCategory(context.Context, *CategoryRequest) (*CategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	GetCategoryTree(context.Context, *ListCategoriesRequest) (*CategoryTreeResponse, error)
+	AddOffer(context.Context, *AddOfferRequest) (*OfferResponse, error)
+	UpdateOffer(context.Context, *UpdateOfferRequest) (*OfferResponse, error)
+	ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error)
+	DeleteOffer(context.Context, *DeleteOfferRequest) (*DeleteOfferResponse, error)
+	GetProductOffers(context.Context, *ProductOffersRequest) (*ProductOffersResponse, error)
 	mustEmbedUnimplementedProductServer()
 }
 
@@ -162,9 +552,45 @@ func (UnimplementedProductServer) ListProducts(context.Context, *ListProductRequ
 func (UnimplementedProductServer) UpdateProducts(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProducts not implemented")
 }
+func (UnimplementedProductServer) PatchProduct(context.Context, *PatchProductRequest) (*PatchProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchProduct not implemented")
+}
 func (UnimplementedProductServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedProductServer) RestoreProduct(context.Context, *ProductIDRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServer) GetProduct(context.Context, *ProductIDRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServer) UploadProductImage(context.Context, *UploadProductImageRequest) (*ProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadProductImage not implemented")
+}
+func (UnimplementedProductServer) ListProductImages(context.Context, *ProductIDRequest) (*ProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductImages not implemented")
+}
+func (UnimplementedProductServer) SetPrimaryImage(context.Context, *ProductImageRequest) (*ProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryImage not implemented")
+}
+func (UnimplementedProductServer) ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderProductImages not implemented")
+}
+func (UnimplementedProductServer) DeleteProductImage(context.Context, *ProductImageRequest) (*ProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductImage not implemented")
+}
+func (UnimplementedProductServer) SchedulePriceChange(context.Context, *SchedulePriceRequest) (*PriceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServer) CancelPriceSchedule(context.Context, *CancelPriceScheduleRequest) (*PriceScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPriceSchedule not implemented")
+}
+func (UnimplementedProductServer) ListPriceSchedules(context.Context, *ProductIDRequest) (*ListPriceSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceSchedules not implemented")
+}
+func (UnimplementedProductServer) ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
 func (UnimplementedProductServer) GetQuantityFromProductID(context.Context, *GetQuantityFromProductIDRequest) (*GetQuantityFromProductIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuantityFromProductID not implemented")
 }
@@ -174,9 +600,63 @@ func (UnimplementedProductServer) GetPriceofProductFromID(context.Context, *GetP
 func (UnimplementedProductServer) ProductStockMinus(context.Context, *ProductStockMinusRequest) (*ProductStockMinusReponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProductStockMinus not implemented")
 }
+func (UnimplementedProductServer) ProductStockPlus(context.Context, *ProductStockPlusRequest) (*ProductStockPlusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProductStockPlus not implemented")
+}
+func (UnimplementedProductServer) ProductStockMinusBulk(context.Context, *ProductStockBulkRequest) (*ProductStockBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProductStockMinusBulk not implemented")
+}
+func (UnimplementedProductServer) ProductStockPlusBulk(context.Context, *ProductStockBulkRequest) (*ProductStockBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProductStockPlusBulk not implemented")
+}
+func (UnimplementedProductServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedProductServer) ReleaseStock(context.Context, *ReservationRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedProductServer) CommitReservation(context.Context, *ReservationRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedProductServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedProductServer) ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStock not implemented")
+}
 func (UnimplementedProductServer) CheckProduct(context.Context, *CheckProductRequest) (*CheckProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckProduct not implemented")
 }
+func (UnimplementedProductServer) AddCategory(context.Context, *CategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCategory not implemented")
+}
+func (UnimplementedProductServer) UpdateCategory(context.Context, *CategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedProductServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServer) GetCategoryTree(context.Context, *ListCategoriesRequest) (*CategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedProductServer) AddOffer(context.Context, *AddOfferRequest) (*OfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOffer not implemented")
+}
+func (UnimplementedProductServer) UpdateOffer(context.Context, *UpdateOfferRequest) (*OfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOffer not implemented")
+}
+func (UnimplementedProductServer) ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOffers not implemented")
+}
+func (UnimplementedProductServer) DeleteOffer(context.Context, *DeleteOfferRequest) (*DeleteOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOffer not implemented")
+}
+func (UnimplementedProductServer) GetProductOffers(context.Context, *ProductOffersRequest) (*ProductOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductOffers not implemented")
+}
 func (UnimplementedProductServer) mustEmbedUnimplementedProductServer() {}
 func (UnimplementedProductServer) testEmbeddedByValue()                 {}
 
@@ -252,43 +732,259 @@ func _Product_UpdateProducts_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _Product_PatchProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).DeleteProduct(ctx, in)
+		return srv.(ProductServer).PatchProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_DeleteProduct_FullMethodName,
+		FullMethod: Product_PatchProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(ProductServer).PatchProduct(ctx, req.(*PatchProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_GetQuantityFromProductID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQuantityFromProductIDRequest)
+func _Product_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).GetQuantityFromProductID(ctx, in)
+		return srv.(ProductServer).DeleteProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_GetQuantityFromProductID_FullMethodName,
+		FullMethod: Product_DeleteProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).GetQuantityFromProductID(ctx, req.(*GetQuantityFromProductIDRequest))
+		return srv.(ProductServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_GetPriceofProductFromID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Product_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).RestoreProduct(ctx, req.(*ProductIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProduct(ctx, req.(*ProductIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UploadProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UploadProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UploadProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UploadProductImage(ctx, req.(*UploadProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListProductImages(ctx, req.(*ProductIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetPrimaryImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetPrimaryImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetPrimaryImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetPrimaryImage(ctx, req.(*ProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteProductImage(ctx, req.(*ProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SchedulePriceChange(ctx, req.(*SchedulePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CancelPriceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPriceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CancelPriceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CancelPriceSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CancelPriceSchedule(ctx, req.(*CancelPriceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListPriceSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListPriceSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListPriceSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListPriceSchedules(ctx, req.(*ProductIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListPriceHistory(ctx, req.(*ListPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetQuantityFromProductID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuantityFromProductIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetQuantityFromProductID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetQuantityFromProductID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetQuantityFromProductID(ctx, req.(*GetQuantityFromProductIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetPriceofProductFromID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPriceofProductFromIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -324,6 +1020,150 @@ func _Product_ProductStockMinus_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Product_ProductStockPlus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductStockPlusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ProductStockPlus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ProductStockPlus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ProductStockPlus(ctx, req.(*ProductStockPlusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ProductStockMinusBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductStockBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ProductStockMinusBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ProductStockMinusBulk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ProductStockMinusBulk(ctx, req.(*ProductStockBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ProductStockPlusBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductStockBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ProductStockPlusBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ProductStockPlusBulk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ProductStockPlusBulk(ctx, req.(*ProductStockBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReleaseStock(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CommitReservation(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReconcileStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReconcileStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReconcileStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReconcileStock(ctx, req.(*ReconcileStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Product_CheckProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckProductRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +1182,186 @@ func _Product_CheckProduct_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Product_AddCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).AddCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_AddCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).AddCategory(ctx, req.(*CategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateCategory(ctx, req.(*CategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetCategoryTree(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_AddOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).AddOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_AddOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).AddOffer(ctx, req.(*AddOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateOffer(ctx, req.(*UpdateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListOffers(ctx, req.(*ListOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteOffer(ctx, req.(*DeleteOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetProductOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetProductOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProductOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProductOffers(ctx, req.(*ProductOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Product_ServiceDesc is the grpc.ServiceDesc for Product service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,10 +1381,58 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProducts",
 			Handler:    _Product_UpdateProducts_Handler,
 		},
+		{
+			MethodName: "PatchProduct",
+			Handler:    _Product_PatchProduct_Handler,
+		},
 		{
 			MethodName: "DeleteProduct",
 			Handler:    _Product_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _Product_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _Product_GetProduct_Handler,
+		},
+		{
+			MethodName: "UploadProductImage",
+			Handler:    _Product_UploadProductImage_Handler,
+		},
+		{
+			MethodName: "ListProductImages",
+			Handler:    _Product_ListProductImages_Handler,
+		},
+		{
+			MethodName: "SetPrimaryImage",
+			Handler:    _Product_SetPrimaryImage_Handler,
+		},
+		{
+			MethodName: "ReorderProductImages",
+			Handler:    _Product_ReorderProductImages_Handler,
+		},
+		{
+			MethodName: "DeleteProductImage",
+			Handler:    _Product_DeleteProductImage_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _Product_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelPriceSchedule",
+			Handler:    _Product_CancelPriceSchedule_Handler,
+		},
+		{
+			MethodName: "ListPriceSchedules",
+			Handler:    _Product_ListPriceSchedules_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _Product_ListPriceHistory_Handler,
+		},
 		{
 			MethodName: "GetQuantityFromProductID",
 			Handler:    _Product_GetQuantityFromProductID_Handler,
@@ -377,10 +1445,82 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProductStockMinus",
 			Handler:    _Product_ProductStockMinus_Handler,
 		},
+		{
+			MethodName: "ProductStockPlus",
+			Handler:    _Product_ProductStockPlus_Handler,
+		},
+		{
+			MethodName: "ProductStockMinusBulk",
+			Handler:    _Product_ProductStockMinusBulk_Handler,
+		},
+		{
+			MethodName: "ProductStockPlusBulk",
+			Handler:    _Product_ProductStockPlusBulk_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _Product_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _Product_ReleaseStock_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _Product_CommitReservation_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _Product_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "ReconcileStock",
+			Handler:    _Product_ReconcileStock_Handler,
+		},
 		{
 			MethodName: "CheckProduct",
 			Handler:    _Product_CheckProduct_Handler,
 		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _Product_AddCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _Product_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Product_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _Product_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _Product_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "AddOffer",
+			Handler:    _Product_AddOffer_Handler,
+		},
+		{
+			MethodName: "UpdateOffer",
+			Handler:    _Product_UpdateOffer_Handler,
+		},
+		{
+			MethodName: "ListOffers",
+			Handler:    _Product_ListOffers_Handler,
+		},
+		{
+			MethodName: "DeleteOffer",
+			Handler:    _Product_DeleteOffer_Handler,
+		},
+		{
+			MethodName: "GetProductOffers",
+			Handler:    _Product_GetProductOffers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/product/product.proto",
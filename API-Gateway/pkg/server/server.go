@@ -1,6 +1,7 @@
 package server
 
 import (
+	"api-gateway/pkg/client/interfaces"
 	"api-gateway/pkg/handler"
 	"api-gateway/pkg/middleware"
 	"log"
@@ -13,7 +14,7 @@ type ServerHTTP struct {
 }
 
 // NewServerHTTP initializes the server with routes and handlers
-func NewServerHTTP(adminHandler *handler.AdminHandler, productHandler *handler.ProductHandler, userHandler *handler.UserHandler, cartHandler *handler.CartHandler, orderHandler *handler.OrderHandler) *ServerHTTP {
+func NewServerHTTP(revocations interfaces.RevocationClient, guestCartSecret []byte, adminHandler *handler.AdminHandler, productHandler *handler.ProductHandler, userHandler *handler.UserHandler, cartHandler *handler.CartHandler, orderHandler *handler.OrderHandler) *ServerHTTP {
 	router := gin.New()
 
 	router.Use(gin.Logger())
@@ -24,25 +25,96 @@ func NewServerHTTP(adminHandler *handler.AdminHandler, productHandler *handler.P
 	router.POST("/admin/signup", adminHandler.AdminSignUp)
 	router.POST("/user/signup", userHandler.UserSignup)
 	router.POST("/user/login", userHandler.Userlogin)
+	router.POST("/user/token/refresh", userHandler.RefreshToken)
 	router.GET("/product", productHandler.ShowAllProducts)
+	router.GET("/product/:id", productHandler.GetProduct)
+	router.GET("/product/:id/images", productHandler.ListProductImages)
+	router.GET("/category", productHandler.GetCategoryTree)
+	router.POST("/payment/webhook/:provider", orderHandler.PaymentWebhook)
+
+	// Guest cart routes, for shoppers who are not logged in
+	guestRoutes := router.Group("/guest")
+	guestRoutes.Use(middleware.GuestCartMiddleware(guestCartSecret))
+	{
+		guestRoutes.POST("/cart", cartHandler.AddToGuestCart)
+		guestRoutes.GET("/cart", cartHandler.GetGuestCart)
+		guestRoutes.PUT("/cart", cartHandler.UpdateGuestCartItemQuantity)
+		guestRoutes.DELETE("/cart", cartHandler.RemoveFromGuestCart)
+	}
 
 	// Admin routes
 	adminRoutes := router.Group("/")
-	adminRoutes.Use(middleware.AdminAuthMiddleware())
+	adminRoutes.Use(middleware.AdminAuthMiddleware(revocations))
 	{
+		adminRoutes.POST("/admin/logout", adminHandler.Logout)
+		adminRoutes.POST("/admin/logout/all", adminHandler.LogoutAll)
+		adminRoutes.POST("/admin/users/:id/logout", userHandler.RevokeUserSessions)
+
 		adminRoutes.POST("/product", productHandler.AddProducts)
 		adminRoutes.DELETE("/product", productHandler.DeleteProduct)
 		adminRoutes.PUT("/product", productHandler.UpdateProducts)
+		adminRoutes.PATCH("/product/:id", productHandler.PatchProduct)
+		adminRoutes.GET("/admin/product", productHandler.AdminListProducts)
+		adminRoutes.POST("/admin/product/:id/restore", productHandler.RestoreProduct)
+		adminRoutes.POST("/admin/product/:id/images", productHandler.UploadProductImages)
+		adminRoutes.PUT("/admin/product/:id/images/order", productHandler.ReorderProductImages)
+		adminRoutes.PUT("/admin/product/:id/images/:image_id/primary", productHandler.SetPrimaryImage)
+		adminRoutes.DELETE("/admin/product/:id/images/:image_id", productHandler.DeleteProductImage)
+		adminRoutes.POST("/admin/product/:id/price-schedules", productHandler.SchedulePriceChange)
+		adminRoutes.GET("/admin/product/:id/price-schedules", productHandler.ListPriceSchedules)
+		adminRoutes.GET("/admin/product/:id/price-history", productHandler.ListPriceHistory)
+		adminRoutes.DELETE("/admin/price-schedules/:id", productHandler.CancelPriceSchedule)
+		adminRoutes.GET("/admin/inventory/movements", productHandler.ListStockMovements)
+		adminRoutes.POST("/admin/inventory/reconcile", productHandler.ReconcileStock)
+		adminRoutes.GET("/admin/category", productHandler.ListCategories)
+		adminRoutes.POST("/admin/category", productHandler.AddCategory)
+		adminRoutes.PUT("/admin/category/:id", productHandler.UpdateCategory)
+		adminRoutes.DELETE("/admin/category/:id", productHandler.DeleteCategory)
+		adminRoutes.GET("/admin/offer", productHandler.ListOffers)
+		adminRoutes.POST("/admin/offer", productHandler.AddOffer)
+		adminRoutes.PUT("/admin/offer/:id", productHandler.UpdateOffer)
+		adminRoutes.DELETE("/admin/offer/:id", productHandler.DeleteOffer)
+
+		adminRoutes.GET("/admin/coupon", cartHandler.ListCoupons)
+		adminRoutes.POST("/admin/coupon", cartHandler.AddCoupon)
+		adminRoutes.PUT("/admin/coupon/:id", cartHandler.UpdateCoupon)
+		adminRoutes.DELETE("/admin/coupon/:id", cartHandler.DeleteCoupon)
+
+		adminRoutes.PUT("/admin/order/:id/status", orderHandler.UpdateOrderStatus)
+		adminRoutes.GET("/admin/returns", orderHandler.ListReturnRequests)
+		adminRoutes.PUT("/admin/returns/:id", orderHandler.ReviewReturnRequest)
+		adminRoutes.POST("/admin/payments/:id/simulate", orderHandler.SimulatePayment)
 	}
 
 	// User routes
 	userRoutes := router.Group("/")
-	userRoutes.Use(middleware.UserAuthMiddleware())
+	userRoutes.Use(middleware.UserAuthMiddleware(revocations))
 	{
+		userRoutes.POST("/user/logout", userHandler.Logout)
+		userRoutes.POST("/user/logout/all", userHandler.LogoutAll)
+
 		userRoutes.POST("/cart", cartHandler.AddToCart)
 		userRoutes.GET("/cart", cartHandler.GetCart)
+		userRoutes.PUT("/cart", cartHandler.UpdateCartItemQuantity)
+		userRoutes.DELETE("/cart", cartHandler.RemoveFromCart)
+		userRoutes.DELETE("/cart/all", cartHandler.ClearCart)
+		userRoutes.POST("/cart/coupon", cartHandler.ApplyCoupon)
+		userRoutes.DELETE("/cart/coupon", cartHandler.RemoveCoupon)
+		userRoutes.POST("/cart/save-for-later", cartHandler.SaveForLater)
+		userRoutes.GET("/wishlist", cartHandler.ListWishlist)
+		userRoutes.POST("/wishlist", cartHandler.AddToWishlist)
+		userRoutes.DELETE("/wishlist", cartHandler.RemoveFromWishlist)
+		userRoutes.POST("/wishlist/cart", cartHandler.MoveToCart)
 		userRoutes.POST("/order", orderHandler.OrderItemsFromCart)
 		userRoutes.GET("/order", orderHandler.GetOrderDetails)
+		userRoutes.GET("/order/:id/timeline", orderHandler.GetOrderTimeline)
+		userRoutes.POST("/order/:id/cancel", orderHandler.CancelOrder)
+		userRoutes.POST("/order/:id/return", orderHandler.RequestReturn)
+		userRoutes.POST("/order/:id/pay", orderHandler.PayOrder)
+		userRoutes.GET("/order/:id/payments", orderHandler.GetOrderPayments)
+		userRoutes.GET("/wallet", orderHandler.GetWallet)
+		userRoutes.GET("/wallet/transactions", orderHandler.ListWalletTransactions)
+		userRoutes.GET("/user/returns", orderHandler.GetReturnRequests)
 
 		// Address routes
 		userRoutes.POST("/address", userHandler.AddAddress)
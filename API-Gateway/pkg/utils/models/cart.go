@@ -1,8 +1,16 @@
 package models
 
+import "time"
+
 type CartResponse struct {
-	TotalPrice float64
-	Cart       []Cart
+	TotalPrice    float64
+	OfferDiscount float64
+	Discount      float64
+	FinalPrice    float64
+	CouponCode    string
+	CouponNotice  string
+	PriceNotices  []string
+	Cart          []Cart
 }
 type CartTotal struct {
 	TotalPrice     float64 `json:"total_price"`
@@ -10,9 +18,27 @@ type CartTotal struct {
 	DiscountReason string
 }
 type Cart struct {
-	ProductID  uint    `json:"product_id"`
-	Quantity   float64 `json:"quantity"`
-	TotalPrice float64 `json:"total_price"`
+	ProductID    uint    `json:"product_id"`
+	Quantity     float64 `json:"quantity"`
+	UnitPrice    float64 `json:"unit_price"`
+	AddedPrice   float64 `json:"added_price"`
+	PriceChanged bool    `json:"price_changed"`
+	TotalPrice   float64 `json:"total_price"`
+	Discount     float64 `json:"discount"`
+	FinalPrice   float64 `json:"final_price"`
+	Offer        string  `json:"offer,omitempty"`
+	HeldUntil    string  `json:"held_until,omitempty"`
+}
+
+// WishlistItem is a saved product with its current price and stock status.
+type WishlistItem struct {
+	ProductID    uint    `json:"product_id"`
+	Price        float64 `json:"price"`
+	AddedPrice   float64 `json:"added_price"`
+	PriceChanged bool    `json:"price_changed"`
+	InStock      bool    `json:"in_stock"`
+	StockStatus  string  `json:"stock_status"`
+	AddedAt      string  `json:"added_at"`
 }
 
 type Carts struct {
@@ -33,3 +59,40 @@ type AddCart struct {
 	ProductID int `json:"product_id"`
 	Quantity  int `json:"quantity"`
 }
+
+type ApplyCoupon struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// CouponInput creates or replaces a coupon. A zero max_discount, usage_limit
+// or per_user_limit means no limit, and a coupon without valid_until does not
+// expire. Coupons are active unless active is false.
+type CouponInput struct {
+	Code          string     `json:"code" validate:"required"`
+	Description   string     `json:"description"`
+	DiscountType  string     `json:"discount_type" validate:"required,oneof=percentage flat"`
+	DiscountValue float64    `json:"discount_value" validate:"required,gt=0"`
+	MinCartValue  float64    `json:"min_cart_value" validate:"gte=0"`
+	MaxDiscount   float64    `json:"max_discount" validate:"gte=0"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	UsageLimit    int        `json:"usage_limit" validate:"gte=0"`
+	PerUserLimit  int        `json:"per_user_limit" validate:"gte=0"`
+	Active        *bool      `json:"active"`
+}
+
+type Coupon struct {
+	ID            int     `json:"id"`
+	Code          string  `json:"code"`
+	Description   string  `json:"description"`
+	DiscountType  string  `json:"discount_type"`
+	DiscountValue float64 `json:"discount_value"`
+	MinCartValue  float64 `json:"min_cart_value"`
+	MaxDiscount   float64 `json:"max_discount"`
+	ValidFrom     string  `json:"valid_from"`
+	ValidUntil    string  `json:"valid_until,omitempty"`
+	UsageLimit    int     `json:"usage_limit"`
+	PerUserLimit  int     `json:"per_user_limit"`
+	Active        bool    `json:"active"`
+	CreatedBy     string  `json:"created_by"`
+}
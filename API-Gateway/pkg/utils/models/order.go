@@ -3,6 +3,8 @@ package models
 type OrderDetails struct {
 	OrderId        int
 	FinalPrice     float64
+	Discount       float64
+	CouponCode     string
 	ShipmentStatus string
 	PaymentStatus  string
 }
@@ -37,6 +39,95 @@ type OrderFromCart struct {
 }
 
 type OrderSuccessResponse struct {
-	OrderID        uint   `json:"order_id"`
-	ShipmentStatus string `json:"shipment_status"`
+	OrderID        uint     `json:"order_id"`
+	ShipmentStatus string   `json:"shipment_status"`
+	PaymentStatus  string   `json:"payment_status,omitempty"`
+	Payment        *Payment `json:"payment,omitempty"`
+}
+
+type OrderStatusUpdate struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type OrderStatusEvent struct {
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Actor      string `json:"actor"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type OrderTimeline struct {
+	OrderID        int                `json:"order_id"`
+	ShipmentStatus string             `json:"shipment_status"`
+	Events         []OrderStatusEvent `json:"events"`
+}
+
+type ReturnRequest struct {
+	ProductID  int    `json:"product_id" binding:"required"`
+	ReasonCode string `json:"reason_code" binding:"required"`
+	Comment    string `json:"comment"`
+}
+
+type ReturnReview struct {
+	Action string `json:"action" binding:"required,oneof=approve reject"`
+	Note   string `json:"note"`
+}
+
+type ReturnRequestDetails struct {
+	ID          int     `json:"id"`
+	OrderID     int     `json:"order_id"`
+	OrderItemID int     `json:"order_item_id"`
+	ProductID   int     `json:"product_id"`
+	Quantity    int     `json:"quantity"`
+	Amount      float64 `json:"amount"`
+	ReasonCode  string  `json:"reason_code"`
+	Comment     string  `json:"comment"`
+	Status      string  `json:"status"`
+	ReviewNote  string  `json:"review_note"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type Payment struct {
+	ID            int     `json:"id"`
+	OrderID       int     `json:"order_id"`
+	Method        string  `json:"method"`
+	Provider      string  `json:"provider"`
+	Reference     string  `json:"reference"`
+	Amount        float64 `json:"amount"`
+	Status        string  `json:"status"`
+	CheckoutURL   string  `json:"checkout_url,omitempty"`
+	FailureReason string  `json:"failure_reason,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type PayOrder struct {
+	PaymentID uint `json:"payment_id" binding:"required"`
+}
+
+type PaymentSimulation struct {
+	Outcome string `json:"outcome" binding:"required,oneof=succeeded failed"`
+}
+
+// PaymentWebhook is a signed webhook call of a payment provider. Posting the
+// payload with the signature to the provider's webhook route delivers it.
+type PaymentWebhook struct {
+	Provider  string `json:"provider"`
+	Payload   string `json:"payload"`
+	Signature string `json:"signature"`
+}
+
+type Wallet struct {
+	UserID  int     `json:"user_id"`
+	Balance float64 `json:"balance"`
+}
+
+type WalletTransaction struct {
+	ID           int     `json:"id"`
+	Type         string  `json:"type"`
+	Amount       float64 `json:"amount"`
+	BalanceAfter float64 `json:"balance_after"`
+	Reason       string  `json:"reason"`
+	Reference    string  `json:"reference"`
+	CreatedAt    string  `json:"created_at"`
 }
@@ -1,14 +1,20 @@
 package models
 
+import "time"
+
 type ProductBrief struct {
-	ID            uint    `json:"id" gorm:"unique;not null"`
-	Name          string  `json:"name"`
-	Description   string  `json:"description"`
-	CategoryID    int     `json:"category_id"`
-	Size          int     `json:"Sizeinch"`
-	Stock         int     `json:"stock"`
-	Price         float64 `json:"price"`
-	ProductStatus string  `json:"product_status"`
+	ID            uint           `json:"id" gorm:"unique;not null"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	CategoryID    int            `json:"category_id"`
+	Size          int            `json:"Sizeinch"`
+	Stock         int            `json:"stock"`
+	Price         float64        `json:"price"`
+	ProductStatus string         `json:"product_status"`
+	Status        string         `json:"status"`
+	DeletedAt     string         `json:"deleted_at,omitempty"`
+	Images        []ProductImage `json:"images"`
+	LaptopSpecs
 }
 
 type ProductUpdateReciever struct {
@@ -22,17 +28,205 @@ type Product struct {
 	Size        int     `json:"Sizeinch" validate:"required"`
 	Stock       int     `json:"stock" validate:"required"`
 	Price       float64 `json:"price" validate:"required"`
+	LaptopSpecs
 }
 type Products struct {
-	ID          uint    `json:"id" gorm:"unique;not null"`
+	ID          uint           `json:"id" gorm:"unique;not null"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	CategoryID  uint           `json:"category_id"`
+	Size        int            `json:"Sizeinch"`
+	Stock       int            `json:"stock"`
+	Price       float64        `json:"price"`
+	Status      string         `json:"status,omitempty"`
+	DeletedAt   string         `json:"deleted_at,omitempty"`
+	Images      []ProductImage `json:"images,omitempty"`
+	LaptopSpecs
+}
+
+// ProductPatch carries new values for the fields of a product that a PATCH
+// request names; fields missing from the request are left unchanged.
+type ProductPatch struct {
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
 	CategoryID  uint    `json:"category_id"`
 	Size        int     `json:"Sizeinch"`
 	Stock       int     `json:"stock"`
 	Price       float64 `json:"price"`
+	LaptopSpecs
 }
+
 type ProductUpdate struct {
 	ProductId int `json:"product_id"`
 	Stock     int `json:"stock"`
 }
+
+type StockMovement struct {
+	ID        int    `json:"id"`
+	ProductID int    `json:"product_id"`
+	Delta     int    `json:"delta"`
+	Reason    string `json:"reason"`
+	Reference string `json:"reference"`
+	Actor     string `json:"actor"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ReconcileStock struct {
+	ProductID int  `json:"product_id"`
+	Fix       bool `json:"fix"`
+}
+
+type StockReconciliation struct {
+	ProductID   int `json:"product_id"`
+	Stock       int `json:"stock"`
+	LedgerStock int `json:"ledger_stock"`
+	Difference  int `json:"difference"`
+}
+
+// LaptopSpecs are the structured specifications of a laptop.
+type LaptopSpecs struct {
+	Brand            string  `json:"brand" validate:"required"`
+	CPU              string  `json:"cpu" validate:"required"`
+	RAMGB            int     `json:"ram_gb" validate:"required"`
+	StorageType      string  `json:"storage_type" validate:"required"`
+	StorageGB        int     `json:"storage_gb" validate:"required"`
+	GPU              string  `json:"gpu"`
+	ScreenResolution string  `json:"screen_resolution" validate:"required"`
+	WeightKg         float64 `json:"weight_kg" validate:"required"`
+	BatteryWh        float64 `json:"battery_wh" validate:"required"`
+	OS               string  `json:"os" validate:"required"`
+}
+
+// ProductFilter narrows the product listing down by free text, category,
+// price, availability and laptop specifications, and picks its sort order:
+// relevance, price_asc, price_desc, newest or popularity.
+type ProductFilter struct {
+	Query            string  `form:"q"`
+	CategoryID       int     `form:"category_id"`
+	MinPrice         float64 `form:"min_price"`
+	MaxPrice         float64 `form:"max_price"`
+	InStock          bool    `form:"in_stock"`
+	Sort             string  `form:"sort"`
+	Status           string  `form:"-"`
+	Brand            string  `form:"brand"`
+	CPU              string  `form:"cpu"`
+	MinRAMGB         int     `form:"min_ram_gb"`
+	MaxRAMGB         int     `form:"max_ram_gb"`
+	StorageType      string  `form:"storage_type"`
+	MinStorageGB     int     `form:"min_storage_gb"`
+	GPU              string  `form:"gpu"`
+	ScreenResolution string  `form:"screen_resolution"`
+	MaxWeightKg      float64 `form:"max_weight_kg"`
+	MinBatteryWh     float64 `form:"min_battery_wh"`
+	OS               string  `form:"os"`
+}
+
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type Facet struct {
+	Name   string       `json:"name"`
+	Values []FacetValue `json:"values"`
+}
+
+type ProductListing struct {
+	Products []ProductBrief `json:"products"`
+	Total    int            `json:"total"`
+	Facets   []Facet        `json:"facets"`
+}
+
+type Category struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	ParentID    int    `json:"parent_id"`
+}
+
+// CategoryInput creates or updates a category. A zero parent_id makes it a
+// root category and an empty slug is derived from the name.
+type CategoryInput struct {
+	Name        string `json:"name" validate:"required"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	ParentID    int    `json:"parent_id" validate:"min=0"`
+}
+
+type CategoryNode struct {
+	Category
+	Children []CategoryNode `json:"children"`
+}
+
+type ProductImage struct {
+	ID           int    `json:"id"`
+	ProductID    int    `json:"product_id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Position     int    `json:"position"`
+	IsPrimary    bool   `json:"is_primary"`
+}
+
+type ImageOrder struct {
+	ImageIDs []int `json:"image_ids" validate:"required,min=1"`
+}
+
+// PriceScheduleInput schedules a price from starts_at, or now when it is
+// missing, until ends_at. Without ends_at the new price stays.
+type PriceScheduleInput struct {
+	Label    string     `json:"label"`
+	Price    float64    `json:"price" validate:"required,gt=0"`
+	StartsAt *time.Time `json:"starts_at"`
+	EndsAt   *time.Time `json:"ends_at"`
+}
+
+type PriceSchedule struct {
+	ID            int     `json:"id"`
+	ProductID     int     `json:"product_id"`
+	Label         string  `json:"label"`
+	Price         float64 `json:"price"`
+	PreviousPrice float64 `json:"previous_price"`
+	StartsAt      string  `json:"starts_at"`
+	EndsAt        string  `json:"ends_at,omitempty"`
+	Status        string  `json:"status"`
+	CreatedBy     string  `json:"created_by"`
+}
+
+// OfferInput creates or replaces an offer on one product or on every product
+// of a category. Without valid_from the offer starts now, without valid_until
+// it does not expire, and it is active unless active is false.
+type OfferInput struct {
+	Name          string     `json:"name" validate:"required"`
+	ProductID     uint       `json:"product_id"`
+	CategoryID    uint       `json:"category_id"`
+	DiscountType  string     `json:"discount_type" validate:"required,oneof=percentage flat"`
+	DiscountValue float64    `json:"discount_value" validate:"required,gt=0"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	Active        *bool      `json:"active"`
+}
+
+type Offer struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	ProductID     int     `json:"product_id,omitempty"`
+	CategoryID    int     `json:"category_id,omitempty"`
+	DiscountType  string  `json:"discount_type"`
+	DiscountValue float64 `json:"discount_value"`
+	ValidFrom     string  `json:"valid_from"`
+	ValidUntil    string  `json:"valid_until,omitempty"`
+	Active        bool    `json:"active"`
+	CreatedBy     string  `json:"created_by"`
+}
+
+type PriceChange struct {
+	ID         int     `json:"id"`
+	ProductID  int     `json:"product_id"`
+	OldPrice   float64 `json:"old_price"`
+	NewPrice   float64 `json:"new_price"`
+	Reason     string  `json:"reason"`
+	ScheduleID int     `json:"schedule_id,omitempty"`
+	Actor      string  `json:"actor"`
+	CreatedAt  string  `json:"created_at"`
+}
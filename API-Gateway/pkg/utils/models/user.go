@@ -29,8 +29,24 @@ type UserDetail struct {
 	Password  string
 }
 type TokenUser struct {
-	User        UserDetails
-	AccessToken string
+	User         UserDetails
+	AccessToken  string
+	RefreshToken string
+	// CartNotices tells the user what could not be merged from their guest cart.
+	CartNotices []string `json:",omitempty"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
 }
 type UserDetailsResponse struct {
 	Id        int    `json:"id"`
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	pb "cart-service/pkg/pb/cart"
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *CartServer) AddCoupon(ctx context.Context, req *pb.AddCouponRequest) (*pb.CouponResponse, error) {
+	input, err := couponInput(req.Coupon)
+	if err != nil {
+		return &pb.CouponResponse{Error: err.Error()}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	input.Actor = req.Actor
+	coupon, err := c.CartUseCase.AddCoupon(input)
+	if err != nil {
+		return &pb.CouponResponse{Error: err.Error()}, couponError(err)
+	}
+	return &pb.CouponResponse{Coupon: couponToProto(coupon)}, nil
+}
+
+func (c *CartServer) UpdateCoupon(ctx context.Context, req *pb.UpdateCouponRequest) (*pb.CouponResponse, error) {
+	input, err := couponInput(req.Coupon)
+	if err != nil {
+		return &pb.CouponResponse{Error: err.Error()}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	coupon, err := c.CartUseCase.UpdateCoupon(int(req.ID), input)
+	if err != nil {
+		return &pb.CouponResponse{Error: err.Error()}, couponError(err)
+	}
+	return &pb.CouponResponse{Coupon: couponToProto(coupon)}, nil
+}
+
+func (c *CartServer) ListCoupons(ctx context.Context, req *pb.ListCouponsRequest) (*pb.ListCouponsResponse, error) {
+	coupons, err := c.CartUseCase.ListCoupons(int(req.Page), int(req.Count))
+	if err != nil {
+		return &pb.ListCouponsResponse{Error: err.Error()}, couponError(err)
+	}
+	var result pb.ListCouponsResponse
+	for _, coupon := range coupons {
+		result.Coupons = append(result.Coupons, couponToProto(coupon))
+	}
+	return &result, nil
+}
+
+func (c *CartServer) DeleteCoupon(ctx context.Context, req *pb.DeleteCouponRequest) (*pb.DeleteCouponResponse, error) {
+	if err := c.CartUseCase.DeleteCoupon(int(req.ID)); err != nil {
+		return &pb.DeleteCouponResponse{Error: err.Error()}, couponError(err)
+	}
+	return &pb.DeleteCouponResponse{}, nil
+}
+
+func (c *CartServer) ApplyCoupon(ctx context.Context, req *pb.ApplyCouponRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.ApplyCoupon(int(req.UserID), req.Code)
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, couponError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) RemoveCoupon(ctx context.Context, req *pb.RemoveCouponRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.RemoveCoupon(int(req.UserID))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, couponError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) RedeemCoupon(ctx context.Context, req *pb.RedeemCouponRequest) (*pb.RedeemCouponResponse, error) {
+	redemption, err := c.CartUseCase.RedeemCoupon(models.CouponRedemptionInput{
+		UserID:   int(req.UserID),
+		OrderID:  int(req.OrderID),
+		Code:     req.Code,
+		Discount: float64(req.Discount),
+	})
+	if err != nil {
+		return &pb.RedeemCouponResponse{Error: err.Error()}, couponError(err)
+	}
+	return &pb.RedeemCouponResponse{Discount: float32(redemption.Discount)}, nil
+}
+
+func (c *CartServer) ReleaseCoupon(ctx context.Context, req *pb.ReleaseCouponRequest) (*pb.ReleaseCouponResponse, error) {
+	if err := c.CartUseCase.ReleaseCoupon(int(req.OrderID)); err != nil {
+		return &pb.ReleaseCouponResponse{Error: err.Error()}, couponError(err)
+	}
+	return &pb.ReleaseCouponResponse{}, nil
+}
+
+// couponError maps coupon errors to gRPC status codes.
+func couponError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCouponNotFound), errors.Is(err, domain.ErrNoCouponApplied):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrInvalidCoupon):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, domain.ErrCouponExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, domain.ErrCouponRedeemed), errors.Is(err, domain.ErrCouponInactive),
+		errors.Is(err, domain.ErrCouponNotStarted), errors.Is(err, domain.ErrCouponExpired),
+		errors.Is(err, domain.ErrCouponMinCartValue), errors.Is(err, domain.ErrCartEmpty):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, domain.ErrCouponUsageLimit), errors.Is(err, domain.ErrCouponUserLimit):
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func couponInput(c *pb.Coupon) (models.CouponInput, error) {
+	input := models.CouponInput{
+		Code:          c.GetCode(),
+		Description:   c.GetDescription(),
+		DiscountType:  c.GetDiscountType(),
+		DiscountValue: float64(c.GetDiscountValue()),
+		MinCartValue:  float64(c.GetMinCartValue()),
+		MaxDiscount:   float64(c.GetMaxDiscount()),
+		UsageLimit:    int(c.GetUsageLimit()),
+		PerUserLimit:  int(c.GetPerUserLimit()),
+		Active:        c.GetActive(),
+	}
+	if c.GetValidFrom() != "" {
+		validFrom, err := time.Parse(time.RFC3339, c.GetValidFrom())
+		if err != nil {
+			return input, err
+		}
+		input.ValidFrom = validFrom
+	}
+	if c.GetValidUntil() != "" {
+		validUntil, err := time.Parse(time.RFC3339, c.GetValidUntil())
+		if err != nil {
+			return input, err
+		}
+		input.ValidUntil = &validUntil
+	}
+	return input, nil
+}
+
+func couponToProto(c domain.Coupon) *pb.Coupon {
+	coupon := &pb.Coupon{
+		ID:            int64(c.ID),
+		Code:          c.Code,
+		Description:   c.Description,
+		DiscountType:  c.DiscountType,
+		DiscountValue: float32(c.DiscountValue),
+		MinCartValue:  float32(c.MinCartValue),
+		MaxDiscount:   float32(c.MaxDiscount),
+		ValidFrom:     c.ValidFrom.Format(time.RFC3339),
+		UsageLimit:    int64(c.UsageLimit),
+		PerUserLimit:  int64(c.PerUserLimit),
+		Active:        c.Active,
+		CreatedBy:     c.CreatedBy,
+	}
+	if c.ValidUntil != nil {
+		coupon.ValidUntil = c.ValidUntil.Format(time.RFC3339)
+	}
+	return coupon
+}
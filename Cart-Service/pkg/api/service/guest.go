@@ -0,0 +1,61 @@
+package service
+
+import (
+	"cart-service/pkg/domain"
+	pb "cart-service/pkg/pb/cart"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *CartServer) AddToGuestCart(ctx context.Context, req *pb.GuestCartRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.AddToGuestCart(req.GuestID, int(req.ProductID), int(req.Quantity))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, guestCartError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) UpdateGuestCartItemQuantity(ctx context.Context, req *pb.GuestCartRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.UpdateGuestCartItemQuantity(req.GuestID, int(req.ProductID), int(req.Quantity))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, guestCartError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) RemoveFromGuestCart(ctx context.Context, req *pb.GuestCartRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.RemoveFromGuestCart(req.GuestID, int(req.ProductID))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, guestCartError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) GetGuestCart(ctx context.Context, req *pb.GuestCartRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.GuestCart(req.GuestID)
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, guestCartError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) MergeGuestCart(ctx context.Context, req *pb.MergeGuestCartRequest) (*pb.MergeGuestCartResponse, error) {
+	res, notices, err := c.CartUseCase.MergeGuestCart(req.GuestID, int(req.UserID))
+	if err != nil {
+		return &pb.MergeGuestCartResponse{Error: err.Error()}, guestCartError(err)
+	}
+	return &pb.MergeGuestCartResponse{
+		Cart:    cartResponse(res),
+		Notices: notices,
+	}, nil
+}
+
+func guestCartError(err error) error {
+	if errors.Is(err, domain.ErrInvalidGuestCart) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return cartError(err)
+}
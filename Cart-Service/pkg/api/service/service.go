@@ -1,10 +1,17 @@
 package service
 
 import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
 	interfaceUse "cart-service/pkg/usecase/interface"
 	"context"
+	"errors"
+	"time"
 
 	pb "cart-service/pkg/pb/cart"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CartServer struct {
@@ -29,16 +36,14 @@ func (c *CartServer) AddToCart(ctx context.Context, req *pb.AddToCartRequest) (*
 		}, err
 	}
 	var result pb.AddToCartResponse
-	var cartDetails []*pb.CartDetails
-	for _, v := range res.Cart {
-		details := &pb.CartDetails{
-			ProductID:  int64(v.ProductID),
-			Quantity:   float32(v.Quantity),
-			TotalPrice: float32(v.TotalPrice),
-		}
-		cartDetails = append(cartDetails, details)
-	}
+	cartDetails := cartLines(res.Cart)
 	result.Price = float32(res.TotalPrice)
+	result.OfferDiscount = float32(res.OfferDiscount)
+	result.Discount = float32(res.Discount)
+	result.FinalPrice = float32(res.FinalPrice)
+	result.CouponCode = res.CouponCode
+	result.CouponNotice = res.CouponNotice
+	result.PriceNotices = res.PriceNotices
 	result.Cart = cartDetails
 	return &result, nil
 }
@@ -51,19 +56,7 @@ func (c *CartServer) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.G
 			Error: err.Error(),
 		}, err
 	}
-	var result pb.GetCartResponse
-	var cartDetails []*pb.CartDetails
-	for _, v := range res.Cart {
-		details := &pb.CartDetails{
-			ProductID:  int64(v.ProductID),
-			Quantity:   float32(v.Quantity),
-			TotalPrice: float32(v.TotalPrice),
-		}
-		cartDetails = append(cartDetails, details)
-	}
-	result.Price = float32(res.TotalPrice)
-	result.Cart = cartDetails
-	return &result, nil
+	return cartResponse(res), nil
 }
 func (c *CartServer) DoesCartExist(ctx context.Context, req *pb.DoesCartExistRequest) (*pb.DoesCartExistReponse, error) {
 	userID := int(req.UserID)
@@ -86,18 +79,8 @@ func (c *CartServer) GetAllItemsFromCart(ctx context.Context, req *pb.GetAllItem
 		}, err
 	}
 
-	var cartDetails []*pb.CartDetails
-	for _, cartItem := range res {
-		cartDetail := &pb.CartDetails{
-			ProductID:  int64(cartItem.ProductID),
-			Quantity:   float32(cartItem.Quantity),
-			TotalPrice: float32(cartItem.TotalPrice),
-		}
-		cartDetails = append(cartDetails, cartDetail)
-	}
-
 	return &pb.GetAllItemsFromCartResponse{
-		Cart: cartDetails,
+		Cart: cartLines(res),
 	}, nil
 }
 
@@ -110,7 +93,11 @@ func (c *CartServer) TotalAmountInCart(ctx context.Context, req *pb.TotalAmountI
 		}, err
 	}
 	return &pb.TotalAmountInCartResponse{
-		Data: float32(res),
+		Data:          float32(res.TotalPrice),
+		Discount:      float32(res.Discount),
+		FinalPrice:    float32(res.FinalPrice),
+		CouponCode:    res.CouponCode,
+		OfferDiscount: float32(res.OfferDiscount),
 	}, nil
 }
 
@@ -127,3 +114,84 @@ func (c *CartServer) UpdateCartAfterOrder(ctx context.Context, req *pb.UpdateCar
 
 	return &pb.UpdateCartAfterOrderResponse{}, nil
 }
+
+func (c *CartServer) ClearCart(ctx context.Context, req *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	userID := int(req.UserID)
+	var productIDs []int
+	for _, id := range req.ProductIDs {
+		productIDs = append(productIDs, int(id))
+	}
+	err := c.CartUseCase.ClearCart(userID, productIDs)
+	if err != nil {
+		return &pb.ClearCartResponse{
+			Error: err.Error(),
+		}, err
+	}
+	return &pb.ClearCartResponse{}, nil
+}
+
+func (c *CartServer) UpdateCartItemQuantity(ctx context.Context, req *pb.UpdateCartItemQuantityRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.UpdateCartItemQuantity(int(req.UserID), int(req.ProductID), int(req.Quantity))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, cartError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) RemoveFromCart(ctx context.Context, req *pb.RemoveFromCartRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.RemoveFromCart(int(req.UserID), int(req.ProductID))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, cartError(err)
+	}
+	return cartResponse(res), nil
+}
+
+// cartError maps cart item errors to gRPC status codes.
+func cartError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCartItemNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, domain.ErrInsufficientStock):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func cartResponse(res models.CartResponse) *pb.GetCartResponse {
+	var result pb.GetCartResponse
+	result.Cart = cartLines(res.Cart)
+	result.Price = float32(res.TotalPrice)
+	result.OfferDiscount = float32(res.OfferDiscount)
+	result.Discount = float32(res.Discount)
+	result.FinalPrice = float32(res.FinalPrice)
+	result.CouponCode = res.CouponCode
+	result.CouponNotice = res.CouponNotice
+	result.PriceNotices = res.PriceNotices
+	return &result
+}
+
+func cartLines(lines []models.Cart) []*pb.CartDetails {
+	var details []*pb.CartDetails
+	for _, v := range lines {
+		var heldUntil string
+		if v.HeldUntil != nil {
+			heldUntil = v.HeldUntil.Format(time.RFC3339)
+		}
+		details = append(details, &pb.CartDetails{
+			ProductID:    int64(v.ProductID),
+			Quantity:     float32(v.Quantity),
+			TotalPrice:   float32(v.TotalPrice),
+			Discount:     float32(v.Discount),
+			FinalPrice:   float32(v.FinalPrice),
+			Offer:        v.Offer,
+			UnitPrice:    float32(v.UnitPrice),
+			AddedPrice:   float32(v.AddedPrice),
+			PriceChanged: v.PriceChanged,
+			HeldUntil:    heldUntil,
+		})
+	}
+	return details
+}
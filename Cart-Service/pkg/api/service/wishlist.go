@@ -0,0 +1,75 @@
+package service
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	pb "cart-service/pkg/pb/cart"
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *CartServer) AddToWishlist(ctx context.Context, req *pb.WishlistRequest) (*pb.WishlistItemResponse, error) {
+	item, err := c.CartUseCase.AddToWishlist(int(req.UserID), int(req.ProductID))
+	if err != nil {
+		return &pb.WishlistItemResponse{Error: err.Error()}, wishlistError(err)
+	}
+	return &pb.WishlistItemResponse{Item: wishlistItemToProto(item)}, nil
+}
+
+func (c *CartServer) RemoveFromWishlist(ctx context.Context, req *pb.WishlistRequest) (*pb.RemoveFromWishlistResponse, error) {
+	if err := c.CartUseCase.RemoveFromWishlist(int(req.UserID), int(req.ProductID)); err != nil {
+		return &pb.RemoveFromWishlistResponse{Error: err.Error()}, wishlistError(err)
+	}
+	return &pb.RemoveFromWishlistResponse{}, nil
+}
+
+func (c *CartServer) ListWishlist(ctx context.Context, req *pb.ListWishlistRequest) (*pb.ListWishlistResponse, error) {
+	items, err := c.CartUseCase.ListWishlist(int(req.UserID), int(req.Page), int(req.Count))
+	if err != nil {
+		return &pb.ListWishlistResponse{Error: err.Error()}, wishlistError(err)
+	}
+	var result pb.ListWishlistResponse
+	for _, item := range items {
+		result.Items = append(result.Items, wishlistItemToProto(item))
+	}
+	return &result, nil
+}
+
+func (c *CartServer) MoveToCart(ctx context.Context, req *pb.WishlistRequest) (*pb.GetCartResponse, error) {
+	res, err := c.CartUseCase.MoveToCart(int(req.UserID), int(req.ProductID), int(req.Quantity))
+	if err != nil {
+		return &pb.GetCartResponse{Error: err.Error()}, wishlistError(err)
+	}
+	return cartResponse(res), nil
+}
+
+func (c *CartServer) SaveForLater(ctx context.Context, req *pb.WishlistRequest) (*pb.WishlistItemResponse, error) {
+	item, err := c.CartUseCase.SaveForLater(int(req.UserID), int(req.ProductID))
+	if err != nil {
+		return &pb.WishlistItemResponse{Error: err.Error()}, wishlistError(err)
+	}
+	return &pb.WishlistItemResponse{Item: wishlistItemToProto(item)}, nil
+}
+
+func wishlistError(err error) error {
+	if errors.Is(err, domain.ErrNotInWishlist) || errors.Is(err, domain.ErrProductNotFound) {
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return cartError(err)
+}
+
+func wishlistItemToProto(item models.WishlistItem) *pb.WishlistItem {
+	return &pb.WishlistItem{
+		ProductID:    int64(item.ProductID),
+		Price:        float32(item.Price),
+		AddedPrice:   float32(item.AddedPrice),
+		PriceChanged: item.PriceChanged,
+		InStock:      item.InStock,
+		StockStatus:  item.StockStatus,
+		AddedAt:      item.AddedAt.Format(time.RFC3339),
+	}
+}
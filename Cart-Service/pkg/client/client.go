@@ -2,10 +2,15 @@ package client
 
 import (
 	"cart-service/pkg/config"
+	"cart-service/pkg/domain"
 	"context"
+	"cart-service/pkg/models"
 	"fmt"
+	"time"
     pb "cart-service/pkg/pb/product"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type clientProduct struct {
@@ -45,16 +50,6 @@ func (c *clientProduct) GetPriceofProductFromID(prodcut_id int) (float64, error)
 	price := float64(res.Price)
 	return price, nil
 }
-func (c *clientProduct) ProductStockMinus(productID, stock int) error {
-	_, err := c.Client.ProductStockMinus(context.Background(), &pb.ProductStockMinusRequest{
-		ID:    int64(productID),
-		Stock: int64(stock),
-	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
 func (c *clientProduct) CheckProduct(productID int) (bool, error) {
 	ok, err := c.Client.CheckProduct(context.Background(), &pb.CheckProductRequest{
 		ProductID: int64(productID),
@@ -64,3 +59,64 @@ func (c *clientProduct) CheckProduct(productID int) (bool, error) {
 	}
 	return ok.Bool, err
 }
+
+// GetProductOffers returns the offers in effect for each of the products,
+// keyed by product ID.
+func (c *clientProduct) GetProductOffers(productIDs []int) (map[int][]models.Offer, error) {
+	req := &pb.ProductOffersRequest{}
+	for _, id := range productIDs {
+		req.ProductIDs = append(req.ProductIDs, int64(id))
+	}
+	res, err := c.Client.GetProductOffers(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	offers := make(map[int][]models.Offer)
+	for _, product := range res.Products {
+		for _, o := range product.Offers {
+			offers[int(product.ProductID)] = append(offers[int(product.ProductID)], models.Offer{
+				ID:            int(o.ID),
+				Name:          o.Name,
+				DiscountType:  o.DiscountType,
+				DiscountValue: float64(o.DiscountValue),
+			})
+		}
+	}
+	return offers, nil
+}
+
+// ReserveStock holds quantity of the product under the reference for ttl and
+// returns when the hold expires. Holding again under the same reference
+// changes the quantity held instead of adding to it.
+func (c *clientProduct) ReserveStock(reference string, productID, quantity int, ttl time.Duration) (time.Time, error) {
+	res, err := c.Client.ReserveStock(context.Background(), &pb.ReserveStockRequest{
+		Reference:  reference,
+		Items:      []*pb.ProductStock{{ID: int64(productID), Stock: int64(quantity)}},
+		TTLSeconds: int64(ttl / time.Second),
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return time.Time{}, domain.ErrInsufficientStock
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(res.Reservations) == 0 {
+		return time.Time{}, fmt.Errorf("no stock was reserved for product %d", productID)
+	}
+	return time.Parse(time.RFC3339, res.Reservations[0].ExpiresAt)
+}
+
+// ReleaseStock gives back the stock held under the reference for the
+// products, or for every product when none are given. Products with nothing
+// held are skipped.
+func (c *clientProduct) ReleaseStock(reference string, productIDs []int) error {
+	req := &pb.ReservationRequest{Reference: reference}
+	for _, id := range productIDs {
+		req.ProductIDs = append(req.ProductIDs, int64(id))
+	}
+	_, err := c.Client.ReleaseStock(context.Background(), req)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	return nil
+}
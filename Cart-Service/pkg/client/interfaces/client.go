@@ -1,8 +1,15 @@
 package interfaceClient
 
+import (
+	"cart-service/pkg/models"
+	"time"
+)
+
 type NewProductClient interface {
 	CheckProduct(product_id int) (bool, error)
 	GetQuantityFromProductID(id int) (int, error)
 	GetPriceofProductFromID(prodcut_id int) (float64, error)
-	ProductStockMinus(productID, stock int) error
+	ReserveStock(reference string, productID, quantity int, ttl time.Duration) (time.Time, error)
+	ReleaseStock(reference string, productIDs []int) error
+	GetProductOffers(productIDs []int) (map[int][]models.Offer, error)
 }
@@ -1,6 +1,11 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 type Config struct {
 	DBHost        string `mapstructure:"DB_HOST"`
@@ -10,10 +15,23 @@ type Config struct {
 	DBPassword    string `mapstructure:"DB_PASSWORD"`
 	Port          string `mapstructure:"PORT"`
 	ProductSvcUrl string `mapstructure:"PRODUCT_SVC_URL"`
+
+	// Stock of the products in a cart is held for CartHoldTTL after the cart
+	// last changed. Product-Service gives the stock of expired holds back.
+	CartHoldTTL time.Duration `mapstructure:"CART_HOLD_TTL"`
+
+	// A cart that did not change for AbandonedCartAfter is abandoned, and its
+	// user is reminded of it. Carts are checked every AbandonedCartInterval
+	// and reminders are written to ReminderLogFile.
+	AbandonedCartAfter    time.Duration `mapstructure:"ABANDONED_CART_AFTER"`
+	AbandonedCartInterval time.Duration `mapstructure:"ABANDONED_CART_INTERVAL"`
+	ReminderLogFile       string        `mapstructure:"REMINDER_LOG_FILE"`
 }
 
 var envs = []string{
 	"DB_HOST", "DB_NAME", "DB_USER", "DB_PORT", "DB_PASSWORD", "PORT", "PRODUCT_SVC_URL",
+	"CART_HOLD_TTL",
+	"ABANDONED_CART_AFTER", "ABANDONED_CART_INTERVAL", "REMINDER_LOG_FILE",
 }
 
 func LoadConfig() (Config, error) {
@@ -22,6 +40,10 @@ func LoadConfig() (Config, error) {
 	viper.AddConfigPath("./")
 	viper.SetConfigFile(".env")
 	viper.ReadInConfig()
+	viper.SetDefault("CART_HOLD_TTL", "30m")
+	viper.SetDefault("ABANDONED_CART_AFTER", "24h")
+	viper.SetDefault("ABANDONED_CART_INTERVAL", "15m")
+	viper.SetDefault("REMINDER_LOG_FILE", "./reminders.log")
 
 	for _, env := range envs {
 		if err := viper.BindEnv(env); err != nil {
@@ -33,6 +55,18 @@ func LoadConfig() (Config, error) {
 		return config, err
 	}
 
+	// The interval drives a ticker, which panics on anything but a positive
+	// duration; the other durations would hold or remind about nothing.
+	for name, d := range map[string]time.Duration{
+		"CART_HOLD_TTL":           config.CartHoldTTL,
+		"ABANDONED_CART_AFTER":    config.AbandonedCartAfter,
+		"ABANDONED_CART_INTERVAL": config.AbandonedCartInterval,
+	} {
+		if d <= 0 {
+			return config, fmt.Errorf("%s must be a positive duration, got %v", name, d)
+		}
+	}
+
 	return config, nil
 
 }
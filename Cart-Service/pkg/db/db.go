@@ -15,7 +15,7 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 		SkipDefaultTransaction: true,
 	})
 
-	db.AutoMigrate(&domain.Cart{})
+	db.AutoMigrate(&domain.Cart{}, &domain.Coupon{}, &domain.AppliedCoupon{}, &domain.CouponRedemption{}, &domain.GuestCartItem{}, &domain.WishlistItem{}, &domain.CartReminder{}, &domain.CartActivity{})
 	return db, dbErr
 
 }
@@ -6,8 +6,10 @@ import (
 	"cart-service/pkg/client"
 	"cart-service/pkg/config"
 	"cart-service/pkg/db"
+	"cart-service/pkg/notifier"
 	"cart-service/pkg/repository"
 	"cart-service/pkg/usecase"
+	"time"
 )
 
 func InitializeAPI(cfg config.Config) (*server.Server, error) {
@@ -19,7 +21,10 @@ func InitializeAPI(cfg config.Config) (*server.Server, error) {
 
 	cartRepository := repository.NewCartRepository(gormDB)
 	productClient := client.NewProductClient(&cfg)
-	adminUseCase := usecase.NewCartUseCase(cartRepository, productClient)
+	reminderNotifier := notifier.NewLogNotifier(cfg.ReminderLogFile)
+	adminUseCase := usecase.NewCartUseCase(cartRepository, productClient, reminderNotifier, cfg.CartHoldTTL, cfg.AbandonedCartAfter)
+	go usecase.StartGuestCartSweeper(adminUseCase, time.Hour)
+	go usecase.StartReminderJob(adminUseCase, cfg.AbandonedCartInterval)
 
 	adminServiceServer := service.NewCartServer(adminUseCase)
 	grpcServer, err := server.NewGRPCServer(cfg, adminServiceServer)
@@ -1,6 +1,11 @@
 package domain
 
-import "gorm.io/gorm"
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Cart struct {
 	gorm.Model
@@ -8,4 +13,134 @@ type Cart struct {
 	ProductID  uint    `json:"product_id"`
 	Quantity   float64 `json:"quantity"`
 	TotalPrice float64 `json:"total_price"`
+	// UnitPrice is the price of the product when it was put in the cart or
+	// its quantity last changed.
+	UnitPrice float64 `json:"unit_price"`
+	// HeldUntil is when Product-Service gives back the stock held for the
+	// line. A time in the past means the hold expired.
+	HeldUntil *time.Time `json:"held_until" gorm:"index"`
+}
+
+// CartActivity is when lines were last removed from a user's cart. Removed
+// lines leave no row in carts to date the change, so it is recorded here for
+// the abandoned cart reminders.
+type CartActivity struct {
+	UserID    uint      `json:"user_id" gorm:"primaryKey"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null"`
+}
+
+// CartReminder records a reminder sent about an abandoned cart. A user gets at
+// most one reminder for each time their cart goes idle: CartUpdatedAt is when
+// the cart last changed before the reminder was sent.
+type CartReminder struct {
+	ID            uint      `json:"id" gorm:"primaryKey;not null"`
+	UserID        uint      `json:"user_id" gorm:"uniqueIndex:idx_cart_reminder;not null"`
+	CartUpdatedAt time.Time `json:"cart_updated_at" gorm:"uniqueIndex:idx_cart_reminder;not null"`
+	SentAt        time.Time `json:"sent_at"`
+}
+
+// GuestCartItem is a line of the cart of a shopper who is not logged in. The
+// cart is identified by GuestID, which the gateway hands out in a signed cart
+// token, and is merged into the user's cart on login or signup.
+type GuestCartItem struct {
+	ID        uint      `json:"id" gorm:"primaryKey;not null"`
+	GuestID   string    `json:"guest_id" gorm:"uniqueIndex:idx_guest_cart_product;not null"`
+	ProductID uint      `json:"product_id" gorm:"uniqueIndex:idx_guest_cart_product;not null"`
+	Quantity  int       `json:"quantity"`
+	UnitPrice float64   `json:"unit_price"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"index"`
+}
+
+// WishlistItem is a product a user saved to buy later. AddedPrice is what the
+// product cost when it was saved.
+type WishlistItem struct {
+	ID         uint      `json:"id" gorm:"primaryKey;not null"`
+	UserID     uint      `json:"user_id" gorm:"uniqueIndex:idx_wishlist_user_product;not null"`
+	ProductID  uint      `json:"product_id" gorm:"uniqueIndex:idx_wishlist_user_product;not null"`
+	AddedPrice float64   `json:"added_price"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Stock statuses shown for the products of a wishlist.
+const (
+	StockStatusInStock     = "in_stock"
+	StockStatusOutOfStock  = "out_of_stock"
+	StockStatusUnavailable = "unavailable"
+)
+
+// Discount types of a coupon. A percentage coupon takes DiscountValue percent
+// off the cart total, a flat coupon takes DiscountValue off it.
+const (
+	CouponTypePercentage = "percentage"
+	CouponTypeFlat       = "flat"
+)
+
+// Statuses of a coupon redemption. A redemption is released when the order
+// that consumed it could not be placed or was cancelled, so it no longer
+// counts against the usage limits.
+const (
+	RedemptionRedeemed = "redeemed"
+	RedemptionReleased = "released"
+)
+
+var (
+	ErrInvalidCoupon      = errors.New("invalid coupon")
+	ErrCouponNotFound     = errors.New("coupon not found")
+	ErrCouponExists       = errors.New("a coupon with this code already exists")
+	ErrCouponRedeemed     = errors.New("coupon has been redeemed and can only be deactivated")
+	ErrCouponInactive     = errors.New("coupon is not active")
+	ErrCouponNotStarted   = errors.New("coupon is not valid yet")
+	ErrCouponExpired      = errors.New("coupon has expired")
+	ErrCouponMinCartValue = errors.New("cart total is below the minimum for this coupon")
+	ErrCouponUsageLimit   = errors.New("coupon has reached its usage limit")
+	ErrCouponUserLimit    = errors.New("you have already used this coupon the maximum number of times")
+	ErrNoCouponApplied    = errors.New("no coupon is applied to the cart")
+	ErrCartEmpty          = errors.New("cart is empty")
+	ErrCartItemNotFound   = errors.New("product is not in the cart")
+	ErrInvalidQuantity    = errors.New("quantity must be at least 1")
+	ErrInsufficientStock  = errors.New("not enough stock for the requested quantity")
+	ErrInvalidGuestCart   = errors.New("invalid guest cart")
+	ErrProductNotFound    = errors.New("product does not exist")
+	ErrNotInWishlist      = errors.New("product is not in the wishlist")
+)
+
+// Coupon is a promo code an admin hands out. A zero MaxDiscount, UsageLimit or
+// PerUserLimit means no limit, and a nil ValidUntil means the coupon does not
+// expire.
+type Coupon struct {
+	ID            uint       `json:"id" gorm:"primaryKey;not null"`
+	Code          string     `json:"code" gorm:"uniqueIndex;not null"`
+	Description   string     `json:"description"`
+	DiscountType  string     `json:"discount_type" gorm:"not null"`
+	DiscountValue float64    `json:"discount_value" gorm:"not null"`
+	MinCartValue  float64    `json:"min_cart_value"`
+	MaxDiscount   float64    `json:"max_discount"`
+	ValidFrom     time.Time  `json:"valid_from" gorm:"not null"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	UsageLimit    int        `json:"usage_limit"`
+	PerUserLimit  int        `json:"per_user_limit"`
+	Active        bool       `json:"active" gorm:"not null;default:true"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// AppliedCoupon is the coupon a user has applied to their cart.
+type AppliedCoupon struct {
+	UserID    uint      `json:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	CouponID  uint      `json:"coupon_id" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CouponRedemption records the discount a coupon gave on an order.
+type CouponRedemption struct {
+	ID        uint      `json:"id" gorm:"primaryKey;not null"`
+	CouponID  uint      `json:"coupon_id" gorm:"index;not null"`
+	UserID    uint      `json:"user_id" gorm:"index;not null"`
+	OrderID   uint      `json:"order_id" gorm:"uniqueIndex;not null"`
+	Discount  float64   `json:"discount"`
+	Status    string    `json:"status" gorm:"not null;default:'redeemed'"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
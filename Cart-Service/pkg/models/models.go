@@ -1,17 +1,77 @@
 package models
 
+import "time"
+
+// CartResponse is a priced cart. TotalPrice is the price before any discount,
+// OfferDiscount what the offers on its lines take off and Discount what the
+// coupon takes off the rest. PriceNotices tells the user which products
+// changed price since they were put in the cart.
 type CartResponse struct {
-	TotalPrice float64
-	Cart       []Cart
+	TotalPrice    float64
+	OfferDiscount float64
+	Discount      float64
+	FinalPrice    float64
+	CouponCode    string
+	CouponNotice  string
+	PriceNotices  []string
+	Cart          []Cart
 }
 type CartTotal struct {
-	TotalPrice float64 `json:"total_price"`
-	FinalPrice float64 `json:"final_price"`
+	TotalPrice    float64 `json:"total_price"`
+	OfferDiscount float64 `json:"offer_discount"`
+	Discount      float64 `json:"discount"`
+	FinalPrice    float64 `json:"final_price"`
+	CouponCode    string  `json:"coupon_code"`
 }
+
+// Cart is a line of a cart. UnitPrice is the current price of the product and
+// AddedPrice what it cost when it was put in the cart. HeldUntil is when the
+// stock held for the line is given back, nil when none is held.
 type Cart struct {
-	ProductID  uint    `json:"product_id"`
-	Quantity   float64 `json:"quantity"`
-	TotalPrice float64 `json:"total_price"`
+	ProductID    uint       `json:"product_id"`
+	Quantity     float64    `json:"quantity"`
+	UnitPrice    float64    `json:"unit_price"`
+	AddedPrice   float64    `json:"added_price"`
+	PriceChanged bool       `json:"price_changed"`
+	TotalPrice   float64    `json:"total_price"`
+	Discount     float64    `json:"discount"`
+	FinalPrice   float64    `json:"final_price"`
+	Offer        string     `json:"offer"`
+	HeldUntil    *time.Time `json:"held_until"`
+}
+
+// Offer is a discount Product-Service has in effect for a product.
+type Offer struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	DiscountType  string  `json:"discount_type"`
+	DiscountValue float64 `json:"discount_value"`
+}
+
+// WishlistItem is a saved product with its current price and stock status. A
+// product that is no longer sold is unavailable and has no price.
+type WishlistItem struct {
+	ProductID    uint      `json:"product_id"`
+	Price        float64   `json:"price"`
+	AddedPrice   float64   `json:"added_price"`
+	PriceChanged bool      `json:"price_changed"`
+	InStock      bool      `json:"in_stock"`
+	StockStatus  string    `json:"stock_status"`
+	AddedAt      time.Time `json:"added_at"`
+}
+
+// AbandonedCart is a cart its user left alone since IdleSince, priced as it
+// is now.
+type AbandonedCart struct {
+	UserID    int          `json:"user_id"`
+	IdleSince time.Time    `json:"idle_since"`
+	Cart      CartResponse `json:"cart"`
+}
+
+// IdleCart is the user of a cart and when the cart last changed.
+type IdleCart struct {
+	UserID       int
+	LastActivity time.Time
 }
 
 type Carts struct {
@@ -28,3 +88,27 @@ type GetCart struct {
 	Quantity   float64 `json:"quantity"`
 	TotalPrice float64 `json:"total_price"`
 }
+
+type CouponInput struct {
+	Code          string     `json:"code"`
+	Description   string     `json:"description"`
+	DiscountType  string     `json:"discount_type"`
+	DiscountValue float64    `json:"discount_value"`
+	MinCartValue  float64    `json:"min_cart_value"`
+	MaxDiscount   float64    `json:"max_discount"`
+	ValidFrom     time.Time  `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	UsageLimit    int        `json:"usage_limit"`
+	PerUserLimit  int        `json:"per_user_limit"`
+	Active        bool       `json:"active"`
+	Actor         string     `json:"actor"`
+}
+
+// CouponRedemptionInput is the discount an order takes from the coupon
+// applied to the user's cart.
+type CouponRedemptionInput struct {
+	UserID   int     `json:"user_id"`
+	OrderID  int     `json:"order_id"`
+	Code     string  `json:"code"`
+	Discount float64 `json:"discount"`
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+import "cart-service/pkg/models"
+
+// Notifier tells users about carts they left behind.
+type Notifier interface {
+	NotifyAbandonedCart(cart models.AbandonedCart) error
+}
@@ -0,0 +1,48 @@
+package notifier
+
+import (
+	"cart-service/pkg/models"
+	"cart-service/pkg/notifier/interfaces"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+)
+
+// logNotifier writes every notification as a line of JSON to a file, or to
+// the service log when no file is given. It stands in for a real delivery
+// channel such as email.
+type logNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewLogNotifier(path string) interfaces.Notifier {
+	return &logNotifier{path: path}
+}
+
+func (n *logNotifier) NotifyAbandonedCart(cart models.AbandonedCart) error {
+	line, err := json.Marshal(struct {
+		Type string `json:"type"`
+		models.AbandonedCart
+	}{"abandoned_cart", cart})
+	if err != nil {
+		return err
+	}
+	if n.path == "" {
+		log.Println("notification:", string(line))
+		return nil
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
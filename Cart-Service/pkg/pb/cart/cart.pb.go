@@ -20,6 +20,226 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ClearCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProductIDs []int64 `protobuf:"varint,2,rep,packed,name=productIDs,proto3" json:"productIDs,omitempty"`
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClearCartRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ClearCartRequest) GetProductIDs() []int64 {
+	if x != nil {
+		return x.ProductIDs
+	}
+	return nil
+}
+
+type ClearCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClearCartResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateCartItemQuantityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProductID int64 `protobuf:"varint,2,opt,name=productID,proto3" json:"productID,omitempty"`
+	Quantity  int64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *UpdateCartItemQuantityRequest) Reset() {
+	*x = UpdateCartItemQuantityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCartItemQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCartItemQuantityRequest) ProtoMessage() {}
+
+func (x *UpdateCartItemQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCartItemQuantityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCartItemQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateCartItemQuantityRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UpdateCartItemQuantityRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *UpdateCartItemQuantityRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RemoveFromCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProductID int64 `protobuf:"varint,2,opt,name=productID,proto3" json:"productID,omitempty"`
+}
+
+func (x *RemoveFromCartRequest) Reset() {
+	*x = RemoveFromCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFromCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromCartRequest) ProtoMessage() {}
+
+func (x *RemoveFromCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromCartRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromCartRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveFromCartRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RemoveFromCartRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
 type UpdateCartAfterOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,7 +253,7 @@ type UpdateCartAfterOrderRequest struct {
 func (x *UpdateCartAfterOrderRequest) Reset() {
 	*x = UpdateCartAfterOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[0]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -46,7 +266,7 @@ func (x *UpdateCartAfterOrderRequest) String() string {
 func (*UpdateCartAfterOrderRequest) ProtoMessage() {}
 
 func (x *UpdateCartAfterOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[0]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +279,7 @@ func (x *UpdateCartAfterOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartAfterOrderRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCartAfterOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{0}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateCartAfterOrderRequest) GetUserID() int64 {
@@ -94,7 +314,7 @@ type UpdateCartAfterOrderResponse struct {
 func (x *UpdateCartAfterOrderResponse) Reset() {
 	*x = UpdateCartAfterOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[1]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -107,7 +327,7 @@ func (x *UpdateCartAfterOrderResponse) String() string {
 func (*UpdateCartAfterOrderResponse) ProtoMessage() {}
 
 func (x *UpdateCartAfterOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[1]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -120,7 +340,7 @@ func (x *UpdateCartAfterOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartAfterOrderResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCartAfterOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{1}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateCartAfterOrderResponse) GetError() string {
@@ -141,7 +361,7 @@ type TotalAmountInCartRequest struct {
 func (x *TotalAmountInCartRequest) Reset() {
 	*x = TotalAmountInCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[2]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -154,7 +374,7 @@ func (x *TotalAmountInCartRequest) String() string {
 func (*TotalAmountInCartRequest) ProtoMessage() {}
 
 func (x *TotalAmountInCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[2]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +387,7 @@ func (x *TotalAmountInCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TotalAmountInCartRequest.ProtoReflect.Descriptor instead.
 func (*TotalAmountInCartRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{2}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TotalAmountInCartRequest) GetUserID() int64 {
@@ -182,14 +402,18 @@ type TotalAmountInCartResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data  float32 `protobuf:"fixed32,1,opt,name=data,proto3" json:"data,omitempty"`
-	Error string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Data          float32 `protobuf:"fixed32,1,opt,name=data,proto3" json:"data,omitempty"`
+	Error         string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Discount      float32 `protobuf:"fixed32,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	FinalPrice    float32 `protobuf:"fixed32,4,opt,name=finalPrice,proto3" json:"finalPrice,omitempty"`
+	CouponCode    string  `protobuf:"bytes,5,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	OfferDiscount float32 `protobuf:"fixed32,6,opt,name=offerDiscount,proto3" json:"offerDiscount,omitempty"`
 }
 
 func (x *TotalAmountInCartResponse) Reset() {
 	*x = TotalAmountInCartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[3]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +426,7 @@ func (x *TotalAmountInCartResponse) String() string {
 func (*TotalAmountInCartResponse) ProtoMessage() {}
 
 func (x *TotalAmountInCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[3]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +439,7 @@ func (x *TotalAmountInCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TotalAmountInCartResponse.ProtoReflect.Descriptor instead.
 func (*TotalAmountInCartResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{3}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TotalAmountInCartResponse) GetData() float32 {
@@ -232,6 +456,34 @@ func (x *TotalAmountInCartResponse) GetError() string {
 	return ""
 }
 
+func (x *TotalAmountInCartResponse) GetDiscount() float32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *TotalAmountInCartResponse) GetFinalPrice() float32 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *TotalAmountInCartResponse) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *TotalAmountInCartResponse) GetOfferDiscount() float32 {
+	if x != nil {
+		return x.OfferDiscount
+	}
+	return 0
+}
+
 type DoesCartExistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -243,7 +495,7 @@ type DoesCartExistRequest struct {
 func (x *DoesCartExistRequest) Reset() {
 	*x = DoesCartExistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[4]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +508,7 @@ func (x *DoesCartExistRequest) String() string {
 func (*DoesCartExistRequest) ProtoMessage() {}
 
 func (x *DoesCartExistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[4]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +521,7 @@ func (x *DoesCartExistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoesCartExistRequest.ProtoReflect.Descriptor instead.
 func (*DoesCartExistRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{4}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DoesCartExistRequest) GetUserID() int64 {
@@ -291,7 +543,7 @@ type DoesCartExistReponse struct {
 func (x *DoesCartExistReponse) Reset() {
 	*x = DoesCartExistReponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[5]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +556,7 @@ func (x *DoesCartExistReponse) String() string {
 func (*DoesCartExistReponse) ProtoMessage() {}
 
 func (x *DoesCartExistReponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[5]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +569,7 @@ func (x *DoesCartExistReponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoesCartExistReponse.ProtoReflect.Descriptor instead.
 func (*DoesCartExistReponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{5}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DoesCartExistReponse) GetData() bool {
@@ -347,7 +599,7 @@ type AddToCartRequest struct {
 func (x *AddToCartRequest) Reset() {
 	*x = AddToCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[6]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -360,7 +612,7 @@ func (x *AddToCartRequest) String() string {
 func (*AddToCartRequest) ProtoMessage() {}
 
 func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[6]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +625,7 @@ func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
 func (*AddToCartRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{6}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AddToCartRequest) GetProductID() int64 {
@@ -402,15 +654,22 @@ type CartDetails struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductID  int64   `protobuf:"varint,1,opt,name=productID,proto3" json:"productID,omitempty"`
-	Quantity   float32 `protobuf:"fixed32,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
-	TotalPrice float32 `protobuf:"fixed32,3,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	ProductID    int64   `protobuf:"varint,1,opt,name=productID,proto3" json:"productID,omitempty"`
+	Quantity     float32 `protobuf:"fixed32,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	TotalPrice   float32 `protobuf:"fixed32,3,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Discount     float32 `protobuf:"fixed32,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	FinalPrice   float32 `protobuf:"fixed32,5,opt,name=finalPrice,proto3" json:"finalPrice,omitempty"`
+	Offer        string  `protobuf:"bytes,6,opt,name=offer,proto3" json:"offer,omitempty"`
+	UnitPrice    float32 `protobuf:"fixed32,7,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	AddedPrice   float32 `protobuf:"fixed32,8,opt,name=addedPrice,proto3" json:"addedPrice,omitempty"`
+	PriceChanged bool    `protobuf:"varint,9,opt,name=priceChanged,proto3" json:"priceChanged,omitempty"`
+	HeldUntil    string  `protobuf:"bytes,10,opt,name=heldUntil,proto3" json:"heldUntil,omitempty"`
 }
 
 func (x *CartDetails) Reset() {
 	*x = CartDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[7]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -423,7 +682,7 @@ func (x *CartDetails) String() string {
 func (*CartDetails) ProtoMessage() {}
 
 func (x *CartDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[7]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +695,7 @@ func (x *CartDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartDetails.ProtoReflect.Descriptor instead.
 func (*CartDetails) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{7}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CartDetails) GetProductID() int64 {
@@ -460,20 +719,75 @@ func (x *CartDetails) GetTotalPrice() float32 {
 	return 0
 }
 
-type AddToCartResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CartDetails) GetDiscount() float32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
 
-	Price float32        `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
-	Cart  []*CartDetails `protobuf:"bytes,2,rep,name=cart,proto3" json:"cart,omitempty"`
-	Error string         `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+func (x *CartDetails) GetFinalPrice() float32 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *CartDetails) GetOffer() string {
+	if x != nil {
+		return x.Offer
+	}
+	return ""
+}
+
+func (x *CartDetails) GetUnitPrice() float32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CartDetails) GetAddedPrice() float32 {
+	if x != nil {
+		return x.AddedPrice
+	}
+	return 0
+}
+
+func (x *CartDetails) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CartDetails) GetHeldUntil() string {
+	if x != nil {
+		return x.HeldUntil
+	}
+	return ""
+}
+
+type AddToCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price         float32        `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
+	Cart          []*CartDetails `protobuf:"bytes,2,rep,name=cart,proto3" json:"cart,omitempty"`
+	Error         string         `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Discount      float32        `protobuf:"fixed32,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	FinalPrice    float32        `protobuf:"fixed32,5,opt,name=finalPrice,proto3" json:"finalPrice,omitempty"`
+	CouponCode    string         `protobuf:"bytes,6,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	CouponNotice  string         `protobuf:"bytes,7,opt,name=couponNotice,proto3" json:"couponNotice,omitempty"`
+	OfferDiscount float32        `protobuf:"fixed32,8,opt,name=offerDiscount,proto3" json:"offerDiscount,omitempty"`
+	PriceNotices  []string       `protobuf:"bytes,9,rep,name=priceNotices,proto3" json:"priceNotices,omitempty"`
 }
 
 func (x *AddToCartResponse) Reset() {
 	*x = AddToCartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[8]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -486,7 +800,7 @@ func (x *AddToCartResponse) String() string {
 func (*AddToCartResponse) ProtoMessage() {}
 
 func (x *AddToCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[8]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +813,7 @@ func (x *AddToCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToCartResponse.ProtoReflect.Descriptor instead.
 func (*AddToCartResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{8}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddToCartResponse) GetPrice() float32 {
@@ -523,6 +837,48 @@ func (x *AddToCartResponse) GetError() string {
 	return ""
 }
 
+func (x *AddToCartResponse) GetDiscount() float32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *AddToCartResponse) GetFinalPrice() float32 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *AddToCartResponse) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *AddToCartResponse) GetCouponNotice() string {
+	if x != nil {
+		return x.CouponNotice
+	}
+	return ""
+}
+
+func (x *AddToCartResponse) GetOfferDiscount() float32 {
+	if x != nil {
+		return x.OfferDiscount
+	}
+	return 0
+}
+
+func (x *AddToCartResponse) GetPriceNotices() []string {
+	if x != nil {
+		return x.PriceNotices
+	}
+	return nil
+}
+
 type GetCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -534,7 +890,7 @@ type GetCartRequest struct {
 func (x *GetCartRequest) Reset() {
 	*x = GetCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[9]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -547,7 +903,7 @@ func (x *GetCartRequest) String() string {
 func (*GetCartRequest) ProtoMessage() {}
 
 func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[9]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +916,7 @@ func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
 func (*GetCartRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{9}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetCartRequest) GetUserID() int64 {
@@ -575,15 +931,21 @@ type GetCartResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Price float32        `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
-	Cart  []*CartDetails `protobuf:"bytes,2,rep,name=cart,proto3" json:"cart,omitempty"`
-	Error string         `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Price         float32        `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
+	Cart          []*CartDetails `protobuf:"bytes,2,rep,name=cart,proto3" json:"cart,omitempty"`
+	Error         string         `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Discount      float32        `protobuf:"fixed32,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	FinalPrice    float32        `protobuf:"fixed32,5,opt,name=finalPrice,proto3" json:"finalPrice,omitempty"`
+	CouponCode    string         `protobuf:"bytes,6,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	CouponNotice  string         `protobuf:"bytes,7,opt,name=couponNotice,proto3" json:"couponNotice,omitempty"`
+	OfferDiscount float32        `protobuf:"fixed32,8,opt,name=offerDiscount,proto3" json:"offerDiscount,omitempty"`
+	PriceNotices  []string       `protobuf:"bytes,9,rep,name=priceNotices,proto3" json:"priceNotices,omitempty"`
 }
 
 func (x *GetCartResponse) Reset() {
 	*x = GetCartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[10]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -596,7 +958,7 @@ func (x *GetCartResponse) String() string {
 func (*GetCartResponse) ProtoMessage() {}
 
 func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[10]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +971,7 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
 func (*GetCartResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{10}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetCartResponse) GetPrice() float32 {
@@ -633,6 +995,48 @@ func (x *GetCartResponse) GetError() string {
 	return ""
 }
 
+func (x *GetCartResponse) GetDiscount() float32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *GetCartResponse) GetFinalPrice() float32 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *GetCartResponse) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *GetCartResponse) GetCouponNotice() string {
+	if x != nil {
+		return x.CouponNotice
+	}
+	return ""
+}
+
+func (x *GetCartResponse) GetOfferDiscount() float32 {
+	if x != nil {
+		return x.OfferDiscount
+	}
+	return 0
+}
+
+func (x *GetCartResponse) GetPriceNotices() []string {
+	if x != nil {
+		return x.PriceNotices
+	}
+	return nil
+}
+
 type GetAllItemsFromCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -644,7 +1048,7 @@ type GetAllItemsFromCartRequest struct {
 func (x *GetAllItemsFromCartRequest) Reset() {
 	*x = GetAllItemsFromCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[11]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +1061,7 @@ func (x *GetAllItemsFromCartRequest) String() string {
 func (*GetAllItemsFromCartRequest) ProtoMessage() {}
 
 func (x *GetAllItemsFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[11]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +1074,7 @@ func (x *GetAllItemsFromCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllItemsFromCartRequest.ProtoReflect.Descriptor instead.
 func (*GetAllItemsFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{11}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAllItemsFromCartRequest) GetUserID() int64 {
@@ -692,7 +1096,7 @@ type GetAllItemsFromCartResponse struct {
 func (x *GetAllItemsFromCartResponse) Reset() {
 	*x = GetAllItemsFromCartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_cart_cart_proto_msgTypes[12]
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1109,7 @@ func (x *GetAllItemsFromCartResponse) String() string {
 func (*GetAllItemsFromCartResponse) ProtoMessage() {}
 
 func (x *GetAllItemsFromCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_cart_cart_proto_msgTypes[12]
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1122,7 @@ func (x *GetAllItemsFromCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllItemsFromCartResponse.ProtoReflect.Descriptor instead.
 func (*GetAllItemsFromCartResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{12}
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetAllItemsFromCartResponse) GetCart() []*CartDetails {
@@ -735,251 +1139,2054 @@ func (x *GetAllItemsFromCartResponse) GetError() string {
 	return ""
 }
 
-var File_pkg_pb_cart_cart_proto protoreflect.FileDescriptor
+type Coupon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_pb_cart_cart_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x2f, 0x63, 0x61,
-	0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x61, 0x72, 0x74, 0x22, 0x6f,
-	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65,
-	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
-	0x34, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74,
-	0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x18, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x19, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x2e, 0x0a, 0x14, 0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x40, 0x0a, 0x14, 0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x64, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x67, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x74,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04,
-	0x63, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72,
-	0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x63,
-	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04,
-	0x63, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72,
-	0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x63,
-	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
-	0x5a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72,
-	0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xe2, 0x03, 0x0a, 0x04,
-	0x43, 0x61, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72,
-	0x74, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x74,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x12,
-	0x14, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f,
-	0x6d, 0x43, 0x61, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d,
-	0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e,
-	0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74,
-	0x2e, 0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x63,
-	0x61, 0x72, 0x74, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
-	0x61, 0x72, 0x74, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74,
-	0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x72,
-	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65,
-	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0x0f, 0x5a, 0x0d, 0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x72,
-	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Code          string  `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	Description   string  `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	DiscountType  string  `protobuf:"bytes,4,opt,name=DiscountType,proto3" json:"DiscountType,omitempty"`
+	DiscountValue float32 `protobuf:"fixed32,5,opt,name=DiscountValue,proto3" json:"DiscountValue,omitempty"`
+	MinCartValue  float32 `protobuf:"fixed32,6,opt,name=MinCartValue,proto3" json:"MinCartValue,omitempty"`
+	MaxDiscount   float32 `protobuf:"fixed32,7,opt,name=MaxDiscount,proto3" json:"MaxDiscount,omitempty"`
+	ValidFrom     string  `protobuf:"bytes,8,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidUntil    string  `protobuf:"bytes,9,opt,name=ValidUntil,proto3" json:"ValidUntil,omitempty"`
+	UsageLimit    int64   `protobuf:"varint,10,opt,name=UsageLimit,proto3" json:"UsageLimit,omitempty"`
+	PerUserLimit  int64   `protobuf:"varint,11,opt,name=PerUserLimit,proto3" json:"PerUserLimit,omitempty"`
+	Active        bool    `protobuf:"varint,12,opt,name=Active,proto3" json:"Active,omitempty"`
+	CreatedBy     string  `protobuf:"bytes,13,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_pkg_pb_cart_cart_proto_rawDescOnce sync.Once
-	file_pkg_pb_cart_cart_proto_rawDescData = file_pkg_pb_cart_cart_proto_rawDesc
-)
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_pkg_pb_cart_cart_proto_rawDescGZIP() []byte {
-	file_pkg_pb_cart_cart_proto_rawDescOnce.Do(func() {
-		file_pkg_pb_cart_cart_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_cart_cart_proto_rawDescData)
-	})
-	return file_pkg_pb_cart_cart_proto_rawDescData
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_pkg_pb_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_pkg_pb_cart_cart_proto_goTypes = []any{
-	(*UpdateCartAfterOrderRequest)(nil),  // 0: cart.UpdateCartAfterOrderRequest
-	(*UpdateCartAfterOrderResponse)(nil), // 1: cart.UpdateCartAfterOrderResponse
-	(*TotalAmountInCartRequest)(nil),     // 2: cart.TotalAmountInCartRequest
-	(*TotalAmountInCartResponse)(nil),    // 3: cart.TotalAmountInCartResponse
-	(*DoesCartExistRequest)(nil),         // 4: cart.DoesCartExistRequest
-	(*DoesCartExistReponse)(nil),         // 5: cart.DoesCartExistReponse
-	(*AddToCartRequest)(nil),             // 6: cart.AddToCartRequest
-	(*CartDetails)(nil),                  // 7: cart.CartDetails
-	(*AddToCartResponse)(nil),            // 8: cart.AddToCartResponse
-	(*GetCartRequest)(nil),               // 9: cart.GetCartRequest
-	(*GetCartResponse)(nil),              // 10: cart.GetCartResponse
-	(*GetAllItemsFromCartRequest)(nil),   // 11: cart.GetAllItemsFromCartRequest
-	(*GetAllItemsFromCartResponse)(nil),  // 12: cart.GetAllItemsFromCartResponse
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{17}
 }
-var file_pkg_pb_cart_cart_proto_depIdxs = []int32{
-	7,  // 0: cart.AddToCartResponse.cart:type_name -> cart.CartDetails
-	7,  // 1: cart.GetCartResponse.cart:type_name -> cart.CartDetails
-	7,  // 2: cart.GetAllItemsFromCartResponse.Cart:type_name -> cart.CartDetails
-	6,  // 3: cart.Cart.AddToCart:input_type -> cart.AddToCartRequest
-	9,  // 4: cart.Cart.GetCart:input_type -> cart.GetCartRequest
-	11, // 5: cart.Cart.GetAllItemsFromCart:input_type -> cart.GetAllItemsFromCartRequest
-	4,  // 6: cart.Cart.DoesCartExist:input_type -> cart.DoesCartExistRequest
-	2,  // 7: cart.Cart.TotalAmountInCart:input_type -> cart.TotalAmountInCartRequest
-	0,  // 8: cart.Cart.UpdateCartAfterOrder:input_type -> cart.UpdateCartAfterOrderRequest
-	8,  // 9: cart.Cart.AddToCart:output_type -> cart.AddToCartResponse
-	10, // 10: cart.Cart.GetCart:output_type -> cart.GetCartResponse
-	12, // 11: cart.Cart.GetAllItemsFromCart:output_type -> cart.GetAllItemsFromCartResponse
-	5,  // 12: cart.Cart.DoesCartExist:output_type -> cart.DoesCartExistReponse
-	3,  // 13: cart.Cart.TotalAmountInCart:output_type -> cart.TotalAmountInCartResponse
-	1,  // 14: cart.Cart.UpdateCartAfterOrder:output_type -> cart.UpdateCartAfterOrderResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+func (x *Coupon) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
 }
 
-func init() { file_pkg_pb_cart_cart_proto_init() }
-func file_pkg_pb_cart_cart_proto_init() {
-	if File_pkg_pb_cart_cart_proto != nil {
-		return
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_pb_cart_cart_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateCartAfterOrderRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateCartAfterOrderResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*TotalAmountInCartRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*TotalAmountInCartResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*DoesCartExistRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DoesCartExistReponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*AddToCartRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_pb_cart_cart_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CartDetails); i {
+	return ""
+}
+
+func (x *Coupon) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Coupon) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Coupon) GetDiscountValue() float32 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *Coupon) GetMinCartValue() float32 {
+	if x != nil {
+		return x.MinCartValue
+	}
+	return 0
+}
+
+func (x *Coupon) GetMaxDiscount() float32 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *Coupon) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *Coupon) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Coupon) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Coupon) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type AddCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coupon *Coupon `protobuf:"bytes,1,opt,name=Coupon,proto3" json:"Coupon,omitempty"`
+	Actor  string  `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *AddCouponRequest) Reset() {
+	*x = AddCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCouponRequest) ProtoMessage() {}
+
+func (x *AddCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCouponRequest.ProtoReflect.Descriptor instead.
+func (*AddCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddCouponRequest) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *AddCouponRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type UpdateCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Coupon *Coupon `protobuf:"bytes,2,opt,name=Coupon,proto3" json:"Coupon,omitempty"`
+}
+
+func (x *UpdateCouponRequest) Reset() {
+	*x = UpdateCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCouponRequest) ProtoMessage() {}
+
+func (x *UpdateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCouponRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateCouponRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateCouponRequest) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type CouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coupon *Coupon `protobuf:"bytes,1,opt,name=Coupon,proto3" json:"Coupon,omitempty"`
+	Error  string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *CouponResponse) Reset() {
+	*x = CouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponResponse) ProtoMessage() {}
+
+func (x *CouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponResponse.ProtoReflect.Descriptor instead.
+func (*CouponResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+func (x *CouponResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCouponsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page  int64 `protobuf:"varint,1,opt,name=Page,proto3" json:"Page,omitempty"`
+	Count int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsRequest) ProtoMessage() {}
+
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCouponsRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCouponsRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListCouponsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coupons []*Coupon `protobuf:"bytes,1,rep,name=Coupons,proto3" json:"Coupons,omitempty"`
+	Error   string    `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ListCouponsResponse) Reset() {
+	*x = ListCouponsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCouponsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCouponsResponse) ProtoMessage() {}
+
+func (x *ListCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCouponsResponse) GetCoupons() []*Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *ListCouponsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteCouponRequest) Reset() {
+	*x = DeleteCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponRequest) ProtoMessage() {}
+
+func (x *DeleteCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteCouponRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *DeleteCouponResponse) Reset() {
+	*x = DeleteCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCouponResponse) ProtoMessage() {}
+
+func (x *DeleteCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCouponResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCouponResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteCouponResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ApplyCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ApplyCouponRequest) Reset() {
+	*x = ApplyCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponRequest) ProtoMessage() {}
+
+func (x *ApplyCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApplyCouponRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ApplyCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RemoveCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *RemoveCouponRequest) Reset() {
+	*x = RemoveCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCouponRequest) ProtoMessage() {}
+
+func (x *RemoveCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCouponRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveCouponRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RedeemCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OrderID  int64   `protobuf:"varint,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Code     string  `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Discount float32 `protobuf:"fixed32,4,opt,name=discount,proto3" json:"discount,omitempty"`
+}
+
+func (x *RedeemCouponRequest) Reset() {
+	*x = RedeemCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponRequest) ProtoMessage() {}
+
+func (x *RedeemCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponRequest.ProtoReflect.Descriptor instead.
+func (*RedeemCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RedeemCouponRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RedeemCouponRequest) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+func (x *RedeemCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemCouponRequest) GetDiscount() float32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+type RedeemCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discount float32 `protobuf:"fixed32,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	Error    string  `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *RedeemCouponResponse) Reset() {
+	*x = RedeemCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponResponse) ProtoMessage() {}
+
+func (x *RedeemCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponResponse.ProtoReflect.Descriptor instead.
+func (*RedeemCouponResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RedeemCouponResponse) GetDiscount() float32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *RedeemCouponResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReleaseCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+}
+
+func (x *ReleaseCouponRequest) Reset() {
+	*x = ReleaseCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCouponRequest) ProtoMessage() {}
+
+func (x *ReleaseCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCouponRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseCouponRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReleaseCouponRequest) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+type ReleaseCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ReleaseCouponResponse) Reset() {
+	*x = ReleaseCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCouponResponse) ProtoMessage() {}
+
+func (x *ReleaseCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCouponResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseCouponResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReleaseCouponResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GuestCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuestID   string `protobuf:"bytes,1,opt,name=guestID,proto3" json:"guestID,omitempty"`
+	ProductID int64  `protobuf:"varint,2,opt,name=productID,proto3" json:"productID,omitempty"`
+	Quantity  int64  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *GuestCartRequest) Reset() {
+	*x = GuestCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartRequest) ProtoMessage() {}
+
+func (x *GuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartRequest.ProtoReflect.Descriptor instead.
+func (*GuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GuestCartRequest) GetGuestID() string {
+	if x != nil {
+		return x.GuestID
+	}
+	return ""
+}
+
+func (x *GuestCartRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *GuestCartRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type MergeGuestCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuestID string `protobuf:"bytes,1,opt,name=guestID,proto3" json:"guestID,omitempty"`
+	UserID  int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *MergeGuestCartRequest) Reset() {
+	*x = MergeGuestCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestCartRequest) ProtoMessage() {}
+
+func (x *MergeGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MergeGuestCartRequest) GetGuestID() string {
+	if x != nil {
+		return x.GuestID
+	}
+	return ""
+}
+
+func (x *MergeGuestCartRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type MergeGuestCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cart    *GetCartResponse `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	Notices []string         `protobuf:"bytes,2,rep,name=notices,proto3" json:"notices,omitempty"`
+	Error   string           `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *MergeGuestCartResponse) Reset() {
+	*x = MergeGuestCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestCartResponse) ProtoMessage() {}
+
+func (x *MergeGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*MergeGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MergeGuestCartResponse) GetCart() *GetCartResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *MergeGuestCartResponse) GetNotices() []string {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+func (x *MergeGuestCartResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WishlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ProductID int64 `protobuf:"varint,2,opt,name=productID,proto3" json:"productID,omitempty"`
+	Quantity  int64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *WishlistRequest) Reset() {
+	*x = WishlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistRequest) ProtoMessage() {}
+
+func (x *WishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistRequest.ProtoReflect.Descriptor instead.
+func (*WishlistRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WishlistRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *WishlistRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *WishlistRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type WishlistItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID    int64   `protobuf:"varint,1,opt,name=productID,proto3" json:"productID,omitempty"`
+	Price        float32 `protobuf:"fixed32,2,opt,name=price,proto3" json:"price,omitempty"`
+	AddedPrice   float32 `protobuf:"fixed32,3,opt,name=addedPrice,proto3" json:"addedPrice,omitempty"`
+	PriceChanged bool    `protobuf:"varint,4,opt,name=priceChanged,proto3" json:"priceChanged,omitempty"`
+	InStock      bool    `protobuf:"varint,5,opt,name=inStock,proto3" json:"inStock,omitempty"`
+	StockStatus  string  `protobuf:"bytes,6,opt,name=stockStatus,proto3" json:"stockStatus,omitempty"`
+	AddedAt      string  `protobuf:"bytes,7,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WishlistItem) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetAddedPrice() float32 {
+	if x != nil {
+		return x.AddedPrice
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *WishlistItem) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *WishlistItem) GetStockStatus() string {
+	if x != nil {
+		return x.StockStatus
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type WishlistItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item  *WishlistItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Error string        `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *WishlistItemResponse) Reset() {
+	*x = WishlistItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WishlistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItemResponse) ProtoMessage() {}
+
+func (x *WishlistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItemResponse.ProtoReflect.Descriptor instead.
+func (*WishlistItemResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WishlistItemResponse) GetItem() *WishlistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *WishlistItemResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RemoveFromWishlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *RemoveFromWishlistResponse) Reset() {
+	*x = RemoveFromWishlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFromWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveFromWishlistResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListWishlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Count  int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListWishlistRequest) Reset() {
+	*x = ListWishlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistRequest) ProtoMessage() {}
+
+func (x *ListWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListWishlistRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ListWishlistRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWishlistRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListWishlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WishlistItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Error string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ListWishlistResponse) Reset() {
+	*x = ListWishlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_cart_cart_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponse) ProtoMessage() {}
+
+func (x *ListWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_cart_cart_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_cart_cart_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListWishlistResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_pkg_pb_cart_cart_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_cart_cart_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x2f, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x61, 0x72, 0x74, 0x22, 0x4a,
+	0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x71, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x4d, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22, 0x6f, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x34, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x32,
+	0x0a, 0x18, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0xc7, 0x01, 0x0a, 0x19, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x14,
+	0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x14,
+	0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x64,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x22, 0xb9, 0x02, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x6c, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x65, 0x6c, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x22, 0xb0, 0x02, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04,
+	0x63, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72,
+	0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x63,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xae, 0x02,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x63, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x22, 0x34,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x5a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x96, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4d,
+	0x69, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x4d, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x4d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x1e, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4e, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x06, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4b, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x24, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x06,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40,
+	0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x2d, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x77, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x10, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x49, 0x0a, 0x15, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x73, 0x0a, 0x16, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x63, 0x0a, 0x0f, 0x57,
+	0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xdc, 0x01, 0x0a, 0x0c, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x54, 0x0a, 0x14, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x57, 0x69, 0x73,
+	0x68, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x57, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xba, 0x0f, 0x0a, 0x04, 0x43,
+	0x61, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74,
+	0x12, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e,
+	0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d,
+	0x43, 0x61, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x44,
+	0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e,
+	0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x72, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x11, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x18, 0x2e,
+	0x63, 0x61, 0x72, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x1a,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72,
+	0x74, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x54, 0x6f, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x13,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x75,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x57, 0x69, 0x73,
+	0x68, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x57, 0x69, 0x73,
+	0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x61,
+	0x72, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x74,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x57, 0x69, 0x73, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0f, 0x5a, 0x0d, 0x2e, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_pb_cart_cart_proto_rawDescOnce sync.Once
+	file_pkg_pb_cart_cart_proto_rawDescData = file_pkg_pb_cart_cart_proto_rawDesc
+)
+
+func file_pkg_pb_cart_cart_proto_rawDescGZIP() []byte {
+	file_pkg_pb_cart_cart_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_cart_cart_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_cart_cart_proto_rawDescData)
+	})
+	return file_pkg_pb_cart_cart_proto_rawDescData
+}
+
+var file_pkg_pb_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_pkg_pb_cart_cart_proto_goTypes = []any{
+	(*ClearCartRequest)(nil),              // 0: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),             // 1: cart.ClearCartResponse
+	(*UpdateCartItemQuantityRequest)(nil), // 2: cart.UpdateCartItemQuantityRequest
+	(*RemoveFromCartRequest)(nil),         // 3: cart.RemoveFromCartRequest
+	(*UpdateCartAfterOrderRequest)(nil),   // 4: cart.UpdateCartAfterOrderRequest
+	(*UpdateCartAfterOrderResponse)(nil),  // 5: cart.UpdateCartAfterOrderResponse
+	(*TotalAmountInCartRequest)(nil),      // 6: cart.TotalAmountInCartRequest
+	(*TotalAmountInCartResponse)(nil),     // 7: cart.TotalAmountInCartResponse
+	(*DoesCartExistRequest)(nil),          // 8: cart.DoesCartExistRequest
+	(*DoesCartExistReponse)(nil),          // 9: cart.DoesCartExistReponse
+	(*AddToCartRequest)(nil),              // 10: cart.AddToCartRequest
+	(*CartDetails)(nil),                   // 11: cart.CartDetails
+	(*AddToCartResponse)(nil),             // 12: cart.AddToCartResponse
+	(*GetCartRequest)(nil),                // 13: cart.GetCartRequest
+	(*GetCartResponse)(nil),               // 14: cart.GetCartResponse
+	(*GetAllItemsFromCartRequest)(nil),    // 15: cart.GetAllItemsFromCartRequest
+	(*GetAllItemsFromCartResponse)(nil),   // 16: cart.GetAllItemsFromCartResponse
+	(*Coupon)(nil),                        // 17: cart.Coupon
+	(*AddCouponRequest)(nil),              // 18: cart.AddCouponRequest
+	(*UpdateCouponRequest)(nil),           // 19: cart.UpdateCouponRequest
+	(*CouponResponse)(nil),                // 20: cart.CouponResponse
+	(*ListCouponsRequest)(nil),            // 21: cart.ListCouponsRequest
+	(*ListCouponsResponse)(nil),           // 22: cart.ListCouponsResponse
+	(*DeleteCouponRequest)(nil),           // 23: cart.DeleteCouponRequest
+	(*DeleteCouponResponse)(nil),          // 24: cart.DeleteCouponResponse
+	(*ApplyCouponRequest)(nil),            // 25: cart.ApplyCouponRequest
+	(*RemoveCouponRequest)(nil),           // 26: cart.RemoveCouponRequest
+	(*RedeemCouponRequest)(nil),           // 27: cart.RedeemCouponRequest
+	(*RedeemCouponResponse)(nil),          // 28: cart.RedeemCouponResponse
+	(*ReleaseCouponRequest)(nil),          // 29: cart.ReleaseCouponRequest
+	(*ReleaseCouponResponse)(nil),         // 30: cart.ReleaseCouponResponse
+	(*GuestCartRequest)(nil),              // 31: cart.GuestCartRequest
+	(*MergeGuestCartRequest)(nil),         // 32: cart.MergeGuestCartRequest
+	(*MergeGuestCartResponse)(nil),        // 33: cart.MergeGuestCartResponse
+	(*WishlistRequest)(nil),               // 34: cart.WishlistRequest
+	(*WishlistItem)(nil),                  // 35: cart.WishlistItem
+	(*WishlistItemResponse)(nil),          // 36: cart.WishlistItemResponse
+	(*RemoveFromWishlistResponse)(nil),    // 37: cart.RemoveFromWishlistResponse
+	(*ListWishlistRequest)(nil),           // 38: cart.ListWishlistRequest
+	(*ListWishlistResponse)(nil),          // 39: cart.ListWishlistResponse
+}
+var file_pkg_pb_cart_cart_proto_depIdxs = []int32{
+	11, // 0: cart.AddToCartResponse.cart:type_name -> cart.CartDetails
+	11, // 1: cart.GetCartResponse.cart:type_name -> cart.CartDetails
+	11, // 2: cart.GetAllItemsFromCartResponse.Cart:type_name -> cart.CartDetails
+	17, // 3: cart.AddCouponRequest.Coupon:type_name -> cart.Coupon
+	17, // 4: cart.UpdateCouponRequest.Coupon:type_name -> cart.Coupon
+	17, // 5: cart.CouponResponse.Coupon:type_name -> cart.Coupon
+	17, // 6: cart.ListCouponsResponse.Coupons:type_name -> cart.Coupon
+	14, // 7: cart.MergeGuestCartResponse.cart:type_name -> cart.GetCartResponse
+	35, // 8: cart.WishlistItemResponse.item:type_name -> cart.WishlistItem
+	35, // 9: cart.ListWishlistResponse.items:type_name -> cart.WishlistItem
+	10, // 10: cart.Cart.AddToCart:input_type -> cart.AddToCartRequest
+	13, // 11: cart.Cart.GetCart:input_type -> cart.GetCartRequest
+	15, // 12: cart.Cart.GetAllItemsFromCart:input_type -> cart.GetAllItemsFromCartRequest
+	8,  // 13: cart.Cart.DoesCartExist:input_type -> cart.DoesCartExistRequest
+	6,  // 14: cart.Cart.TotalAmountInCart:input_type -> cart.TotalAmountInCartRequest
+	4,  // 15: cart.Cart.UpdateCartAfterOrder:input_type -> cart.UpdateCartAfterOrderRequest
+	0,  // 16: cart.Cart.ClearCart:input_type -> cart.ClearCartRequest
+	2,  // 17: cart.Cart.UpdateCartItemQuantity:input_type -> cart.UpdateCartItemQuantityRequest
+	3,  // 18: cart.Cart.RemoveFromCart:input_type -> cart.RemoveFromCartRequest
+	18, // 19: cart.Cart.AddCoupon:input_type -> cart.AddCouponRequest
+	19, // 20: cart.Cart.UpdateCoupon:input_type -> cart.UpdateCouponRequest
+	21, // 21: cart.Cart.ListCoupons:input_type -> cart.ListCouponsRequest
+	23, // 22: cart.Cart.DeleteCoupon:input_type -> cart.DeleteCouponRequest
+	25, // 23: cart.Cart.ApplyCoupon:input_type -> cart.ApplyCouponRequest
+	26, // 24: cart.Cart.RemoveCoupon:input_type -> cart.RemoveCouponRequest
+	27, // 25: cart.Cart.RedeemCoupon:input_type -> cart.RedeemCouponRequest
+	29, // 26: cart.Cart.ReleaseCoupon:input_type -> cart.ReleaseCouponRequest
+	31, // 27: cart.Cart.AddToGuestCart:input_type -> cart.GuestCartRequest
+	31, // 28: cart.Cart.UpdateGuestCartItemQuantity:input_type -> cart.GuestCartRequest
+	31, // 29: cart.Cart.RemoveFromGuestCart:input_type -> cart.GuestCartRequest
+	31, // 30: cart.Cart.GetGuestCart:input_type -> cart.GuestCartRequest
+	32, // 31: cart.Cart.MergeGuestCart:input_type -> cart.MergeGuestCartRequest
+	34, // 32: cart.Cart.AddToWishlist:input_type -> cart.WishlistRequest
+	34, // 33: cart.Cart.RemoveFromWishlist:input_type -> cart.WishlistRequest
+	38, // 34: cart.Cart.ListWishlist:input_type -> cart.ListWishlistRequest
+	34, // 35: cart.Cart.MoveToCart:input_type -> cart.WishlistRequest
+	34, // 36: cart.Cart.SaveForLater:input_type -> cart.WishlistRequest
+	12, // 37: cart.Cart.AddToCart:output_type -> cart.AddToCartResponse
+	14, // 38: cart.Cart.GetCart:output_type -> cart.GetCartResponse
+	16, // 39: cart.Cart.GetAllItemsFromCart:output_type -> cart.GetAllItemsFromCartResponse
+	9,  // 40: cart.Cart.DoesCartExist:output_type -> cart.DoesCartExistReponse
+	7,  // 41: cart.Cart.TotalAmountInCart:output_type -> cart.TotalAmountInCartResponse
+	5,  // 42: cart.Cart.UpdateCartAfterOrder:output_type -> cart.UpdateCartAfterOrderResponse
+	1,  // 43: cart.Cart.ClearCart:output_type -> cart.ClearCartResponse
+	14, // 44: cart.Cart.UpdateCartItemQuantity:output_type -> cart.GetCartResponse
+	14, // 45: cart.Cart.RemoveFromCart:output_type -> cart.GetCartResponse
+	20, // 46: cart.Cart.AddCoupon:output_type -> cart.CouponResponse
+	20, // 47: cart.Cart.UpdateCoupon:output_type -> cart.CouponResponse
+	22, // 48: cart.Cart.ListCoupons:output_type -> cart.ListCouponsResponse
+	24, // 49: cart.Cart.DeleteCoupon:output_type -> cart.DeleteCouponResponse
+	14, // 50: cart.Cart.ApplyCoupon:output_type -> cart.GetCartResponse
+	14, // 51: cart.Cart.RemoveCoupon:output_type -> cart.GetCartResponse
+	28, // 52: cart.Cart.RedeemCoupon:output_type -> cart.RedeemCouponResponse
+	30, // 53: cart.Cart.ReleaseCoupon:output_type -> cart.ReleaseCouponResponse
+	14, // 54: cart.Cart.AddToGuestCart:output_type -> cart.GetCartResponse
+	14, // 55: cart.Cart.UpdateGuestCartItemQuantity:output_type -> cart.GetCartResponse
+	14, // 56: cart.Cart.RemoveFromGuestCart:output_type -> cart.GetCartResponse
+	14, // 57: cart.Cart.GetGuestCart:output_type -> cart.GetCartResponse
+	33, // 58: cart.Cart.MergeGuestCart:output_type -> cart.MergeGuestCartResponse
+	36, // 59: cart.Cart.AddToWishlist:output_type -> cart.WishlistItemResponse
+	37, // 60: cart.Cart.RemoveFromWishlist:output_type -> cart.RemoveFromWishlistResponse
+	39, // 61: cart.Cart.ListWishlist:output_type -> cart.ListWishlistResponse
+	14, // 62: cart.Cart.MoveToCart:output_type -> cart.GetCartResponse
+	36, // 63: cart.Cart.SaveForLater:output_type -> cart.WishlistItemResponse
+	37, // [37:64] is the sub-list for method output_type
+	10, // [10:37] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_cart_cart_proto_init() }
+func file_pkg_pb_cart_cart_proto_init() {
+	if File_pkg_pb_cart_cart_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_pb_cart_cart_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ClearCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ClearCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCartItemQuantityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveFromCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCartAfterOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCartAfterOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TotalAmountInCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TotalAmountInCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DoesCartExistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DoesCartExistReponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*AddToCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -990,7 +3197,19 @@ func file_pkg_pb_cart_cart_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_cart_cart_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_pkg_pb_cart_cart_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CartDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*AddToCartResponse); i {
 			case 0:
 				return &v.state
@@ -1002,7 +3221,7 @@ func file_pkg_pb_cart_cart_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_cart_cart_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_pkg_pb_cart_cart_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*GetCartRequest); i {
 			case 0:
 				return &v.state
@@ -1014,7 +3233,7 @@ func file_pkg_pb_cart_cart_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_cart_cart_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_pkg_pb_cart_cart_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*GetCartResponse); i {
 			case 0:
 				return &v.state
@@ -1026,7 +3245,7 @@ func file_pkg_pb_cart_cart_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_cart_cart_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_pkg_pb_cart_cart_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*GetAllItemsFromCartRequest); i {
 			case 0:
 				return &v.state
@@ -1038,7 +3257,7 @@ func file_pkg_pb_cart_cart_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_cart_cart_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_pkg_pb_cart_cart_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*GetAllItemsFromCartResponse); i {
 			case 0:
 				return &v.state
@@ -1050,6 +3269,282 @@ func file_pkg_pb_cart_cart_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_pb_cart_cart_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Coupon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*AddCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*CouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCouponsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCouponsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ApplyCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RedeemCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*RedeemCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GuestCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeGuestCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeGuestCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*WishlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*WishlistItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*WishlistItemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveFromWishlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWishlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_cart_cart_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWishlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1057,7 +3552,7 @@ func file_pkg_pb_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_pb_cart_cart_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"cart-service/pkg/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (cr *cartRepository) CreateCoupon(coupon domain.Coupon) (domain.Coupon, error) {
+	if err := cr.DB.Create(&coupon).Error; err != nil {
+		return domain.Coupon{}, err
+	}
+	return coupon, nil
+}
+
+func (cr *cartRepository) GetCoupon(id int) (domain.Coupon, error) {
+	var coupon domain.Coupon
+	result := cr.DB.Raw("SELECT * FROM coupons WHERE id = ?", id).Scan(&coupon)
+	if result.Error != nil {
+		return domain.Coupon{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Coupon{}, domain.ErrCouponNotFound
+	}
+	return coupon, nil
+}
+
+func (cr *cartRepository) GetCouponByCode(code string) (domain.Coupon, error) {
+	var coupon domain.Coupon
+	result := cr.DB.Raw("SELECT * FROM coupons WHERE code = ?", code).Scan(&coupon)
+	if result.Error != nil {
+		return domain.Coupon{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Coupon{}, domain.ErrCouponNotFound
+	}
+	return coupon, nil
+}
+
+func (cr *cartRepository) ListCoupons(page, count int) ([]domain.Coupon, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	var coupons []domain.Coupon
+	if err := cr.DB.Order("created_at DESC, id DESC").Limit(count).Offset(offset).Find(&coupons).Error; err != nil {
+		return nil, err
+	}
+	return coupons, nil
+}
+
+func (cr *cartRepository) UpdateCoupon(coupon domain.Coupon) (domain.Coupon, error) {
+	result := cr.DB.Model(&coupon).Updates(map[string]interface{}{
+		"code":           coupon.Code,
+		"description":    coupon.Description,
+		"discount_type":  coupon.DiscountType,
+		"discount_value": coupon.DiscountValue,
+		"min_cart_value": coupon.MinCartValue,
+		"max_discount":   coupon.MaxDiscount,
+		"valid_from":     coupon.ValidFrom,
+		"valid_until":    coupon.ValidUntil,
+		"usage_limit":    coupon.UsageLimit,
+		"per_user_limit": coupon.PerUserLimit,
+		"active":         coupon.Active,
+	})
+	if result.Error != nil {
+		return domain.Coupon{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Coupon{}, domain.ErrCouponNotFound
+	}
+	return cr.GetCoupon(int(coupon.ID))
+}
+
+// DeleteCoupon deletes a coupon that was never redeemed and takes it off the
+// carts it is applied to.
+func (cr *cartRepository) DeleteCoupon(id int) error {
+	return cr.DB.Transaction(func(tx *gorm.DB) error {
+		var coupon domain.Coupon
+		result := tx.Raw("SELECT * FROM coupons WHERE id = ? FOR UPDATE", id).Scan(&coupon)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrCouponNotFound
+		}
+		var redeemed int
+		if err := tx.Raw("SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_id = ?", id).Scan(&redeemed).Error; err != nil {
+			return err
+		}
+		if redeemed > 0 {
+			return domain.ErrCouponRedeemed
+		}
+		if err := tx.Exec("DELETE FROM applied_coupons WHERE coupon_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM coupons WHERE id = ?", id).Error
+	})
+}
+
+// CouponCodeTaken reports whether a coupon other than exceptID uses code.
+func (cr *cartRepository) CouponCodeTaken(code string, exceptID int) (bool, error) {
+	var count int
+	if err := cr.DB.Raw("SELECT COUNT(*) FROM coupons WHERE code = ? AND id <> ?", code, exceptID).Scan(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CouponUsage returns how many times a coupon was redeemed in total and by
+// the user. Released redemptions do not count.
+func (cr *cartRepository) CouponUsage(couponID, userID int) (int, int, error) {
+	return couponUsage(cr.DB, couponID, userID)
+}
+
+// ApplyCoupon puts a coupon on the user's cart, replacing any coupon that was
+// applied before.
+func (cr *cartRepository) ApplyCoupon(userID, couponID int) error {
+	applied := domain.AppliedCoupon{UserID: uint(userID), CouponID: uint(couponID)}
+	return cr.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"coupon_id", "created_at"}),
+	}).Create(&applied).Error
+}
+
+func (cr *cartRepository) RemoveCoupon(userID int) error {
+	result := cr.DB.Exec("DELETE FROM applied_coupons WHERE user_id = ?", userID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNoCouponApplied
+	}
+	return nil
+}
+
+// GetAppliedCoupon returns the coupon applied to the user's cart.
+func (cr *cartRepository) GetAppliedCoupon(userID int) (domain.Coupon, error) {
+	var coupon domain.Coupon
+	result := cr.DB.Raw(`SELECT coupons.* FROM applied_coupons
+	JOIN coupons ON coupons.id = applied_coupons.coupon_id
+	WHERE applied_coupons.user_id = ?`, userID).Scan(&coupon)
+	if result.Error != nil {
+		return domain.Coupon{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Coupon{}, domain.ErrNoCouponApplied
+	}
+	return coupon, nil
+}
+
+// RedeemCoupon records the redemption of a coupon for an order and takes the
+// coupon off the user's cart. The coupon row is locked while the usage limits
+// are checked, so concurrent orders cannot go over them. Redeeming for an
+// order that already has a redemption returns that redemption.
+func (cr *cartRepository) RedeemCoupon(redemption domain.CouponRedemption) (domain.CouponRedemption, error) {
+	err := cr.DB.Transaction(func(tx *gorm.DB) error {
+		var coupon domain.Coupon
+		result := tx.Raw("SELECT * FROM coupons WHERE id = ? FOR UPDATE", redemption.CouponID).Scan(&coupon)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrCouponNotFound
+		}
+
+		var existing domain.CouponRedemption
+		result = tx.Raw("SELECT * FROM coupon_redemptions WHERE order_id = ? AND status = ?",
+			redemption.OrderID, domain.RedemptionRedeemed).Scan(&existing)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			redemption = existing
+			return nil
+		}
+
+		total, byUser, err := couponUsage(tx, int(coupon.ID), int(redemption.UserID))
+		if err != nil {
+			return err
+		}
+		if coupon.UsageLimit > 0 && total >= coupon.UsageLimit {
+			return domain.ErrCouponUsageLimit
+		}
+		if coupon.PerUserLimit > 0 && byUser >= coupon.PerUserLimit {
+			return domain.ErrCouponUserLimit
+		}
+
+		redemption.Status = domain.RedemptionRedeemed
+		if err := tx.Create(&redemption).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM applied_coupons WHERE user_id = ?", redemption.UserID).Error
+	})
+	if err != nil {
+		return domain.CouponRedemption{}, err
+	}
+	return redemption, nil
+}
+
+// ReleaseCouponRedemption gives the usage taken by an order back to its
+// coupon. Releasing an order without a redemption does nothing.
+func (cr *cartRepository) ReleaseCouponRedemption(orderID int) error {
+	return cr.DB.Exec("UPDATE coupon_redemptions SET status = ?, updated_at = NOW() WHERE order_id = ? AND status = ?",
+		domain.RedemptionReleased, orderID, domain.RedemptionRedeemed).Error
+}
+
+func couponUsage(tx *gorm.DB, couponID, userID int) (int, int, error) {
+	var usage struct {
+		Total  int
+		ByUser int
+	}
+	err := tx.Raw(`SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE user_id = ?) AS by_user
+	FROM coupon_redemptions WHERE coupon_id = ? AND status = ?`, userID, couponID, domain.RedemptionRedeemed).Scan(&usage).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	return usage.Total, usage.ByUser, nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+func (cr *cartRepository) GuestCartLines(guestID string) ([]models.Cart, error) {
+	var lines []models.Cart
+	err := cr.DB.Raw(`SELECT product_id, quantity, unit_price AS added_price, unit_price * quantity AS total_price
+	FROM guest_cart_items WHERE guest_id = ? ORDER BY id`, guestID).Scan(&lines).Error
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func (cr *cartRepository) QuantityInGuestCart(guestID string, productID int) (int, error) {
+	var quantity int
+	err := cr.DB.Raw("SELECT COALESCE(SUM(quantity), 0) FROM guest_cart_items WHERE guest_id = ? AND product_id = ?", guestID, productID).Scan(&quantity).Error
+	if err != nil {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+// SetGuestCartItem puts a product in the guest cart with the given quantity,
+// replacing the line of that product if there is one.
+func (cr *cartRepository) SetGuestCartItem(guestID string, productID, quantity int, unitPrice float64) error {
+	item := domain.GuestCartItem{
+		GuestID:   guestID,
+		ProductID: uint(productID),
+		Quantity:  quantity,
+		UnitPrice: unitPrice,
+	}
+	return cr.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "guest_id"}, {Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity", "unit_price", "updated_at"}),
+	}).Create(&item).Error
+}
+
+func (cr *cartRepository) RemoveGuestCartItem(guestID string, productID int) error {
+	result := cr.DB.Exec("DELETE FROM guest_cart_items WHERE guest_id = ? AND product_id = ?", guestID, productID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrCartItemNotFound
+	}
+	return nil
+}
+
+func (cr *cartRepository) DeleteGuestCart(guestID string) error {
+	return cr.DB.Exec("DELETE FROM guest_cart_items WHERE guest_id = ?", guestID).Error
+}
+
+// DeleteStaleGuestCarts deletes the guest carts that did not change since
+// before and returns how many lines it deleted.
+func (cr *cartRepository) DeleteStaleGuestCarts(before time.Time) (int, error) {
+	result := cr.DB.Exec(`DELETE FROM guest_cart_items WHERE guest_id IN (
+		SELECT guest_id FROM guest_cart_items GROUP BY guest_id HAVING MAX(updated_at) < ?)`, before)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
@@ -1,12 +1,16 @@
 package interfaces
 
-import "cart-service/pkg/models"
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	"time"
+)
 
 type CartRepository interface {
 	QuantityOfProductInCart(userId int, productId int) (int, error)
-	AddItemIntoCart(userId int, productId int, Quantity int, productprice float64) error
+	AddItemIntoCart(userId int, productId int, Quantity int, unitPrice float64) error
 	TotalPriceForProductInCart(userID int, productID int) (float64, error)
-	UpdateCart(quantity int, price float64, userID int, product_id int) error
+	UpdateCart(quantity int, unitPrice float64, userID int, product_id int) error
 	DisplayCart(userID int) ([]models.Cart, error)
 	GetTotalPrice(userID int) (models.CartTotal, error)
 	EmptyCart(userID int) error
@@ -15,4 +19,38 @@ type CartRepository interface {
 	DoesCartExist(userID int) (bool, error)
 	TotalAmountInCart(userID int) (float64, error)
 	UpdateCartAfterOrder(userID, productID int, quantity float64) error
+	RemoveProductsFromCart(userID int, productIDs []int) error
+	SetCartItemQuantity(userID, productID, quantity int, unitPrice float64) error
+	RemoveCartItem(userID, productID int) error
+	SetCartHold(userID, productID int, heldUntil time.Time) error
+
+	GuestCartLines(guestID string) ([]models.Cart, error)
+	QuantityInGuestCart(guestID string, productID int) (int, error)
+	SetGuestCartItem(guestID string, productID, quantity int, unitPrice float64) error
+	RemoveGuestCartItem(guestID string, productID int) error
+	DeleteGuestCart(guestID string) error
+	DeleteStaleGuestCarts(before time.Time) (int, error)
+
+	AddToWishlist(userID, productID int, price float64) (domain.WishlistItem, error)
+	GetWishlistItem(userID, productID int) (domain.WishlistItem, error)
+	ListWishlist(userID, page, count int) ([]domain.WishlistItem, error)
+	RemoveFromWishlist(userID, productID int) error
+
+	IdleCarts(before time.Time) ([]models.IdleCart, error)
+	RecordCartReminder(userID int, cartUpdatedAt time.Time) (domain.CartReminder, bool, error)
+	DeleteCartReminder(id int) error
+
+	CreateCoupon(coupon domain.Coupon) (domain.Coupon, error)
+	GetCoupon(id int) (domain.Coupon, error)
+	GetCouponByCode(code string) (domain.Coupon, error)
+	ListCoupons(page, count int) ([]domain.Coupon, error)
+	UpdateCoupon(coupon domain.Coupon) (domain.Coupon, error)
+	DeleteCoupon(id int) error
+	CouponCodeTaken(code string, exceptID int) (bool, error)
+	CouponUsage(couponID, userID int) (int, int, error)
+	ApplyCoupon(userID, couponID int) error
+	RemoveCoupon(userID int) error
+	GetAppliedCoupon(userID int) (domain.Coupon, error)
+	RedeemCoupon(redemption domain.CouponRedemption) (domain.CouponRedemption, error)
+	ReleaseCouponRedemption(orderID int) error
 }
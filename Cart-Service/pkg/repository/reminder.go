@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// IdleCarts returns the carts that did not change since before and whose
+// users were not yet reminded of them since they last changed. A cart changes
+// when a line is added or updated, and when lines are removed from it.
+func (cr *cartRepository) IdleCarts(before time.Time) ([]models.IdleCart, error) {
+	var carts []models.IdleCart
+	err := cr.DB.Raw(`SELECT idle.user_id, idle.last_activity FROM (
+		SELECT carts.user_id, GREATEST(MAX(COALESCE(carts.updated_at, carts.created_at)), MAX(cart_activities.updated_at)) AS last_activity
+		FROM carts LEFT JOIN cart_activities ON cart_activities.user_id = carts.user_id
+		GROUP BY carts.user_id
+	) idle
+	WHERE idle.last_activity < ? AND NOT EXISTS (
+		SELECT 1 FROM cart_reminders
+		WHERE cart_reminders.user_id = idle.user_id AND cart_reminders.cart_updated_at >= idle.last_activity
+	)
+	ORDER BY idle.last_activity`, before).Scan(&carts).Error
+	if err != nil {
+		return nil, err
+	}
+	return carts, nil
+}
+
+// RecordCartReminder records a reminder about the cart of the user as it was
+// at cartUpdatedAt. It reports false when one was already recorded, for
+// example by another instance of the service.
+func (cr *cartRepository) RecordCartReminder(userID int, cartUpdatedAt time.Time) (domain.CartReminder, bool, error) {
+	reminder := domain.CartReminder{
+		UserID:        uint(userID),
+		CartUpdatedAt: cartUpdatedAt,
+		SentAt:        time.Now(),
+	}
+	result := cr.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&reminder)
+	if result.Error != nil {
+		return domain.CartReminder{}, false, result.Error
+	}
+	return reminder, result.RowsAffected > 0, nil
+}
+
+func (cr *cartRepository) DeleteCartReminder(id int) error {
+	return cr.DB.Exec("DELETE FROM cart_reminders WHERE id = ?", id).Error
+}
@@ -1,9 +1,10 @@
 package repository
 
 import (
+	"cart-service/pkg/domain"
 	"cart-service/pkg/models"
 	"cart-service/pkg/repository/interfaces"
-	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -27,8 +28,16 @@ func (cr *cartRepository) QuantityOfProductInCart(userId int, productId int) (in
 	return productQty, nil
 }
 
-func (cr *cartRepository) AddItemIntoCart(userId int, productId int, Quantity int, productprice float64) error {
-	if err := cr.DB.Exec("INSERT INTO carts (user_id,product_id,quantity,total_price) values(?,?,?,?)", userId, productId, Quantity, productprice).Error; err != nil {
+// cartLines selects the lines of a cart. Lines added before unit prices were
+// recorded get the average price they were added at, and a hold that expired
+// reads as no hold.
+const cartLines = `SELECT product_id, quantity, total_price,
+	CASE WHEN held_until > NOW() THEN held_until END AS held_until,
+	CASE WHEN unit_price = 0 AND quantity > 0 THEN total_price / quantity ELSE unit_price END AS added_price
+	FROM carts WHERE user_id = ? ORDER BY id`
+
+func (cr *cartRepository) AddItemIntoCart(userId int, productId int, Quantity int, unitPrice float64) error {
+	if err := cr.DB.Exec("INSERT INTO carts (user_id,product_id,quantity,unit_price,total_price,created_at,updated_at) values(?,?,?,?,?,NOW(),NOW())", userId, productId, Quantity, unitPrice, unitPrice*float64(Quantity)).Error; err != nil {
 		return err
 	}
 	return nil
@@ -43,10 +52,10 @@ func (cr *cartRepository) TotalPriceForProductInCart(userID int, productID int)
 	}
 	return totalPrice, nil
 }
-func (cr *cartRepository) UpdateCart(quantity int, price float64, userID int, product_id int) error {
+func (cr *cartRepository) UpdateCart(quantity int, unitPrice float64, userID int, product_id int) error {
 	if err := cr.DB.Exec(`UPDATE carts
-	SET quantity = ?, total_price = ? 
-	WHERE user_id = ? and product_id = ?`, quantity, price, product_id, userID).Error; err != nil {
+	SET quantity = ?, unit_price = ?, total_price = ?, updated_at = NOW()
+	WHERE user_id = ? and product_id = ?`, quantity, unitPrice, unitPrice*float64(quantity), userID, product_id).Error; err != nil {
 		return err
 	}
 
@@ -66,7 +75,7 @@ func (cr *cartRepository) DisplayCart(userID int) ([]models.Cart, error) {
 
 	var cartResponse []models.Cart
 
-	if err := cr.DB.Raw("SELECT * from carts WHERE user_id = ?", userID).Scan(&cartResponse).Error; err != nil {
+	if err := cr.DB.Raw(cartLines, userID).Scan(&cartResponse).Error; err != nil {
 		return []models.Cart{}, err
 	}
 	return cartResponse, nil
@@ -88,10 +97,12 @@ func (cr *cartRepository) GetTotalPrice(userID int) (models.CartTotal, error) {
 }
 
 func (cr *cartRepository) EmptyCart(userID int) error {
-	if err := cr.DB.Exec("DELETE FROM carts WHERE  user_id = ?", userID).Error; err != nil {
-		return err
-	}
-	return nil
+	return cr.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM carts WHERE  user_id = ?", userID).Error; err != nil {
+			return err
+		}
+		return recordCartActivity(tx, userID)
+	})
 }
 
 func (cr *cartRepository) ProductExist(userID int, productID int) (bool, error) {
@@ -115,14 +126,8 @@ func (cr *cartRepository) GetAllItemsFromCart(userID int) ([]models.Cart, error)
 	if count == 0 {
 		return []models.Cart{}, nil
 	}
-	err = cr.DB.Raw("SELECT carts.user_id,carts.product_id,carts.quantity,carts.total_price from carts WHERE user_id = ?", userID).First(&cartResponse).Error
+	err = cr.DB.Raw(cartLines, userID).Scan(&cartResponse).Error
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			if len(cartResponse) == 0 {
-				return []models.Cart{}, nil
-			}
-			return []models.Cart{}, err
-		}
 		return []models.Cart{}, err
 	}
 	return cartResponse, nil
@@ -143,9 +148,61 @@ func (cr *cartRepository) TotalAmountInCart(userID int) (float64, error) {
 	return price, nil
 }
 func (cr *cartRepository) UpdateCartAfterOrder(userID, productID int, quantity float64) error {
-	err := cr.DB.Exec("DELETE FROM carts WHERE user_id = ? and product_id = ?", userID, productID).Error
-	if err != nil {
-		return err
+	return cr.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("DELETE FROM carts WHERE user_id = ? and product_id = ?", userID, productID).Error
+		if err != nil {
+			return err
+		}
+		return recordCartActivity(tx, userID)
+	})
+}
+
+// SetCartItemQuantity changes the quantity of a product in the user's cart
+// and records the unit price it now has.
+func (cr *cartRepository) SetCartItemQuantity(userID, productID, quantity int, unitPrice float64) error {
+	result := cr.DB.Exec("UPDATE carts SET quantity = ?, unit_price = ?, total_price = ?, updated_at = NOW() WHERE user_id = ? AND product_id = ?",
+		quantity, unitPrice, unitPrice*float64(quantity), userID, productID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrCartItemNotFound
 	}
 	return nil
 }
+
+func (cr *cartRepository) RemoveCartItem(userID, productID int) error {
+	return cr.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("DELETE FROM carts WHERE user_id = ? AND product_id = ?", userID, productID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrCartItemNotFound
+		}
+		return recordCartActivity(tx, userID)
+	})
+}
+
+func (cr *cartRepository) RemoveProductsFromCart(userID int, productIDs []int) error {
+	return cr.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("DELETE FROM carts WHERE user_id = ? AND product_id IN ?", userID, productIDs).Error
+		if err != nil {
+			return err
+		}
+		return recordCartActivity(tx, userID)
+	})
+}
+
+// recordCartActivity records that lines were just removed from the user's
+// cart, so the cart does not count as idle since before the change.
+func recordCartActivity(tx *gorm.DB, userID int) error {
+	return tx.Exec(`INSERT INTO cart_activities (user_id, updated_at) VALUES (?, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET updated_at = EXCLUDED.updated_at`, userID).Error
+}
+
+// SetCartHold records until when the stock for a line of the user's cart is
+// held.
+func (cr *cartRepository) SetCartHold(userID, productID int, heldUntil time.Time) error {
+	return cr.DB.Exec("UPDATE carts SET held_until = ? WHERE user_id = ? AND product_id = ?", heldUntil, userID, productID).Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"cart-service/pkg/domain"
+
+	"gorm.io/gorm/clause"
+)
+
+// AddToWishlist saves a product to the user's wishlist. Saving a product that
+// is already there keeps the price it was first saved at.
+func (cr *cartRepository) AddToWishlist(userID, productID int, price float64) (domain.WishlistItem, error) {
+	item := domain.WishlistItem{
+		UserID:     uint(userID),
+		ProductID:  uint(productID),
+		AddedPrice: price,
+	}
+	if err := cr.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&item).Error; err != nil {
+		return domain.WishlistItem{}, err
+	}
+	return cr.GetWishlistItem(userID, productID)
+}
+
+func (cr *cartRepository) GetWishlistItem(userID, productID int) (domain.WishlistItem, error) {
+	var item domain.WishlistItem
+	result := cr.DB.Raw("SELECT * FROM wishlist_items WHERE user_id = ? AND product_id = ?", userID, productID).Scan(&item)
+	if result.Error != nil {
+		return domain.WishlistItem{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.WishlistItem{}, domain.ErrNotInWishlist
+	}
+	return item, nil
+}
+
+func (cr *cartRepository) ListWishlist(userID, page, count int) ([]domain.WishlistItem, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	var items []domain.WishlistItem
+	err := cr.DB.Where("user_id = ?", userID).Order("created_at DESC, id DESC").Limit(count).Offset(offset).Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (cr *cartRepository) RemoveFromWishlist(userID, productID int) error {
+	result := cr.DB.Exec("DELETE FROM wishlist_items WHERE user_id = ? AND product_id = ?", userID, productID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotInWishlist
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+func (cr *cartUseCase) AddCoupon(input models.CouponInput) (domain.Coupon, error) {
+	coupon, err := cr.prepareCoupon(input, 0)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+	coupon.CreatedBy = input.Actor
+	return cr.cartRepository.CreateCoupon(coupon)
+}
+
+func (cr *cartUseCase) UpdateCoupon(id int, input models.CouponInput) (domain.Coupon, error) {
+	if _, err := cr.cartRepository.GetCoupon(id); err != nil {
+		return domain.Coupon{}, err
+	}
+	coupon, err := cr.prepareCoupon(input, id)
+	if err != nil {
+		return domain.Coupon{}, err
+	}
+	coupon.ID = uint(id)
+	return cr.cartRepository.UpdateCoupon(coupon)
+}
+
+func (cr *cartUseCase) ListCoupons(page, count int) ([]domain.Coupon, error) {
+	return cr.cartRepository.ListCoupons(page, count)
+}
+
+func (cr *cartUseCase) DeleteCoupon(id int) error {
+	return cr.cartRepository.DeleteCoupon(id)
+}
+
+// ApplyCoupon puts a coupon on the user's cart once it is checked against the
+// cart total after offers and the usage limits.
+func (cr *cartUseCase) ApplyCoupon(userID int, code string) (models.CartResponse, error) {
+	coupon, err := cr.cartRepository.GetCouponByCode(normalizeCouponCode(code))
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	cart, err := cr.priceCart(userID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if len(cart.Cart) == 0 {
+		return models.CartResponse{}, domain.ErrCartEmpty
+	}
+	if err := cr.checkCoupon(coupon, userID, cart.TotalPrice-cart.OfferDiscount); err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.cartRepository.ApplyCoupon(userID, int(coupon.ID)); err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.DisplayCart(userID)
+}
+
+func (cr *cartUseCase) RemoveCoupon(userID int) (models.CartResponse, error) {
+	if err := cr.cartRepository.RemoveCoupon(userID); err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.DisplayCart(userID)
+}
+
+// RedeemCoupon consumes the coupon for an order, recording the discount the
+// order was priced with.
+func (cr *cartUseCase) RedeemCoupon(input models.CouponRedemptionInput) (domain.CouponRedemption, error) {
+	coupon, err := cr.cartRepository.GetCouponByCode(normalizeCouponCode(input.Code))
+	if err != nil {
+		return domain.CouponRedemption{}, err
+	}
+	if input.Discount <= 0 {
+		return domain.CouponRedemption{}, fmt.Errorf("%w: discount must be positive", domain.ErrInvalidCoupon)
+	}
+	if err := couponAvailable(coupon, time.Now()); err != nil {
+		return domain.CouponRedemption{}, err
+	}
+	return cr.cartRepository.RedeemCoupon(domain.CouponRedemption{
+		CouponID: coupon.ID,
+		UserID:   uint(input.UserID),
+		OrderID:  uint(input.OrderID),
+		Discount: input.Discount,
+	})
+}
+
+func (cr *cartUseCase) ReleaseCoupon(orderID int) error {
+	return cr.cartRepository.ReleaseCouponRedemption(orderID)
+}
+
+// checkCoupon checks that the user may use the coupon on a cart of the given
+// total.
+func (cr *cartUseCase) checkCoupon(coupon domain.Coupon, userID int, cartTotal float64) error {
+	if err := couponAvailable(coupon, time.Now()); err != nil {
+		return err
+	}
+	if cartTotal < coupon.MinCartValue {
+		return fmt.Errorf("%w of %.2f", domain.ErrCouponMinCartValue, coupon.MinCartValue)
+	}
+	total, byUser, err := cr.cartRepository.CouponUsage(int(coupon.ID), userID)
+	if err != nil {
+		return err
+	}
+	if coupon.UsageLimit > 0 && total >= coupon.UsageLimit {
+		return domain.ErrCouponUsageLimit
+	}
+	if coupon.PerUserLimit > 0 && byUser >= coupon.PerUserLimit {
+		return domain.ErrCouponUserLimit
+	}
+	return nil
+}
+
+func (cr *cartUseCase) prepareCoupon(input models.CouponInput, exceptID int) (domain.Coupon, error) {
+	coupon := domain.Coupon{
+		Code:          normalizeCouponCode(input.Code),
+		Description:   strings.TrimSpace(input.Description),
+		DiscountType:  strings.ToLower(strings.TrimSpace(input.DiscountType)),
+		DiscountValue: input.DiscountValue,
+		MinCartValue:  input.MinCartValue,
+		MaxDiscount:   input.MaxDiscount,
+		ValidFrom:     input.ValidFrom,
+		ValidUntil:    input.ValidUntil,
+		UsageLimit:    input.UsageLimit,
+		PerUserLimit:  input.PerUserLimit,
+		Active:        input.Active,
+	}
+	if !couponCodePattern.MatchString(coupon.Code) {
+		return coupon, couponError("code must be 3 to 32 letters, digits, '-' or '_'")
+	}
+	switch coupon.DiscountType {
+	case domain.CouponTypePercentage:
+		if coupon.DiscountValue <= 0 || coupon.DiscountValue > 100 {
+			return coupon, couponError("a percentage discount must be between 0 and 100")
+		}
+	case domain.CouponTypeFlat:
+		if coupon.DiscountValue <= 0 {
+			return coupon, couponError("a flat discount must be positive")
+		}
+	default:
+		return coupon, couponError("discount_type must be %q or %q", domain.CouponTypePercentage, domain.CouponTypeFlat)
+	}
+	if coupon.MinCartValue < 0 || coupon.MaxDiscount < 0 {
+		return coupon, couponError("min_cart_value and max_discount cannot be negative")
+	}
+	if coupon.UsageLimit < 0 || coupon.PerUserLimit < 0 {
+		return coupon, couponError("usage limits cannot be negative")
+	}
+	if coupon.ValidFrom.IsZero() {
+		coupon.ValidFrom = time.Now()
+	}
+	if coupon.ValidUntil != nil && !coupon.ValidUntil.After(coupon.ValidFrom) {
+		return coupon, couponError("valid_until must be after valid_from")
+	}
+
+	taken, err := cr.cartRepository.CouponCodeTaken(coupon.Code, exceptID)
+	if err != nil {
+		return coupon, err
+	}
+	if taken {
+		return coupon, domain.ErrCouponExists
+	}
+	return coupon, nil
+}
+
+// couponAvailable checks that a coupon is switched on and inside its validity
+// window at the given time.
+func couponAvailable(coupon domain.Coupon, now time.Time) error {
+	if !coupon.Active {
+		return domain.ErrCouponInactive
+	}
+	if now.Before(coupon.ValidFrom) {
+		return domain.ErrCouponNotStarted
+	}
+	if coupon.ValidUntil != nil && !now.Before(*coupon.ValidUntil) {
+		return domain.ErrCouponExpired
+	}
+	return nil
+}
+
+// couponDiscount returns the discount a coupon gives on a cart total, capped
+// by the coupon's maximum discount and by the total itself.
+func couponDiscount(coupon domain.Coupon, cartTotal float64) float64 {
+	discount := coupon.DiscountValue
+	if coupon.DiscountType == domain.CouponTypePercentage {
+		discount = cartTotal * coupon.DiscountValue / 100
+	}
+	if coupon.MaxDiscount > 0 && discount > coupon.MaxDiscount {
+		discount = coupon.MaxDiscount
+	}
+	if discount > cartTotal {
+		discount = cartTotal
+	}
+	return roundPrice(discount)
+}
+
+// isCouponRejection reports whether err says the coupon cannot be used, as
+// opposed to a failure to check it.
+func isCouponRejection(err error) bool {
+	for _, target := range []error{
+		domain.ErrCouponInactive, domain.ErrCouponNotStarted, domain.ErrCouponExpired,
+		domain.ErrCouponMinCartValue, domain.ErrCouponUsageLimit, domain.ErrCouponUserLimit,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func couponError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{domain.ErrInvalidCoupon}, args...)...)
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/repository/interfaces"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeCouponRepository reports fixed coupon usage counts. Methods a test does
+// not need panic through the embedded nil interface.
+type fakeCouponRepository struct {
+	interfaces.CartRepository
+
+	used, usedByUser int
+}
+
+func (f *fakeCouponRepository) CouponUsage(couponID, userID int) (int, int, error) {
+	return f.used, f.usedByUser, nil
+}
+
+func TestCouponAvailable(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	until := now.Add(time.Hour)
+	ended := now
+	tests := []struct {
+		name    string
+		coupon  domain.Coupon
+		wantErr error
+	}{
+		{"active and open", domain.Coupon{Active: true, ValidFrom: now.Add(-time.Hour), ValidUntil: &until}, nil},
+		{"no end", domain.Coupon{Active: true, ValidFrom: now}, nil},
+		{"switched off", domain.Coupon{Active: false, ValidFrom: now.Add(-time.Hour)}, domain.ErrCouponInactive},
+		{"not started", domain.Coupon{Active: true, ValidFrom: now.Add(time.Second)}, domain.ErrCouponNotStarted},
+		{"ends now", domain.Coupon{Active: true, ValidFrom: now.Add(-time.Hour), ValidUntil: &ended}, domain.ErrCouponExpired},
+	}
+	for _, tt := range tests {
+		if err := couponAvailable(tt.coupon, now); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: couponAvailable error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCouponDiscount(t *testing.T) {
+	tests := []struct {
+		name   string
+		coupon domain.Coupon
+		total  float64
+		want   float64
+	}{
+		{"percentage", domain.Coupon{DiscountType: domain.CouponTypePercentage, DiscountValue: 15}, 333.33, 50},
+		{"percentage capped", domain.Coupon{DiscountType: domain.CouponTypePercentage, DiscountValue: 50, MaxDiscount: 100}, 1000, 100},
+		{"flat", domain.Coupon{DiscountType: domain.CouponTypeFlat, DiscountValue: 75}, 1000, 75},
+		{"flat above the total", domain.Coupon{DiscountType: domain.CouponTypeFlat, DiscountValue: 75}, 40, 40},
+	}
+	for _, tt := range tests {
+		if got := couponDiscount(tt.coupon, tt.total); got != tt.want {
+			t.Errorf("%s: couponDiscount = %.2f, want %.2f", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckCouponLimits(t *testing.T) {
+	coupon := domain.Coupon{
+		ID:           1,
+		Active:       true,
+		ValidFrom:    time.Now().Add(-time.Hour),
+		MinCartValue: 500,
+		UsageLimit:   100,
+		PerUserLimit: 2,
+	}
+	tests := []struct {
+		name       string
+		cartTotal  float64
+		used       int
+		usedByUser int
+		wantErr    error
+	}{
+		{"within every limit", 500, 99, 1, nil},
+		{"cart below the minimum", 499.99, 0, 0, domain.ErrCouponMinCartValue},
+		{"used up", 500, 100, 0, domain.ErrCouponUsageLimit},
+		{"used up by the user", 500, 10, 2, domain.ErrCouponUserLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := &cartUseCase{cartRepository: &fakeCouponRepository{used: tt.used, usedByUser: tt.usedByUser}}
+			err := useCase.checkCoupon(coupon, 7, tt.cartTotal)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkCoupon error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && !isCouponRejection(err) {
+				t.Errorf("%v is not reported as a rejection of the coupon", err)
+			}
+		})
+	}
+
+	unlimited := coupon
+	unlimited.UsageLimit, unlimited.PerUserLimit = 0, 0
+	useCase := &cartUseCase{cartRepository: &fakeCouponRepository{used: 1000, usedByUser: 1000}}
+	if err := useCase.checkCoupon(unlimited, 7, 500); err != nil {
+		t.Errorf("checkCoupon without limits: %v", err)
+	}
+}
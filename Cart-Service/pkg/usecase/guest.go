@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	interfaceUse "cart-service/pkg/usecase/interface"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// GuestCartTTL is how long a guest cart is kept after it last changed.
+const GuestCartTTL = 30 * 24 * time.Hour
+
+func (cr *cartUseCase) AddToGuestCart(guestID string, productID, quantity int) (models.CartResponse, error) {
+	if err := checkGuestID(guestID); err != nil {
+		return models.CartResponse{}, err
+	}
+	if quantity < 1 {
+		return models.CartResponse{}, domain.ErrInvalidQuantity
+	}
+	ok, err := cr.productRepository.CheckProduct(productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if !ok {
+		return models.CartResponse{}, errors.New("product Does not exist")
+	}
+	inCart, err := cr.cartRepository.QuantityInGuestCart(guestID, productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.setGuestQuantity(guestID, productID, inCart+quantity)
+}
+
+func (cr *cartUseCase) UpdateGuestCartItemQuantity(guestID string, productID, quantity int) (models.CartResponse, error) {
+	if err := checkGuestID(guestID); err != nil {
+		return models.CartResponse{}, err
+	}
+	if quantity < 1 {
+		return models.CartResponse{}, domain.ErrInvalidQuantity
+	}
+	inCart, err := cr.cartRepository.QuantityInGuestCart(guestID, productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if inCart == 0 {
+		return models.CartResponse{}, domain.ErrCartItemNotFound
+	}
+	return cr.setGuestQuantity(guestID, productID, quantity)
+}
+
+func (cr *cartUseCase) RemoveFromGuestCart(guestID string, productID int) (models.CartResponse, error) {
+	if err := checkGuestID(guestID); err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.cartRepository.RemoveGuestCartItem(guestID, productID); err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.GuestCart(guestID)
+}
+
+// GuestCart prices the guest cart. Coupons need an account, so only offers
+// apply.
+func (cr *cartUseCase) GuestCart(guestID string) (models.CartResponse, error) {
+	if err := checkGuestID(guestID); err != nil {
+		return models.CartResponse{}, err
+	}
+	lines, err := cr.cartRepository.GuestCartLines(guestID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.priceLines(lines)
+}
+
+// MergeGuestCart moves the guest cart into the user's cart and deletes it. A
+// product in both carts gets the sum of both quantities, lowered to the stock
+// available to the user. The notices tell the user about every product whose
+// quantity was lowered or that was left out.
+func (cr *cartUseCase) MergeGuestCart(guestID string, userID int) (models.CartResponse, []string, error) {
+	if err := checkGuestID(guestID); err != nil {
+		return models.CartResponse{}, nil, err
+	}
+	guestLines, err := cr.cartRepository.GuestCartLines(guestID)
+	if err != nil {
+		return models.CartResponse{}, nil, err
+	}
+	userLines, err := cr.cartRepository.DisplayCart(userID)
+	if err != nil {
+		return models.CartResponse{}, nil, err
+	}
+	inCart := make(map[uint]models.Cart, len(userLines))
+	for _, line := range userLines {
+		inCart[line.ProductID] = line
+	}
+
+	var notices []string
+	now := time.Now()
+	for _, line := range guestLines {
+		notice, err := cr.mergeLine(userID, line, inCart[line.ProductID], now)
+		if err != nil {
+			return models.CartResponse{}, nil, err
+		}
+		if notice != "" {
+			notices = append(notices, notice)
+		}
+	}
+	if err := cr.cartRepository.DeleteGuestCart(guestID); err != nil {
+		return models.CartResponse{}, nil, err
+	}
+	cart, err := cr.priceCart(userID)
+	if err != nil {
+		return models.CartResponse{}, nil, err
+	}
+	return cart, notices, nil
+}
+
+// mergeLine adds a guest cart line to the user's line for the same product,
+// capping the quantity at the available stock and keeping the larger of the
+// merged and the existing line. It holds the stock and returns a notice when
+// the line could not be merged in full.
+func (cr *cartUseCase) mergeLine(userID int, line, existing models.Cart, now time.Time) (string, error) {
+	productID := int(line.ProductID)
+	ok, err := cr.productRepository.CheckProduct(productID)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return fmt.Sprintf("product %d is no longer available and was left out of the cart", productID), nil
+	}
+	stock, err := cr.productRepository.GetQuantityFromProductID(productID)
+	if err != nil {
+		return "", err
+	}
+	// Stock still held for the user's own line is available to them.
+	inCart := int(existing.Quantity)
+	if existing.HeldUntil != nil && existing.HeldUntil.After(now) {
+		stock += inCart
+	}
+	want := inCart + int(line.Quantity)
+	quantity := want
+	if quantity > stock {
+		quantity = stock
+	}
+	if quantity <= inCart {
+		if inCart == 0 {
+			return fmt.Sprintf("product %d is out of stock and was left out of the cart", productID), nil
+		}
+		return fmt.Sprintf("product %d: only %d in stock, the cart keeps %d", productID, stock, inCart), nil
+	}
+
+	price, err := cr.productRepository.GetPriceofProductFromID(productID)
+	if err != nil {
+		return "", err
+	}
+	heldUntil, err := cr.productRepository.ReserveStock(cartHoldReference(userID), productID, quantity, cr.holdTTL)
+	if errors.Is(err, domain.ErrInsufficientStock) {
+		return fmt.Sprintf("product %d: not enough stock to add it to the cart", productID), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if inCart == 0 {
+		err = cr.cartRepository.AddItemIntoCart(userID, productID, quantity, price)
+	} else {
+		err = cr.cartRepository.UpdateCart(quantity, price, userID, productID)
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := cr.cartRepository.SetCartHold(userID, productID, heldUntil); err != nil {
+		return "", err
+	}
+	if quantity < want {
+		return fmt.Sprintf("product %d: only %d in stock, the cart has %d instead of %d", productID, stock, quantity, want), nil
+	}
+	return "", nil
+}
+
+// setGuestQuantity sets the quantity of a product in the guest cart. Guest
+// carts hold no stock, so the quantity is only checked against the stock
+// available now.
+func (cr *cartUseCase) setGuestQuantity(guestID string, productID, quantity int) (models.CartResponse, error) {
+	stock, err := cr.productRepository.GetQuantityFromProductID(productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if quantity > stock {
+		return models.CartResponse{}, fmt.Errorf("%w: %d available", domain.ErrInsufficientStock, stock)
+	}
+	price, err := cr.productRepository.GetPriceofProductFromID(productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.cartRepository.SetGuestCartItem(guestID, productID, quantity, price); err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.GuestCart(guestID)
+}
+
+func (cr *cartUseCase) PurgeGuestCarts() (int, error) {
+	return cr.cartRepository.DeleteStaleGuestCarts(time.Now().Add(-GuestCartTTL))
+}
+
+// StartGuestCartSweeper deletes guest carts that were left alone for longer
+// than GuestCartTTL every interval. It blocks, so run it in its own goroutine.
+func StartGuestCartSweeper(useCase interfaceUse.CartUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		deleted, err := useCase.PurgeGuestCarts()
+		if err != nil {
+			log.Println("deleting stale guest carts:", err)
+			continue
+		}
+		if deleted > 0 {
+			log.Printf("deleted %d lines of stale guest carts", deleted)
+		}
+	}
+}
+
+func checkGuestID(guestID string) error {
+	if guestID == "" || len(guestID) > 64 {
+		return domain.ErrInvalidGuestCart
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	interfaceClient "cart-service/pkg/client/interfaces"
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	"cart-service/pkg/repository/interfaces"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeMergeRepository records the cart lines a merge writes. Methods a test
+// does not need panic through the embedded nil interface.
+type fakeMergeRepository struct {
+	interfaces.CartRepository
+
+	written map[int]int
+	held    map[int]time.Time
+}
+
+func (f *fakeMergeRepository) AddItemIntoCart(userID, productID, quantity int, unitPrice float64) error {
+	f.written[productID] = quantity
+	return nil
+}
+
+func (f *fakeMergeRepository) UpdateCart(quantity int, unitPrice float64, userID, productID int) error {
+	f.written[productID] = quantity
+	return nil
+}
+
+func (f *fakeMergeRepository) SetCartHold(userID, productID int, heldUntil time.Time) error {
+	f.held[productID] = heldUntil
+	return nil
+}
+
+// fakeStockClient has one product with a fixed free stock.
+type fakeStockClient struct {
+	interfaceClient.NewProductClient
+
+	exists     bool
+	stock      int
+	reserveErr error
+}
+
+func (f *fakeStockClient) CheckProduct(productID int) (bool, error)               { return f.exists, nil }
+func (f *fakeStockClient) GetQuantityFromProductID(id int) (int, error)           { return f.stock, nil }
+func (f *fakeStockClient) GetPriceofProductFromID(productID int) (float64, error) { return 10, nil }
+func (f *fakeStockClient) ReserveStock(reference string, productID, quantity int, ttl time.Duration) (time.Time, error) {
+	if f.reserveErr != nil {
+		return time.Time{}, f.reserveErr
+	}
+	return time.Now().Add(ttl), nil
+}
+
+func TestMergeLine(t *testing.T) {
+	now := time.Now()
+	held := now.Add(time.Minute)
+	expired := now.Add(-time.Minute)
+	tests := []struct {
+		name     string
+		product  fakeStockClient
+		guest    float64
+		existing models.Cart
+		// wantQuantity is the quantity written to the user's cart, 0 for none.
+		wantQuantity int
+		wantNotice   string
+	}{
+		{
+			name: "new product", product: fakeStockClient{exists: true, stock: 5}, guest: 2,
+			wantQuantity: 2,
+		},
+		{
+			name: "added to a held line", product: fakeStockClient{exists: true, stock: 5},
+			guest: 2, existing: models.Cart{ProductID: 1, Quantity: 3, HeldUntil: &held},
+			wantQuantity: 5,
+		},
+		{
+			name: "capped by stock and the user's hold", product: fakeStockClient{exists: true, stock: 1},
+			guest: 3, existing: models.Cart{ProductID: 1, Quantity: 2, HeldUntil: &held},
+			wantQuantity: 3, wantNotice: "only 3 in stock, the cart has 3 instead of 5",
+		},
+		{
+			name: "expired hold counts for nothing", product: fakeStockClient{exists: true, stock: 1},
+			guest: 1, existing: models.Cart{ProductID: 1, Quantity: 2, HeldUntil: &expired},
+			wantNotice: "only 1 in stock, the cart keeps 2",
+		},
+		{
+			name: "out of stock", product: fakeStockClient{exists: true}, guest: 1,
+			wantNotice: "out of stock and was left out",
+		},
+		{
+			name: "product gone", product: fakeStockClient{stock: 5}, guest: 1,
+			wantNotice: "no longer available",
+		},
+		{
+			name: "stock taken meanwhile", product: fakeStockClient{exists: true, stock: 5, reserveErr: domain.ErrInsufficientStock},
+			guest: 1, wantNotice: "not enough stock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeMergeRepository{written: map[int]int{}, held: map[int]time.Time{}}
+			product := tt.product
+			useCase := &cartUseCase{cartRepository: repo, productRepository: &product, holdTTL: time.Hour}
+
+			notice, err := useCase.mergeLine(7, models.Cart{ProductID: 1, Quantity: tt.guest}, tt.existing, now)
+			if err != nil {
+				t.Fatalf("mergeLine: %v", err)
+			}
+			if !strings.Contains(notice, tt.wantNotice) || (tt.wantNotice == "") != (notice == "") {
+				t.Errorf("notice = %q, want %q", notice, tt.wantNotice)
+			}
+			if got := repo.written[1]; got != tt.wantQuantity {
+				t.Errorf("quantity in the cart = %d, want %d", got, tt.wantQuantity)
+			}
+			if _, ok := repo.held[1]; ok != (tt.wantQuantity > 0) {
+				t.Errorf("stock held = %v, want %v", ok, tt.wantQuantity > 0)
+			}
+		})
+	}
+}
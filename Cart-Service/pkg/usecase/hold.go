@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+)
+
+// cartHoldReference is the reference the stock held for a user's cart is
+// reserved under in Product-Service. Orders commit holds under it too.
+func cartHoldReference(userID int) string {
+	return fmt.Sprintf("cart:%d", userID)
+}
+
+// releaseHolds gives back the stock held for products that left the user's
+// cart, or for the whole cart when none are given. A failed release is only
+// logged: the product was already removed and its hold expires on its own.
+// Holds that expire are given back by Product-Service alone, so a hold taken
+// again in the meantime is never released by mistake.
+func (cr *cartUseCase) releaseHolds(userID int, productIDs []int) {
+	if err := cr.productRepository.ReleaseStock(cartHoldReference(userID), productIDs); err != nil {
+		log.Printf("releasing the stock held for cart of user %d: %v", userID, err)
+	}
+}
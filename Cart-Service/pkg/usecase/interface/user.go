@@ -1,12 +1,41 @@
 package interfaceUse
 
-import "cart-service/pkg/models"
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+)
 
 type CartUseCase interface {
 	AddToCart(product_id, user_id, quantity int) (models.CartResponse, error)
 	DisplayCart(user_id int) (models.CartResponse, error)
 	GetAllItemsFromCart(userID int) ([]models.Cart, error)
 	DoesCartExist(userID int) (bool, error)
-	TotalAmountInCart(userID int) (float64, error)
+	TotalAmountInCart(userID int) (models.CartTotal, error)
 	UpdateCartAfterOrder(userID, productID int, quantity float64) error
+	ClearCart(userID int, productIDs []int) error
+	UpdateCartItemQuantity(userID, productID, quantity int) (models.CartResponse, error)
+	RemoveFromCart(userID, productID int) (models.CartResponse, error)
+	SendCartReminders() (int, error)
+
+	AddToGuestCart(guestID string, productID, quantity int) (models.CartResponse, error)
+	UpdateGuestCartItemQuantity(guestID string, productID, quantity int) (models.CartResponse, error)
+	RemoveFromGuestCart(guestID string, productID int) (models.CartResponse, error)
+	GuestCart(guestID string) (models.CartResponse, error)
+	MergeGuestCart(guestID string, userID int) (models.CartResponse, []string, error)
+	PurgeGuestCarts() (int, error)
+
+	AddToWishlist(userID, productID int) (models.WishlistItem, error)
+	ListWishlist(userID, page, count int) ([]models.WishlistItem, error)
+	RemoveFromWishlist(userID, productID int) error
+	MoveToCart(userID, productID, quantity int) (models.CartResponse, error)
+	SaveForLater(userID, productID int) (models.WishlistItem, error)
+
+	AddCoupon(input models.CouponInput) (domain.Coupon, error)
+	UpdateCoupon(id int, input models.CouponInput) (domain.Coupon, error)
+	ListCoupons(page, count int) ([]domain.Coupon, error)
+	DeleteCoupon(id int) error
+	ApplyCoupon(userID int, code string) (models.CartResponse, error)
+	RemoveCoupon(userID int) (models.CartResponse, error)
+	RedeemCoupon(input models.CouponRedemptionInput) (domain.CouponRedemption, error)
+	ReleaseCoupon(orderID int) error
 }
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// priceCart prices the user's cart at the current product prices, with the best
+// offer of each product and then the applied coupon taken off. A coupon that
+// no longer applies gives no discount, and CouponNotice tells the user why.
+func (cr *cartUseCase) priceCart(userID int) (models.CartResponse, error) {
+	lines, err := cr.cartRepository.DisplayCart(userID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	cart, err := cr.priceLines(lines)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	subtotal := cart.FinalPrice
+
+	coupon, err := cr.cartRepository.GetAppliedCoupon(userID)
+	if errors.Is(err, domain.ErrNoCouponApplied) {
+		return cart, nil
+	}
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.checkCoupon(coupon, userID, subtotal); err != nil {
+		if !isCouponRejection(err) {
+			return models.CartResponse{}, err
+		}
+		cart.CouponNotice = fmt.Sprintf("coupon %s does not apply: %v", coupon.Code, err)
+		return cart, nil
+	}
+	cart.CouponCode = coupon.Code
+	cart.Discount = couponDiscount(coupon, subtotal)
+	cart.FinalPrice = roundPrice(subtotal - cart.Discount)
+	return cart, nil
+}
+
+// priceLines prices cart lines at the current product prices with their offers
+// applied. The FinalPrice of the result is the total after offers.
+func (cr *cartUseCase) priceLines(lines []models.Cart) (models.CartResponse, error) {
+	lines, err := cr.livePrices(lines)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	lines, err = cr.applyOffers(lines)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+
+	cart := models.CartResponse{Cart: lines}
+	for _, line := range lines {
+		cart.TotalPrice += line.TotalPrice
+		cart.OfferDiscount += line.Discount
+		if line.PriceChanged {
+			cart.PriceNotices = append(cart.PriceNotices, fmt.Sprintf("price changed: product %d was %.2f when added to the cart and is now %.2f",
+				line.ProductID, line.AddedPrice, line.UnitPrice))
+		}
+	}
+	cart.TotalPrice = roundPrice(cart.TotalPrice)
+	cart.OfferDiscount = roundPrice(cart.OfferDiscount)
+	cart.FinalPrice = roundPrice(cart.TotalPrice - cart.OfferDiscount)
+	return cart, nil
+}
+
+// livePrices prices every cart line at the current price of its product and
+// flags the lines whose price changed since the product was put in the cart.
+func (cr *cartUseCase) livePrices(lines []models.Cart) ([]models.Cart, error) {
+	for i := range lines {
+		line := &lines[i]
+		price, err := cr.productRepository.GetPriceofProductFromID(int(line.ProductID))
+		if err != nil {
+			return nil, err
+		}
+		line.UnitPrice = price
+		line.TotalPrice = roundPrice(price * line.Quantity)
+		line.PriceChanged = roundPrice(line.AddedPrice) != roundPrice(price)
+	}
+	return lines, nil
+}
+
+// applyOffers gives every cart line the offer that takes the most off it.
+func (cr *cartUseCase) applyOffers(lines []models.Cart) ([]models.Cart, error) {
+	if len(lines) == 0 {
+		return lines, nil
+	}
+	productIDs := make([]int, 0, len(lines))
+	for _, line := range lines {
+		productIDs = append(productIDs, int(line.ProductID))
+	}
+	offers, err := cr.productRepository.GetProductOffers(productIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range lines {
+		line := &lines[i]
+		line.Discount = 0
+		line.Offer = ""
+		for _, offer := range offers[int(line.ProductID)] {
+			if discount := offerDiscount(offer, *line); discount > line.Discount {
+				line.Discount = discount
+				line.Offer = offer.Name
+			}
+		}
+		line.FinalPrice = roundPrice(line.TotalPrice - line.Discount)
+	}
+	return lines, nil
+}
+
+// offerDiscount returns what an offer takes off a cart line. Offers use the
+// discount types of coupons; a flat offer applies to every unit. The discount
+// never exceeds the line total.
+func offerDiscount(offer models.Offer, line models.Cart) float64 {
+	discount := offer.DiscountValue * line.Quantity
+	if offer.DiscountType == domain.CouponTypePercentage {
+		discount = line.TotalPrice * offer.DiscountValue / 100
+	}
+	if discount > line.TotalPrice {
+		discount = line.TotalPrice
+	}
+	return roundPrice(discount)
+}
+
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
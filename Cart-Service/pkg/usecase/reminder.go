@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"cart-service/pkg/models"
+	interfaceUse "cart-service/pkg/usecase/interface"
+	"log"
+	"time"
+)
+
+// SendCartReminders reminds the users of carts that did not change for
+// longer than the abandoned cart threshold and returns how many reminders it
+// sent. Each idle cart gets one reminder; a cart that changes and goes idle
+// again gets another. A reminder is recorded before it is sent and the record
+// is dropped if sending fails, so it is tried again on the next run. A cart
+// that cannot be priced or whose reminder cannot be sent is logged and skipped
+// until the next run.
+func (cr *cartUseCase) SendCartReminders() (int, error) {
+	idle, err := cr.cartRepository.IdleCarts(time.Now().Add(-cr.abandonedAfter))
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, c := range idle {
+		cart, err := cr.priceCart(c.UserID)
+		if err != nil {
+			log.Printf("pricing the idle cart of user %d: %v", c.UserID, err)
+			continue
+		}
+		if len(cart.Cart) == 0 {
+			continue
+		}
+		reminder, recorded, err := cr.cartRepository.RecordCartReminder(c.UserID, c.LastActivity)
+		if err != nil {
+			return sent, err
+		}
+		if !recorded {
+			continue
+		}
+		err = cr.notifier.NotifyAbandonedCart(models.AbandonedCart{
+			UserID:    c.UserID,
+			IdleSince: c.LastActivity,
+			Cart:      cart,
+		})
+		if err != nil {
+			if derr := cr.cartRepository.DeleteCartReminder(int(reminder.ID)); derr != nil {
+				log.Printf("dropping the unsent cart reminder of user %d: %v", c.UserID, derr)
+			}
+			log.Printf("reminding user %d of their cart: %v", c.UserID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// StartReminderJob sends reminders about abandoned carts every interval. It
+// blocks, so run it in its own goroutine.
+func StartReminderJob(useCase interfaceUse.CartUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		sent, err := useCase.SendCartReminders()
+		if err != nil {
+			log.Println("sending abandoned cart reminders:", err)
+		}
+		if sent > 0 {
+			log.Printf("sent %d abandoned cart reminders", sent)
+		}
+	}
+}
@@ -2,26 +2,38 @@ package usecase
 
 import (
 	interfaceClient "cart-service/pkg/client/interfaces"
+	"cart-service/pkg/domain"
 	"cart-service/pkg/models"
+	interfaceNotifier "cart-service/pkg/notifier/interfaces"
 	"cart-service/pkg/repository/interfaces"
 	interfaceUse "cart-service/pkg/usecase/interface"
 	"errors"
+	"time"
 )
 
 type cartUseCase struct {
 	cartRepository    interfaces.CartRepository
 	productRepository interfaceClient.NewProductClient
+	notifier          interfaceNotifier.Notifier
+	holdTTL           time.Duration
+	abandonedAfter    time.Duration
 }
 
-func NewCartUseCase(repository interfaces.CartRepository, productRepo interfaceClient.NewProductClient) interfaceUse.CartUseCase {
+func NewCartUseCase(repository interfaces.CartRepository, productRepo interfaceClient.NewProductClient, notifier interfaceNotifier.Notifier, holdTTL, abandonedAfter time.Duration) interfaceUse.CartUseCase {
 
 	return &cartUseCase{
 		cartRepository:    repository,
 		productRepository: productRepo,
+		notifier:          notifier,
+		holdTTL:           holdTTL,
+		abandonedAfter:    abandonedAfter,
 	}
 
 }
 func (cr *cartUseCase) AddToCart(product_id, user_id, quantity int) (models.CartResponse, error) {
+	if quantity < 1 {
+		return models.CartResponse{}, domain.ErrInvalidQuantity
+	}
 	ok, err := cr.productRepository.CheckProduct(product_id)
 	if err != nil {
 		return models.CartResponse{}, err
@@ -42,83 +54,51 @@ func (cr *cartUseCase) AddToCart(product_id, user_id, quantity int) (models.Cart
 	if quantityOfProduct <= 0 {
 		return models.CartResponse{}, errors.New("out of stock")
 	}
-	if quantityOfProduct == QuantityOfProductInCart {
-		return models.CartResponse{}, errors.New("stock limit exceeded")
-	}
-	ok, err = cr.cartRepository.ProductExist(user_id, product_id)
-	if err != nil {
-		return models.CartResponse{}, err
-	}
-	if !ok {
-		return models.CartResponse{}, err
-	}
 	productPrice, err := cr.productRepository.GetPriceofProductFromID(product_id)
 	if err != nil {
 
 		return models.CartResponse{}, err
 	}
-	if !ok {
+	heldUntil, err := cr.productRepository.ReserveStock(cartHoldReference(user_id), product_id, QuantityOfProductInCart+quantity, cr.holdTTL)
+	if err != nil {
 		return models.CartResponse{}, err
 	}
 
-	FinalPrice := productPrice * float64(quantity)
 	if QuantityOfProductInCart == 0 {
-		err := cr.cartRepository.AddItemIntoCart(user_id, product_id, quantity, FinalPrice)
+		err := cr.cartRepository.AddItemIntoCart(user_id, product_id, quantity, productPrice)
 		if err != nil {
 
 			return models.CartResponse{}, err
 		}
 
 	} else {
-		currentTotal, err := cr.cartRepository.TotalPriceForProductInCart(user_id, product_id)
-		if err != nil {
-			return models.CartResponse{}, err
-		}
-		err = cr.cartRepository.UpdateCart(QuantityOfProductInCart+quantity, currentTotal+productPrice, user_id, product_id)
+		err = cr.cartRepository.UpdateCart(QuantityOfProductInCart+quantity, productPrice, user_id, product_id)
 		if err != nil {
 			return models.CartResponse{}, err
 		}
 	}
-	cartDetails, err := cr.cartRepository.DisplayCart(user_id)
-	if err != nil {
+	if err := cr.cartRepository.SetCartHold(user_id, product_id, heldUntil); err != nil {
 		return models.CartResponse{}, err
 	}
-	cartTotal, err := cr.cartRepository.GetTotalPrice(user_id)
-	if err != nil {
-
-		return models.CartResponse{}, err
-	}
-	err = cr.productRepository.ProductStockMinus(product_id, QuantityOfProductInCart)
-	if err != nil {
-		return models.CartResponse{}, err
-	}
-	return models.CartResponse{
-		TotalPrice: cartTotal.TotalPrice,
-		Cart:       cartDetails,
-	}, nil
+	return cr.priceCart(user_id)
 
 }
 func (cr *cartUseCase) DisplayCart(user_id int) (models.CartResponse, error) {
-	cart, err := cr.cartRepository.DisplayCart(user_id)
-	if err != nil {
-		return models.CartResponse{}, err
-	}
-	cartTotal, err := cr.cartRepository.GetTotalPrice(user_id)
-	if err != nil {
-		return models.CartResponse{}, err
-	}
-	return models.CartResponse{
-		TotalPrice: cartTotal.TotalPrice,
-		Cart:       cart,
-	}, nil
+	return cr.priceCart(user_id)
 }
 
+// GetAllItemsFromCart returns the lines of the user's cart at the current
+// prices with their offers applied.
 func (cr *cartUseCase) GetAllItemsFromCart(userID int) ([]models.Cart, error) {
 	res, err := cr.cartRepository.GetAllItemsFromCart(userID)
 	if err != nil {
 		return []models.Cart{}, err
 	}
-	return res, nil
+	res, err = cr.livePrices(res)
+	if err != nil {
+		return []models.Cart{}, err
+	}
+	return cr.applyOffers(res)
 }
 func (cr *cartUseCase) DoesCartExist(userID int) (bool, error) {
 	res, err := cr.cartRepository.DoesCartExist(userID)
@@ -127,12 +107,21 @@ func (cr *cartUseCase) DoesCartExist(userID int) (bool, error) {
 	}
 	return res, nil
 }
-func (cr *cartUseCase) TotalAmountInCart(userID int) (float64, error) {
-	res, err := cr.cartRepository.TotalAmountInCart(userID)
+
+// TotalAmountInCart returns what an order of the user's cart costs, after
+// offers and the discount of the applied coupon if it still applies.
+func (cr *cartUseCase) TotalAmountInCart(userID int) (models.CartTotal, error) {
+	cart, err := cr.priceCart(userID)
 	if err != nil {
-		return 0.0, err
-	}
-	return res, nil
+		return models.CartTotal{}, err
+	}
+	return models.CartTotal{
+		TotalPrice:    cart.TotalPrice,
+		OfferDiscount: cart.OfferDiscount,
+		Discount:      cart.Discount,
+		FinalPrice:    cart.FinalPrice,
+		CouponCode:    cart.CouponCode,
+	}, nil
 }
 func (cr *cartUseCase) UpdateCartAfterOrder(userID, productID int, quantity float64) error {
 	err := cr.cartRepository.UpdateCartAfterOrder(userID, productID, quantity)
@@ -141,3 +130,57 @@ func (cr *cartUseCase) UpdateCartAfterOrder(userID, productID int, quantity floa
 	}
 	return nil
 }
+
+// ClearCart removes the given products from the user's cart, or every product
+// when none are given, and gives back the stock held for them.
+func (cr *cartUseCase) ClearCart(userID int, productIDs []int) error {
+	var err error
+	if len(productIDs) == 0 {
+		err = cr.cartRepository.EmptyCart(userID)
+	} else {
+		err = cr.cartRepository.RemoveProductsFromCart(userID, productIDs)
+	}
+	if err != nil {
+		return err
+	}
+	cr.releaseHolds(userID, productIDs)
+	return nil
+}
+
+// UpdateCartItemQuantity sets the quantity of a product already in the cart
+// and holds stock for it, as long as there is enough.
+func (cr *cartUseCase) UpdateCartItemQuantity(userID, productID, quantity int) (models.CartResponse, error) {
+	if quantity < 1 {
+		return models.CartResponse{}, domain.ErrInvalidQuantity
+	}
+	inCart, err := cr.cartRepository.QuantityOfProductInCart(userID, productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if inCart == 0 {
+		return models.CartResponse{}, domain.ErrCartItemNotFound
+	}
+	price, err := cr.productRepository.GetPriceofProductFromID(productID)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	heldUntil, err := cr.productRepository.ReserveStock(cartHoldReference(userID), productID, quantity, cr.holdTTL)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.cartRepository.SetCartItemQuantity(userID, productID, quantity, price); err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.cartRepository.SetCartHold(userID, productID, heldUntil); err != nil {
+		return models.CartResponse{}, err
+	}
+	return cr.priceCart(userID)
+}
+
+func (cr *cartUseCase) RemoveFromCart(userID, productID int) (models.CartResponse, error) {
+	if err := cr.cartRepository.RemoveCartItem(userID, productID); err != nil {
+		return models.CartResponse{}, err
+	}
+	cr.releaseHolds(userID, []int{productID})
+	return cr.priceCart(userID)
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"cart-service/pkg/domain"
+	"cart-service/pkg/models"
+)
+
+func (cr *cartUseCase) AddToWishlist(userID, productID int) (models.WishlistItem, error) {
+	ok, err := cr.productRepository.CheckProduct(productID)
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	if !ok {
+		return models.WishlistItem{}, domain.ErrProductNotFound
+	}
+	price, err := cr.productRepository.GetPriceofProductFromID(productID)
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	item, err := cr.cartRepository.AddToWishlist(userID, productID, price)
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	return cr.wishlistItem(item)
+}
+
+// ListWishlist returns the user's saved products with their current price and
+// stock status, most recently saved first.
+func (cr *cartUseCase) ListWishlist(userID, page, count int) ([]models.WishlistItem, error) {
+	items, err := cr.cartRepository.ListWishlist(userID, page, count)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.WishlistItem, 0, len(items))
+	for _, item := range items {
+		listed, err := cr.wishlistItem(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, listed)
+	}
+	return result, nil
+}
+
+func (cr *cartUseCase) RemoveFromWishlist(userID, productID int) error {
+	return cr.cartRepository.RemoveFromWishlist(userID, productID)
+}
+
+// MoveToCart puts a saved product in the user's cart and takes it off the
+// wishlist.
+func (cr *cartUseCase) MoveToCart(userID, productID, quantity int) (models.CartResponse, error) {
+	if _, err := cr.cartRepository.GetWishlistItem(userID, productID); err != nil {
+		return models.CartResponse{}, err
+	}
+	cart, err := cr.AddToCart(productID, userID, quantity)
+	if err != nil {
+		return models.CartResponse{}, err
+	}
+	if err := cr.cartRepository.RemoveFromWishlist(userID, productID); err != nil {
+		return models.CartResponse{}, err
+	}
+	return cart, nil
+}
+
+// SaveForLater moves a product from the user's cart to their wishlist, giving
+// back the stock held for it.
+func (cr *cartUseCase) SaveForLater(userID, productID int) (models.WishlistItem, error) {
+	inCart, err := cr.cartRepository.QuantityOfProductInCart(userID, productID)
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	if inCart == 0 {
+		return models.WishlistItem{}, domain.ErrCartItemNotFound
+	}
+	item, err := cr.AddToWishlist(userID, productID)
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	if _, err := cr.RemoveFromCart(userID, productID); err != nil {
+		return models.WishlistItem{}, err
+	}
+	return item, nil
+}
+
+// wishlistItem looks up the current price and stock of a saved product.
+func (cr *cartUseCase) wishlistItem(item domain.WishlistItem) (models.WishlistItem, error) {
+	listed := models.WishlistItem{
+		ProductID:   item.ProductID,
+		AddedPrice:  item.AddedPrice,
+		StockStatus: domain.StockStatusUnavailable,
+		AddedAt:     item.CreatedAt,
+	}
+	ok, err := cr.productRepository.CheckProduct(int(item.ProductID))
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	if !ok {
+		return listed, nil
+	}
+	price, err := cr.productRepository.GetPriceofProductFromID(int(item.ProductID))
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	stock, err := cr.productRepository.GetQuantityFromProductID(int(item.ProductID))
+	if err != nil {
+		return models.WishlistItem{}, err
+	}
+	listed.Price = price
+	listed.PriceChanged = roundPrice(price) != roundPrice(item.AddedPrice)
+	listed.InStock = stock > 0
+	listed.StockStatus = domain.StockStatusOutOfStock
+	if listed.InStock {
+		listed.StockStatus = domain.StockStatusInStock
+	}
+	return listed, nil
+}
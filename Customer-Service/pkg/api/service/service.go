@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 	"user-service/pkg/domain"
 	"user-service/pkg/models"
 	"user-service/pkg/pb"
@@ -47,9 +48,10 @@ func (s *UserServer) UserSignUp(ctx context.Context, userSignUpDetails *pb.UserS
 	}
 
 	return &pb.UserSignUpResponse{
-		Status:      201,
-		UserDetails: userDetails,
-		AccessToken: data.AccessToken,
+		Status:       201,
+		UserDetails:  userDetails,
+		AccessToken:  data.AccessToken,
+		RefreshToken: data.RefreshToken,
 	}, nil
 }
 
@@ -75,9 +77,92 @@ func (s *UserServer) UserLogin(ctx context.Context, loginDetails *pb.UserLoginRe
 	}
 
 	return &pb.UserLoginResponse{
-		Status:      200,
-		UserDetails: userDetails,
-		AccessToken: data.AccessToken,
+		Status:       200,
+		UserDetails:  userDetails,
+		AccessToken:  data.AccessToken,
+		RefreshToken: data.RefreshToken,
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a rotated refresh token.
+func (s *UserServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	data, err := s.userUseCase.RefreshToken(req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) || errors.Is(err, domain.ErrRefreshTokenReused) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refresh token: %v", err)
+	}
+
+	return &pb.RefreshTokenResponse{
+		Status:       200,
+		AccessToken:  data.AccessToken,
+		RefreshToken: data.RefreshToken,
+	}, nil
+}
+
+// RevokeRefreshToken revokes the session a refresh token belongs to.
+func (s *UserServer) RevokeRefreshToken(ctx context.Context, req *pb.RevokeRefreshTokenRequest) (*pb.RevokeRefreshTokenResponse, error) {
+	err := s.userUseCase.RevokeRefreshToken(req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke refresh token: %v", err)
+	}
+
+	return &pb.RevokeRefreshTokenResponse{
+		Status: 200,
+	}, nil
+}
+
+// RevokeUserSessions revokes all refresh tokens of a user.
+func (s *UserServer) RevokeUserSessions(ctx context.Context, req *pb.RevokeUserSessionsRequest) (*pb.RevokeUserSessionsResponse, error) {
+	if err := s.userUseCase.RevokeUserSessions(uint(req.UserId)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke user sessions: %v", err)
+	}
+
+	return &pb.RevokeUserSessionsResponse{
+		Status: 200,
+	}, nil
+}
+
+// RevokeAccessToken records a revoked access token until it expires.
+func (s *UserServer) RevokeAccessToken(ctx context.Context, req *pb.RevokeAccessTokenRequest) (*pb.RevokeAccessTokenResponse, error) {
+	if err := s.userUseCase.RevokeAccessToken(req.TokenId, req.ExpiresAt); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access token: %v", err)
+	}
+
+	return &pb.RevokeAccessTokenResponse{
+		Status: 200,
+	}, nil
+}
+
+// RevokeAccount rejects every access token issued to an account so far.
+func (s *UserServer) RevokeAccount(ctx context.Context, req *pb.RevokeAccountRequest) (*pb.RevokeAccountResponse, error) {
+	if req.Account == "" || req.TokenLifetime <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "account and token lifetime are required")
+	}
+	revokedAt, err := s.userUseCase.RevokeAccount(req.Account, time.Duration(req.TokenLifetime)*time.Second)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke account tokens: %v", err)
+	}
+
+	return &pb.RevokeAccountResponse{
+		Status:    200,
+		RevokedAt: revokedAt,
+	}, nil
+}
+
+// IsAccessTokenRevoked reports whether an access token has been revoked.
+func (s *UserServer) IsAccessTokenRevoked(ctx context.Context, req *pb.IsAccessTokenRevokedRequest) (*pb.IsAccessTokenRevokedResponse, error) {
+	revoked, err := s.userUseCase.IsAccessTokenRevoked(req.TokenId, req.Account, req.IssuedAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check token revocation: %v", err)
+	}
+
+	return &pb.IsAccessTokenRevokedResponse{
+		Revoked: revoked,
 	}, nil
 }
 
@@ -17,5 +17,8 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 
 	db.AutoMigrate(&domain.User{})
 	db.AutoMigrate(&domain.Address{})
+	db.AutoMigrate(&domain.RefreshToken{})
+	db.AutoMigrate(&domain.RevokedToken{})
+	db.AutoMigrate(&domain.AccountRevocation{})
 	return db, dbErr
 }
\ No newline at end of file
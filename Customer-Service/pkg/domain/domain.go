@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"time"
 	"user-service/pkg/models"
 )
 
@@ -30,7 +31,38 @@ type Address struct {
 	Country string `json:"country" gorm:"not null"`
 }
 
+// RefreshToken tracks an issued refresh token by its jti. Tokens minted from the
+// same login share a FamilyID so that reuse of a rotated token can revoke them all.
+type RefreshToken struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	TokenID    string    `json:"token_id" gorm:"uniqueIndex;not null"`
+	FamilyID   string    `json:"family_id" gorm:"index;not null"`
+	UserID     uint      `json:"user_id" gorm:"index;not null"`
+	ExpiresAt  time.Time `json:"expires_at" gorm:"not null"`
+	Revoked    bool      `json:"revoked" gorm:"default:false"`
+	ReplacedBy string    `json:"replaced_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// RevokedToken is an access token revoked before it expired, looked up by its
+// jti. The row is only needed until the token expires on its own.
+type RevokedToken struct {
+	TokenID   string    `json:"token_id" gorm:"primaryKey"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"index;not null"`
+}
+
+// AccountRevocation rejects every access token of an account whose iat is
+// before RevokedAt. RevokedAt is in whole Unix seconds like iat itself, and the
+// row is kept until ExpiresAt, when every token it covers has expired.
+type AccountRevocation struct {
+	Account   string    `json:"account" gorm:"primaryKey"`
+	RevokedAt int64     `json:"revoked_at" gorm:"not null"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"index;not null"`
+}
+
 var (
-	ErrAddressNotFound    = errors.New("address not found")
-	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrAddressNotFound     = errors.New("address not found")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected, all sessions of this login were revoked")
 )
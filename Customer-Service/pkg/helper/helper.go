@@ -1,6 +1,8 @@
 package helper
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -10,10 +12,19 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 24 * 90 * time.Hour
+)
+
 type AuthUserClaims struct {
-	Id    int    `json:"id"`
-	Email string `json:"email"`
-	Role  string `json:"role"`
+	Id        int    `json:"id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	TokenType string `json:"token_type,omitempty"`
 	jwt.StandardClaims
 }
 
@@ -26,11 +37,22 @@ func PasswordHash(password string) (string, error) {
 	return hash, nil
 }
 
-func GenerateTokenUsers(userID int, userEmail string, expirationTime time.Time) (string, error) {
+// NewTokenID returns a random identifier used as the jti of issued tokens.
+func NewTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func GenerateTokenUsers(userID int, userEmail string, tokenType string, tokenID string, expirationTime time.Time) (string, error) {
 	claims := &AuthUserClaims{
-		Id:    userID,
-		Email: userEmail,
+		Id:        userID,
+		Email:     userEmail,
+		TokenType: tokenType,
 		StandardClaims: jwt.StandardClaims{
+			Id:        tokenID,
 			ExpiresAt: expirationTime.Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
@@ -44,22 +66,51 @@ func GenerateTokenUsers(userID int, userEmail string, expirationTime time.Time)
 }
 
 func GenerateAccessToken(user models.UserDetails) (string, error) {
-	expirationTime := time.Now().Add(15 * time.Minute)
-	tokenString, err := GenerateTokenUsers(int(user.ID), user.Email, expirationTime)
+	tokenID, err := NewTokenID()
+	if err != nil {
+		return "", err
+	}
+	expirationTime := time.Now().Add(AccessTokenTTL)
+	tokenString, err := GenerateTokenUsers(int(user.ID), user.Email, AccessTokenType, tokenID, expirationTime)
 	if err != nil {
 		return "", err
 	}
 	return tokenString, nil
 }
 
-func GenerateRefreshToken(user models.UserDetails) (string, error) {
-	expirationTime := time.Now().Add(24 * 90 * time.Hour)
-	tokenString, err := GenerateTokenUsers(int(user.ID), user.Email, expirationTime)
+// GenerateRefreshToken mints a refresh token with the given jti so the token can be
+// tracked server side and rotated on use.
+func GenerateRefreshToken(user models.UserDetails, tokenID string, expirationTime time.Time) (string, error) {
+	tokenString, err := GenerateTokenUsers(int(user.ID), user.Email, RefreshTokenType, tokenID, expirationTime)
 	if err != nil {
 		return "", err
 	}
 	return tokenString, nil
 }
+
+// ParseRefreshToken validates the signature and expiry of a refresh token and
+// returns its claims.
+func ParseRefreshToken(tokenString string) (*AuthUserClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &AuthUserClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("invalid signing method")
+		}
+		return []byte("123456789"), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*AuthUserClaims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+	if claims.TokenType != RefreshTokenType || claims.StandardClaims.Id == "" {
+		return nil, fmt.Errorf("token is not a refresh token")
+	}
+
+	return claims, nil
+}
 func CompareHashAndPassword(a string, b string) error {
 	err := bcrypt.CompareHashAndPassword([]byte(a), []byte(b))
 	if err != nil {
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_UserSignUp_FullMethodName    = "/user.User/UserSignUp"
-	User_UserLogin_FullMethodName     = "/user.User/UserLogin"
-	User_AddAddress_FullMethodName    = "/user.User/AddAddress"
-	User_GetAddress_FullMethodName    = "/user.User/GetAddress"
-	User_UpdateAddress_FullMethodName = "/user.User/UpdateAddress"
-	User_DeleteAddress_FullMethodName = "/user.User/DeleteAddress"
+	User_UserSignUp_FullMethodName           = "/user.User/UserSignUp"
+	User_UserLogin_FullMethodName            = "/user.User/UserLogin"
+	User_RefreshToken_FullMethodName         = "/user.User/RefreshToken"
+	User_RevokeRefreshToken_FullMethodName   = "/user.User/RevokeRefreshToken"
+	User_RevokeUserSessions_FullMethodName   = "/user.User/RevokeUserSessions"
+	User_RevokeAccessToken_FullMethodName    = "/user.User/RevokeAccessToken"
+	User_RevokeAccount_FullMethodName        = "/user.User/RevokeAccount"
+	User_IsAccessTokenRevoked_FullMethodName = "/user.User/IsAccessTokenRevoked"
+	User_AddAddress_FullMethodName           = "/user.User/AddAddress"
+	User_GetAddress_FullMethodName           = "/user.User/GetAddress"
+	User_UpdateAddress_FullMethodName        = "/user.User/UpdateAddress"
+	User_DeleteAddress_FullMethodName        = "/user.User/DeleteAddress"
 )
 
 // UserClient is the client API for User service.
@@ -33,6 +39,12 @@ const (
 type UserClient interface {
 	UserSignUp(ctx context.Context, in *UserSignUpRequest, opts ...grpc.CallOption) (*UserSignUpResponse, error)
 	UserLogin(ctx context.Context, in *UserLoginRequest, opts ...grpc.CallOption) (*UserLoginResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...grpc.CallOption) (*RevokeRefreshTokenResponse, error)
+	RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeUserSessionsResponse, error)
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error)
+	RevokeAccount(ctx context.Context, in *RevokeAccountRequest, opts ...grpc.CallOption) (*RevokeAccountResponse, error)
+	IsAccessTokenRevoked(ctx context.Context, in *IsAccessTokenRevokedRequest, opts ...grpc.CallOption) (*IsAccessTokenRevokedResponse, error)
 	AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error)
 	GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error)
 	UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*UpdateAddressResponse, error)
@@ -67,6 +79,66 @@ func (c *userClient) UserLogin(ctx context.Context, in *UserLoginRequest, opts .
 	return out, nil
 }
 
+func (c *userClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, User_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeRefreshToken(ctx context.Context, in *RevokeRefreshTokenRequest, opts ...grpc.CallOption) (*RevokeRefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeRefreshTokenResponse)
+	err := c.cc.Invoke(ctx, User_RevokeRefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserSessionsResponse)
+	err := c.cc.Invoke(ctx, User_RevokeUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccessTokenResponse)
+	err := c.cc.Invoke(ctx, User_RevokeAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RevokeAccount(ctx context.Context, in *RevokeAccountRequest, opts ...grpc.CallOption) (*RevokeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccountResponse)
+	err := c.cc.Invoke(ctx, User_RevokeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) IsAccessTokenRevoked(ctx context.Context, in *IsAccessTokenRevokedRequest, opts ...grpc.CallOption) (*IsAccessTokenRevokedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsAccessTokenRevokedResponse)
+	err := c.cc.Invoke(ctx, User_IsAccessTokenRevoked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddAddressResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddAddressResponse)
@@ -113,6 +185,12 @@ func (c *userClient) DeleteAddress(ctx context.Context, in *DeleteAddressRequest
 type UserServer interface {
 	UserSignUp(context.Context, *UserSignUpRequest) (*UserSignUpResponse, error)
 	UserLogin(context.Context, *UserLoginRequest) (*UserLoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	RevokeRefreshToken(context.Context, *RevokeRefreshTokenRequest) (*RevokeRefreshTokenResponse, error)
+	RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeUserSessionsResponse, error)
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*RevokeAccessTokenResponse, error)
+	RevokeAccount(context.Context, *RevokeAccountRequest) (*RevokeAccountResponse, error)
+	IsAccessTokenRevoked(context.Context, *IsAccessTokenRevokedRequest) (*IsAccessTokenRevokedResponse, error)
 	AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error)
 	GetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error)
 	UpdateAddress(context.Context, *UpdateAddressRequest) (*UpdateAddressResponse, error)
@@ -133,6 +211,24 @@ func (UnimplementedUserServer) UserSignUp(context.Context, *UserSignUpRequest) (
 func (UnimplementedUserServer) UserLogin(context.Context, *UserLoginRequest) (*UserLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserLogin not implemented")
 }
+func (UnimplementedUserServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServer) RevokeRefreshToken(context.Context, *RevokeRefreshTokenRequest) (*RevokeRefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRefreshToken not implemented")
+}
+func (UnimplementedUserServer) RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSessions not implemented")
+}
+func (UnimplementedUserServer) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*RevokeAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedUserServer) RevokeAccount(context.Context, *RevokeAccountRequest) (*RevokeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccount not implemented")
+}
+func (UnimplementedUserServer) IsAccessTokenRevoked(context.Context, *IsAccessTokenRevokedRequest) (*IsAccessTokenRevokedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAccessTokenRevoked not implemented")
+}
 func (UnimplementedUserServer) AddAddress(context.Context, *AddAddressRequest) (*AddAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAddress not implemented")
 }
@@ -202,6 +298,114 @@ func _User_UserLogin_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeRefreshToken(ctx, req.(*RevokeRefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeUserSessions(ctx, req.(*RevokeUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeAccessToken(ctx, req.(*RevokeAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RevokeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RevokeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RevokeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RevokeAccount(ctx, req.(*RevokeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_IsAccessTokenRevoked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAccessTokenRevokedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).IsAccessTokenRevoked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_IsAccessTokenRevoked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).IsAccessTokenRevoked(ctx, req.(*IsAccessTokenRevokedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddAddressRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +493,30 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserLogin",
 			Handler:    _User_UserLogin_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _User_RefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeRefreshToken",
+			Handler:    _User_RevokeRefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeUserSessions",
+			Handler:    _User_RevokeUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _User_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "RevokeAccount",
+			Handler:    _User_RevokeAccount_Handler,
+		},
+		{
+			MethodName: "IsAccessTokenRevoked",
+			Handler:    _User_IsAccessTokenRevoked_Handler,
+		},
 		{
 			MethodName: "AddAddress",
 			Handler:    _User_AddAddress_Handler,
@@ -1,6 +1,7 @@
 package interfaces
 
 import (
+	"time"
 	"user-service/pkg/domain"
 	"user-service/pkg/models"
 )
@@ -10,6 +11,16 @@ type UserRepository interface {
 	CheckUserExistsByPhone(phone string) (*domain.User, error)
 	UserSignUp(user models.UserSignUp) (models.UserDetails, error)
 	FindUserByEmail(user models.UserLogin) (models.UserDetail, error)
+	FindUserByID(id uint) (models.UserDetails, error)
+
+	SaveRefreshToken(token domain.RefreshToken) error
+	GetRefreshToken(tokenID string) (domain.RefreshToken, error)
+	RotateRefreshToken(oldTokenID string, newToken domain.RefreshToken) (bool, error)
+	RevokeTokenFamily(familyID string) error
+	RevokeUserTokens(userID uint) error
+	RevokeAccessToken(tokenID string, expiresAt time.Time) error
+	RevokeAccount(account string, revokedAt int64, expiresAt time.Time) error
+	IsAccessTokenRevoked(tokenID string, account string, issuedAt int64) (bool, error)
 
 	AddAddress(address domain.Address) (domain.Address, error)
 	GetAddressByID(id uint) (domain.Address, error)
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"errors"
+	"time"
 	"user-service/pkg/domain"
 	"user-service/pkg/models"
 	"user-service/pkg/repository/interfaces"
@@ -87,6 +88,117 @@ func (ur *userRepository) FindUserByEmail(user models.UserLogin) (models.UserDet
 	return userDetails, nil
 }
 
+// FindUserByID finds a user by ID.
+func (ur *userRepository) FindUserByID(id uint) (models.UserDetails, error) {
+	var userDetails models.UserDetails
+	err := ur.DB.Raw(`SELECT id, firstname, lastname, email, phone FROM users WHERE id = ?`, id).Scan(&userDetails).Error
+	if err != nil {
+		return models.UserDetails{}, err
+	}
+	if userDetails.ID == 0 {
+		return models.UserDetails{}, errors.New("user not found")
+	}
+	return userDetails, nil
+}
+
+// SaveRefreshToken stores a newly issued refresh token.
+func (ur *userRepository) SaveRefreshToken(token domain.RefreshToken) error {
+	return ur.DB.Create(&token).Error
+}
+
+// GetRefreshToken retrieves a refresh token by its jti.
+func (ur *userRepository) GetRefreshToken(tokenID string) (domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	err := ur.DB.Where("token_id = ?", tokenID).First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.RefreshToken{}, domain.ErrInvalidRefreshToken
+		}
+		return domain.RefreshToken{}, err
+	}
+	return token, nil
+}
+
+// RotateRefreshToken marks the old token as replaced and stores the new one in a
+// single transaction. It reports false when the old token was already rotated or
+// revoked, which means the presented token is being reused.
+func (ur *userRepository) RotateRefreshToken(oldTokenID string, newToken domain.RefreshToken) (bool, error) {
+	rotated := false
+	err := ur.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(`
+			UPDATE refresh_tokens SET revoked = true, replaced_by = ?
+			WHERE token_id = ? AND revoked = false
+		`, newToken.TokenID, oldTokenID)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(&newToken).Error; err != nil {
+			return err
+		}
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return rotated, nil
+}
+
+// RevokeTokenFamily revokes every refresh token issued from the same login.
+func (ur *userRepository) RevokeTokenFamily(familyID string) error {
+	return ur.DB.Exec("UPDATE refresh_tokens SET revoked = true WHERE family_id = ?", familyID).Error
+}
+
+// RevokeUserTokens revokes every refresh token issued to a user.
+func (ur *userRepository) RevokeUserTokens(userID uint) error {
+	return ur.DB.Exec("UPDATE refresh_tokens SET revoked = true WHERE user_id = ? AND revoked = false", userID).Error
+}
+
+// RevokeAccessToken records a revoked access token until it expires. Rows of
+// tokens that have expired in the meantime are dropped on the way.
+func (ur *userRepository) RevokeAccessToken(tokenID string, expiresAt time.Time) error {
+	return ur.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM revoked_tokens WHERE expires_at < NOW()").Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			INSERT INTO revoked_tokens (token_id, expires_at) VALUES (?, ?)
+			ON CONFLICT (token_id) DO NOTHING
+		`, tokenID, expiresAt).Error
+	})
+}
+
+// RevokeAccount records the cutoff for the tokens of an account. An earlier
+// cutoff never replaces a later one.
+func (ur *userRepository) RevokeAccount(account string, revokedAt int64, expiresAt time.Time) error {
+	return ur.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM account_revocations WHERE expires_at < NOW()").Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			INSERT INTO account_revocations (account, revoked_at, expires_at) VALUES (?, ?, ?)
+			ON CONFLICT (account) DO UPDATE SET
+				revoked_at = GREATEST(account_revocations.revoked_at, EXCLUDED.revoked_at),
+				expires_at = GREATEST(account_revocations.expires_at, EXCLUDED.expires_at)
+		`, account, revokedAt, expiresAt).Error
+	})
+}
+
+// IsAccessTokenRevoked reports whether the token itself was revoked or was
+// issued before the cutoff of its account. A token issued at the cutoff second
+// or later is valid.
+func (ur *userRepository) IsAccessTokenRevoked(tokenID string, account string, issuedAt int64) (bool, error) {
+	var revoked bool
+	err := ur.DB.Raw(`
+		SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE token_id = ? AND expires_at >= NOW())
+			OR EXISTS (SELECT 1 FROM account_revocations WHERE account = ? AND revoked_at > ? AND expires_at >= NOW())
+	`, tokenID, account, issuedAt).Scan(&revoked).Error
+	return revoked, err
+}
 
 // AddAddress adds a new address.
 func (ur *userRepository) AddAddress(address domain.Address) (domain.Address, error) {
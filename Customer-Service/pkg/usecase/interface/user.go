@@ -1,6 +1,7 @@
 package interfaceUseCase
 
 import (
+	"time"
 	"user-service/pkg/domain"
 	"user-service/pkg/models"
 )
@@ -8,6 +9,12 @@ import (
 type UserUseCase interface {
 	UsersSignUp(user models.UserSignUp) (domain.TokenUser, error)
 	UsersLogin(user models.UserLogin) (domain.TokenUser, error)
+	RefreshToken(refreshToken string) (domain.TokenUser, error)
+	RevokeRefreshToken(refreshToken string) error
+	RevokeUserSessions(userID uint) error
+	RevokeAccessToken(tokenID string, expiresAt int64) error
+	RevokeAccount(account string, tokenLifetime time.Duration) (int64, error)
+	IsAccessTokenRevoked(tokenID string, account string, issuedAt int64) (bool, error)
 
 	AddAddress(address models.AddressDetail) (models.AddressDetail, error)
 	GetAddress(id uint) (domain.Address, error)
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"errors"
+	"time"
 	"user-service/pkg/domain"
 	"user-service/pkg/helper"
 	"user-service/pkg/models"
@@ -57,7 +58,7 @@ func (uc *userUseCase) UsersSignUp(user models.UserSignUp) (domain.TokenUser, er
 	if err != nil {
 		return domain.TokenUser{}, errors.New("failed to generate access token")
 	}
-	refreshToken, err := helper.GenerateRefreshToken(userData)
+	refreshToken, err := uc.issueRefreshToken(userData)
 	if err != nil {
 		return domain.TokenUser{}, errors.New("failed to generate refresh token")
 	}
@@ -94,7 +95,7 @@ func (ur *userUseCase) UsersLogin(user models.UserLogin) (domain.TokenUser, erro
 	if err != nil {
 		return domain.TokenUser{}, errors.New("couldn't create accesstoken due to internal error")
 	}
-	refreshToken, err := helper.GenerateRefreshToken(userDetails)
+	refreshToken, err := ur.issueRefreshToken(userDetails)
 	if err != nil {
 		return domain.TokenUser{}, errors.New("counldn't create refreshtoken due to internal error")
 	}
@@ -105,6 +106,152 @@ func (ur *userUseCase) UsersLogin(user models.UserLogin) (domain.TokenUser, erro
 	}, nil
 }
 
+// RefreshToken exchanges a valid refresh token for a new access token and rotates
+// the refresh token. Presenting a token that was already rotated revokes the whole
+// token family, since it means the token has leaked.
+func (ur *userUseCase) RefreshToken(refreshToken string) (domain.TokenUser, error) {
+	claims, err := helper.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return domain.TokenUser{}, domain.ErrInvalidRefreshToken
+	}
+
+	stored, err := ur.userRepository.GetRefreshToken(claims.StandardClaims.Id)
+	if err != nil {
+		return domain.TokenUser{}, err
+	}
+	if stored.Revoked {
+		if stored.ReplacedBy != "" {
+			if err := ur.userRepository.RevokeTokenFamily(stored.FamilyID); err != nil {
+				return domain.TokenUser{}, err
+			}
+			return domain.TokenUser{}, domain.ErrRefreshTokenReused
+		}
+		return domain.TokenUser{}, domain.ErrInvalidRefreshToken
+	}
+	if time.Now().After(stored.ExpiresAt) || stored.UserID != uint(claims.Id) {
+		return domain.TokenUser{}, domain.ErrInvalidRefreshToken
+	}
+
+	userDetails, err := ur.userRepository.FindUserByID(stored.UserID)
+	if err != nil {
+		return domain.TokenUser{}, domain.ErrInvalidRefreshToken
+	}
+
+	tokenID, err := helper.NewTokenID()
+	if err != nil {
+		return domain.TokenUser{}, errors.New("couldn't create refreshtoken due to internal error")
+	}
+	expiresAt := time.Now().Add(helper.RefreshTokenTTL)
+	newRefreshToken, err := helper.GenerateRefreshToken(userDetails, tokenID, expiresAt)
+	if err != nil {
+		return domain.TokenUser{}, errors.New("couldn't create refreshtoken due to internal error")
+	}
+
+	rotated, err := ur.userRepository.RotateRefreshToken(stored.TokenID, domain.RefreshToken{
+		TokenID:   tokenID,
+		FamilyID:  stored.FamilyID,
+		UserID:    stored.UserID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return domain.TokenUser{}, err
+	}
+	if !rotated {
+		// another request rotated this token first
+		if err := ur.userRepository.RevokeTokenFamily(stored.FamilyID); err != nil {
+			return domain.TokenUser{}, err
+		}
+		return domain.TokenUser{}, domain.ErrRefreshTokenReused
+	}
+
+	accessToken, err := helper.GenerateAccessToken(userDetails)
+	if err != nil {
+		return domain.TokenUser{}, errors.New("couldn't create accesstoken due to internal error")
+	}
+
+	return domain.TokenUser{
+		User:         userDetails,
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// RevokeRefreshToken ends the session a refresh token belongs to by revoking its
+// whole token family.
+func (ur *userUseCase) RevokeRefreshToken(refreshToken string) error {
+	claims, err := helper.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return domain.ErrInvalidRefreshToken
+	}
+	stored, err := ur.userRepository.GetRefreshToken(claims.StandardClaims.Id)
+	if err != nil {
+		return err
+	}
+	return ur.userRepository.RevokeTokenFamily(stored.FamilyID)
+}
+
+// RevokeUserSessions revokes every refresh token of a user so that no session
+// can be renewed.
+func (ur *userUseCase) RevokeUserSessions(userID uint) error {
+	return ur.userRepository.RevokeUserTokens(userID)
+}
+
+// RevokeAccessToken rejects an access token by its jti until it expires.
+func (ur *userUseCase) RevokeAccessToken(tokenID string, expiresAt int64) error {
+	if tokenID == "" {
+		return nil
+	}
+	return ur.userRepository.RevokeAccessToken(tokenID, time.Unix(expiresAt, 0))
+}
+
+// RevokeAccount rejects every access token of the account issued so far. The
+// iat claim only has whole seconds, so the cutoff is the next second: a token
+// passes only when its iat is at or after the cutoff, which a token issued in
+// the same second as the revocation never is. The cutoff is kept for the
+// lifetime of the tokens it covers and returned to the caller.
+func (ur *userUseCase) RevokeAccount(account string, tokenLifetime time.Duration) (int64, error) {
+	now := time.Now()
+	revokedAt := now.Truncate(time.Second).Add(time.Second)
+	if err := ur.userRepository.RevokeAccount(account, revokedAt.Unix(), revokedAt.Add(tokenLifetime)); err != nil {
+		return 0, err
+	}
+	return revokedAt.Unix(), nil
+}
+
+// IsAccessTokenRevoked reports whether an access token was revoked on its own
+// or together with every token of its account.
+func (ur *userUseCase) IsAccessTokenRevoked(tokenID string, account string, issuedAt int64) (bool, error) {
+	return ur.userRepository.IsAccessTokenRevoked(tokenID, account, issuedAt)
+}
+
+// issueRefreshToken mints and stores the first refresh token of a new token
+// family, as happens on every signup and login.
+func (ur *userUseCase) issueRefreshToken(user models.UserDetails) (string, error) {
+	tokenID, err := helper.NewTokenID()
+	if err != nil {
+		return "", err
+	}
+	familyID, err := helper.NewTokenID()
+	if err != nil {
+		return "", err
+	}
+	expiresAt := time.Now().Add(helper.RefreshTokenTTL)
+	refreshToken, err := helper.GenerateRefreshToken(user, tokenID, expiresAt)
+	if err != nil {
+		return "", err
+	}
+	err = ur.userRepository.SaveRefreshToken(domain.RefreshToken{
+		TokenID:   tokenID,
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
 // AddAddress adds an address to the user's account.
 func (uc *userUseCase) AddAddress(address models.AddressDetail) (models.AddressDetail, error) {
 	addressData := domain.Address{}
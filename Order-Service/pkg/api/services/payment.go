@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	pb "order-service/pkg/pb/order"
+	"time"
+)
+
+func (or *OrderServer) PayOrder(ctx context.Context, req *pb.PayOrderRequest) (*pb.PaymentResponse, error) {
+	result, err := or.UseCase.PayOrder(int(req.OrderID), int(req.UserID), int(req.PaymentID))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.PaymentResponse{
+		Payment: paymentDetails(result),
+	}, nil
+}
+
+func (or *OrderServer) GetOrderPayments(ctx context.Context, req *pb.GetOrderPaymentsRequest) (*pb.GetOrderPaymentsResponse, error) {
+	payments, err := or.UseCase.GetOrderPayments(int(req.OrderID), int(req.UserID))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	var result pb.GetOrderPaymentsResponse
+	for _, p := range payments {
+		result.Payments = append(result.Payments, paymentDetails(p))
+	}
+	return &result, nil
+}
+
+func (or *OrderServer) HandlePaymentWebhook(ctx context.Context, req *pb.PaymentWebhookRequest) (*pb.PaymentResponse, error) {
+	result, err := or.UseCase.HandlePaymentWebhook(models.PaymentWebhook{
+		Provider:  req.Provider,
+		Payload:   req.Payload,
+		Signature: req.Signature,
+	})
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.PaymentResponse{
+		Payment: paymentDetails(result),
+	}, nil
+}
+
+func (or *OrderServer) SimulatePayment(ctx context.Context, req *pb.SimulatePaymentRequest) (*pb.SimulatePaymentResponse, error) {
+	webhook, err := or.UseCase.SimulatePayment(int(req.PaymentID), req.Outcome)
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.SimulatePaymentResponse{
+		Provider:  webhook.Provider,
+		Payload:   webhook.Payload,
+		Signature: webhook.Signature,
+	}, nil
+}
+
+func paymentDetails(p domain.PaymentIntent) *pb.PaymentDetails {
+	return &pb.PaymentDetails{
+		ID:            int64(p.ID),
+		OrderID:       int64(p.OrderID),
+		Method:        p.Method,
+		Provider:      p.Provider,
+		Reference:     p.ProviderRef,
+		Amount:        float32(p.Amount),
+		Status:        p.Status,
+		CheckoutURL:   p.CheckoutURL,
+		FailureReason: p.FailureReason,
+		CreatedAt:     p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     p.UpdatedAt.Format(time.RFC3339),
+	}
+}
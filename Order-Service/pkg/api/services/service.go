@@ -2,9 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
+	"order-service/pkg/domain"
 	"order-service/pkg/models"
 	pb "order-service/pkg/pb/order"
 	interfaceUse "order-service/pkg/usecase/interfaces"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderServer struct {
@@ -26,12 +32,17 @@ func (or *OrderServer) OrderItemsFromCart(ctx context.Context, req *pb.OrderItem
 	userID := int(req.UserID)
 	result, err := or.UseCase.OrderItemsFromCart(*model, userID)
 	if err != nil {
-		return &pb.OrderItemsFromCartResponse{}, err
+		return &pb.OrderItemsFromCartResponse{}, orderError(err)
 	}
-	return &pb.OrderItemsFromCartResponse{
+	response := &pb.OrderItemsFromCartResponse{
 		OrderID:        int64(result.OrderID),
 		Shipmentstatus: result.ShipmentStatus,
-	}, nil
+		Paymentstatus:  result.PaymentStatus,
+	}
+	if result.Payment != nil {
+		response.Payment = paymentDetails(*result.Payment)
+	}
+	return response, nil
 }
 func (or *OrderServer) GetOrderDetails(ctx context.Context, req *pb.GetOrderDetailsRequest) (*pb.GetOrderDetailsResponse, error) {
 	userID := int(req.UserID)
@@ -51,6 +62,8 @@ func (or *OrderServer) GetOrderDetails(ctx context.Context, req *pb.GetOrderDeta
 		orderDetails.Price = float32(v.OrderDetails.FinalPrice)
 		orderDetails.Shipmentstatus = v.OrderDetails.ShipmentStatus
 		orderDetails.Paymentstatus = v.OrderDetails.PaymentStatus
+		orderDetails.Discount = float32(v.OrderDetails.Discount)
+		orderDetails.CouponCode = v.OrderDetails.CouponCode
 
 		var orderProductDetails []*pb.OrderProductDetails
 		for _, product := range v.OrderProductDetails {
@@ -72,3 +85,130 @@ func (or *OrderServer) GetOrderDetails(ctx context.Context, req *pb.GetOrderDeta
 
 	return &result, nil
 }
+
+func (or *OrderServer) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	result, err := or.UseCase.UpdateOrderStatus(int(req.OrderID), req.Status, req.Actor)
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.UpdateOrderStatusResponse{
+		OrderID:        int64(result.OrderID),
+		Shipmentstatus: result.ShipmentStatus,
+	}, nil
+}
+
+func (or *OrderServer) GetOrderTimeline(ctx context.Context, req *pb.GetOrderTimelineRequest) (*pb.GetOrderTimelineResponse, error) {
+	timeline, err := or.UseCase.GetOrderTimeline(int(req.OrderID), int(req.UserID))
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	result := &pb.GetOrderTimelineResponse{
+		OrderID:        int64(timeline.OrderID),
+		Shipmentstatus: timeline.ShipmentStatus,
+	}
+	for _, e := range timeline.Events {
+		result.Events = append(result.Events, &pb.OrderStatusEvent{
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			Actor:      e.Actor,
+			CreatedAt:  e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return result, nil
+}
+
+func (or *OrderServer) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+	result, err := or.UseCase.CancelOrder(int(req.OrderID), int(req.UserID))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.CancelOrderResponse{
+		OrderID:        int64(result.OrderID),
+		Shipmentstatus: result.ShipmentStatus,
+		Paymentstatus:  result.PaymentStatus,
+	}, nil
+}
+
+func (or *OrderServer) RequestReturn(ctx context.Context, req *pb.RequestReturnRequest) (*pb.RequestReturnResponse, error) {
+	result, err := or.UseCase.RequestReturn(models.ReturnRequestInput{
+		OrderID:    int(req.OrderID),
+		UserID:     int(req.UserID),
+		ProductID:  int(req.ProductID),
+		ReasonCode: req.ReasonCode,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.RequestReturnResponse{
+		Return: returnDetails(result),
+	}, nil
+}
+
+func (or *OrderServer) ListReturnRequests(ctx context.Context, req *pb.ListReturnRequestsRequest) (*pb.ListReturnRequestsResponse, error) {
+	requests, err := or.UseCase.ListReturnRequests(int(req.UserID), req.Status, int(req.Page), int(req.Count))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	var result pb.ListReturnRequestsResponse
+	for _, r := range requests {
+		result.Returns = append(result.Returns, returnDetails(r))
+	}
+	return &result, nil
+}
+
+func (or *OrderServer) ReviewReturnRequest(ctx context.Context, req *pb.ReviewReturnRequestRequest) (*pb.ReviewReturnRequestResponse, error) {
+	result, err := or.UseCase.ReviewReturnRequest(models.ReturnReview{
+		ReturnID: int(req.ReturnID),
+		Approve:  req.Approve,
+		Actor:    req.Actor,
+		Note:     req.Note,
+	})
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.ReviewReturnRequestResponse{
+		Return: returnDetails(result),
+	}, nil
+}
+
+func returnDetails(r domain.ReturnRequest) *pb.ReturnRequestDetails {
+	return &pb.ReturnRequestDetails{
+		ID:          int64(r.ID),
+		OrderID:     int64(r.OrderID),
+		OrderItemID: int64(r.OrderItemID),
+		ProductID:   int64(r.ProductID),
+		Quantity:    int64(r.Quantity),
+		Amount:      float32(r.Amount),
+		ReasonCode:  r.ReasonCode,
+		Comment:     r.Comment,
+		Status:      r.Status,
+		ReviewNote:  r.ReviewNote,
+		CreatedAt:   r.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// orderError maps domain errors to gRPC status codes.
+func orderError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotFound), errors.Is(err, domain.ErrOrderItemNotFound), errors.Is(err, domain.ErrReturnNotFound),
+		errors.Is(err, domain.ErrPaymentNotFound), errors.Is(err, domain.ErrUnknownPaymentProvider):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrUnknownOrderStatus), errors.Is(err, domain.ErrInvalidReturnReason),
+		errors.Is(err, domain.ErrPaymentMethodNotFound), errors.Is(err, domain.ErrInvalidPaymentEvent):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, domain.ErrInvalidStatusTransition), errors.Is(err, domain.ErrOrderNotCancellable), errors.Is(err, domain.ErrStatusNeedsWorkflow),
+		errors.Is(err, domain.ErrOrderNotDelivered), errors.Is(err, domain.ErrOrderNotPaid), errors.Is(err, domain.ErrReturnAlreadyReviewed),
+		errors.Is(err, domain.ErrPaymentDeclined), errors.Is(err, domain.ErrOrderAlreadyPaid),
+		errors.Is(err, domain.ErrOrderNotPayable), errors.Is(err, domain.ErrSimulationUnsupported),
+		errors.Is(err, domain.ErrInsufficientBalance):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, domain.ErrReturnAlreadyRequested):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, domain.ErrInvalidWebhookSignature):
+		return status.Errorf(codes.Unauthenticated, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
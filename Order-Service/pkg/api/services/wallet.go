@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"order-service/pkg/domain"
+	pb "order-service/pkg/pb/order"
+	"time"
+)
+
+func (or *OrderServer) GetWallet(ctx context.Context, req *pb.GetWalletRequest) (*pb.GetWalletResponse, error) {
+	wallet, err := or.UseCase.GetWallet(int(req.UserID))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return &pb.GetWalletResponse{
+		UserID:  int64(wallet.UserID),
+		Balance: wallet.Balance,
+	}, nil
+}
+
+func (or *OrderServer) ListWalletTransactions(ctx context.Context, req *pb.ListWalletTransactionsRequest) (*pb.ListWalletTransactionsResponse, error) {
+	entries, err := or.UseCase.ListWalletTransactions(int(req.UserID), int(req.Page), int(req.Count))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	var result pb.ListWalletTransactionsResponse
+	for _, e := range entries {
+		result.Transactions = append(result.Transactions, walletTransaction(e))
+	}
+	return &result, nil
+}
+
+func walletTransaction(e domain.WalletTransaction) *pb.WalletTransaction {
+	return &pb.WalletTransaction{
+		ID:           int64(e.ID),
+		Type:         e.Type,
+		Amount:       e.Amount,
+		BalanceAfter: e.BalanceAfter,
+		Reason:       e.Reason,
+		Reference:    e.Reference,
+		CreatedAt:    e.CreatedAt.Format(time.RFC3339),
+	}
+}
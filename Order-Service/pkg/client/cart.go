@@ -38,11 +38,12 @@ func (c *cartClient) GetAllItemsFromCart(userID int) ([]models.Cart, error) {
 	}
 	var result []models.Cart
 
+	// A line is ordered at its price after the offer Cart-Service applied.
 	for _, v := range res.Cart {
 		result = append(result, models.Cart{
 			ProductID:  uint(v.ProductID),
 			Quantity:   float64(v.Quantity),
-			TotalPrice: float64(v.TotalPrice),
+			TotalPrice: float64(v.FinalPrice),
 		})
 	}
 	return result, nil
@@ -56,14 +57,19 @@ func (c *cartClient) DoesCartExist(userID int) (bool, error) {
 	}
 	return res.Data, nil
 }
-func (c *cartClient) TotalAmountInCart(userID int) (float64, error) {
+func (c *cartClient) TotalAmountInCart(userID int) (models.CartTotal, error) {
 	res, err := c.Client.TotalAmountInCart(context.Background(), &pbc.TotalAmountInCartRequest{
 		UserID: int64(userID),
 	})
 	if err != nil {
-		return 0.0, err
+		return models.CartTotal{}, err
 	}
-	return float64(res.Data), err
+	return models.CartTotal{
+		TotalPrice: float64(res.Data),
+		Discount:   float64(res.Discount),
+		FinalPrice: float64(res.FinalPrice),
+		CouponCode: res.CouponCode,
+	}, nil
 }
 func (c *cartClient) UpdateCartAfterOrder(userID, productID int, quantity float64) error {
 	_, err := c.Client.UpdateCartAfterOrder(context.Background(), &pbc.UpdateCartAfterOrderRequest{
@@ -76,3 +82,31 @@ func (c *cartClient) UpdateCartAfterOrder(userID, productID int, quantity float6
 	}
 	return nil
 }
+func (c *cartClient) ClearCart(userID int, productIDs []int) error {
+	req := &pbc.ClearCartRequest{
+		UserID: int64(userID),
+	}
+	for _, id := range productIDs {
+		req.ProductIDs = append(req.ProductIDs, int64(id))
+	}
+	_, err := c.Client.ClearCart(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *cartClient) RedeemCoupon(userID, orderID int, code string, discount float64) error {
+	_, err := c.Client.RedeemCoupon(context.Background(), &pbc.RedeemCouponRequest{
+		UserID:   int64(userID),
+		OrderID:  int64(orderID),
+		Code:     code,
+		Discount: float32(discount),
+	})
+	return err
+}
+func (c *cartClient) ReleaseCoupon(orderID int) error {
+	_, err := c.Client.ReleaseCoupon(context.Background(), &pbc.ReleaseCouponRequest{
+		OrderID: int64(orderID),
+	})
+	return err
+}
@@ -5,6 +5,9 @@ import "order-service/pkg/models"
 type CartClient interface {
 	GetAllItemsFromCart(userID int) ([]models.Cart, error)
 	DoesCartExist(userID int) (bool, error)
-	TotalAmountInCart(userID int) (float64, error)
+	TotalAmountInCart(userID int) (models.CartTotal, error)
 	UpdateCartAfterOrder(userID, productID int, quantity float64) error
+	ClearCart(userID int, productIDs []int) error
+	RedeemCoupon(userID, orderID int, code string, discount float64) error
+	ReleaseCoupon(orderID int) error
 }
@@ -1,5 +1,13 @@
 package interfaceClient
 
+import "order-service/pkg/models"
+
 type ProductClient interface {
 	ProductStockMinus(productID, stock int) error
+	ProductStockPlus(productID, stock int, change models.StockChange) error
+	ProductStockMinusBulk(items []models.Cart, change models.StockChange) error
+	ProductStockPlusBulk(items []models.Cart, change models.StockChange) error
+	ReserveStock(reference string, items []models.Cart) error
+	ReleaseStock(reference string, productIDs []int) error
+	CommitReservation(reference string, productIDs []int) error
 }
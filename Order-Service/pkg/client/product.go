@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"order-service/pkg/config"
+	"order-service/pkg/models"
 	pb "order-service/pkg/pb/product"
 
 	"google.golang.org/grpc"
@@ -37,3 +38,82 @@ func (c *clientProduct) ProductStockMinus(productID, stock int) error {
 	}
 	return nil
 }
+func (c *clientProduct) ProductStockPlus(productID, stock int, change models.StockChange) error {
+	_, err := c.client.ProductStockPlus(context.Background(), &pb.ProductStockPlusRequest{
+		ID:        int64(productID),
+		Stock:     int64(stock),
+		Reason:    change.Reason,
+		Reference: change.Reference,
+		Actor:     change.Actor,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *clientProduct) ProductStockMinusBulk(items []models.Cart, change models.StockChange) error {
+	_, err := c.client.ProductStockMinusBulk(context.Background(), stockRequest(items, change))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *clientProduct) ProductStockPlusBulk(items []models.Cart, change models.StockChange) error {
+	_, err := c.client.ProductStockPlusBulk(context.Background(), stockRequest(items, change))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func stockRequest(items []models.Cart, change models.StockChange) *pb.ProductStockBulkRequest {
+	req := pb.ProductStockBulkRequest{
+		Reason:    change.Reason,
+		Reference: change.Reference,
+		Actor:     change.Actor,
+	}
+	for _, item := range items {
+		req.Items = append(req.Items, &pb.ProductStock{
+			ID:    int64(item.ProductID),
+			Stock: int64(item.Quantity),
+		})
+	}
+	return &req
+}
+
+// ReserveStock holds the quantities of the cart items under the reference,
+// replacing what was held under it for those products before.
+func (c *clientProduct) ReserveStock(reference string, items []models.Cart) error {
+	req := pb.ReserveStockRequest{Reference: reference}
+	for _, item := range items {
+		req.Items = append(req.Items, &pb.ProductStock{
+			ID:    int64(item.ProductID),
+			Stock: int64(item.Quantity),
+		})
+	}
+	_, err := c.client.ReserveStock(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *clientProduct) ReleaseStock(reference string, productIDs []int) error {
+	_, err := c.client.ReleaseStock(context.Background(), reservationRequest(reference, productIDs))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (c *clientProduct) CommitReservation(reference string, productIDs []int) error {
+	_, err := c.client.CommitReservation(context.Background(), reservationRequest(reference, productIDs))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func reservationRequest(reference string, productIDs []int) *pb.ReservationRequest {
+	req := pb.ReservationRequest{Reference: reference}
+	for _, id := range productIDs {
+		req.ProductIDs = append(req.ProductIDs, int64(id))
+	}
+	return &req
+}
@@ -11,10 +11,15 @@ type Config struct {
 	Port          string `mapstructure:"PORT"`
 	CartSvcUrl    string `mapstructure:"CART_SVC_URL"`
 	ProductSvcUrl string `mapstructure:"PRODUCT_SVC_URL"`
+
+	// PaymentWebhookSecret signs the webhook calls of the mock payment
+	// provider.
+	PaymentWebhookSecret string `mapstructure:"PAYMENT_WEBHOOK_SECRET"`
 }
 
 var envs = []string{
 	"DB_HOST", "DB_NAME", "DB_USER", "DB_PORT", "DB_PASSWORD", "PORT", "CART_SVC_URL", "PRODUCT_SVC_URL",
+	"PAYMENT_WEBHOOK_SECRET",
 }
 
 func LoadConfig() (Config, error) {
@@ -17,8 +17,23 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 
 	db.AutoMigrate(&domain.Order{})
 	db.AutoMigrate(&domain.OrderItem{})
+	db.AutoMigrate(&domain.OrderStatusHistory{})
+	db.AutoMigrate(&domain.ReturnRequest{})
+	db.AutoMigrate(&domain.Refund{})
 	db.AutoMigrate(&domain.Address{})
 	db.AutoMigrate(&domain.PaymentMethod{})
+	db.AutoMigrate(&domain.PaymentIntent{})
+	db.AutoMigrate(&domain.PaymentEvent{})
+	db.AutoMigrate(&domain.WalletTransaction{})
+
+	// Orders placed before the statuses became lower case still hold values
+	// such as "Pending" and "Paid", which no status transition starts from.
+	db.Exec("UPDATE orders SET shipment_status = lower(shipment_status) WHERE shipment_status <> lower(shipment_status)")
+	db.Exec("UPDATE orders SET payment_status = lower(payment_status) WHERE payment_status <> lower(payment_status)")
+
+	for _, name := range domain.PaymentMethods {
+		db.Exec("INSERT INTO payment_methods (payment_name) VALUES (?) ON CONFLICT (payment_name) DO NOTHING", name)
+	}
 	return db, dbErr
 
 }
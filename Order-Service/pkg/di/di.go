@@ -6,6 +6,8 @@ import (
 	"order-service/pkg/client"
 	"order-service/pkg/config"
 	"order-service/pkg/db"
+	"order-service/pkg/payment"
+	interfacePayment "order-service/pkg/payment/interfaces"
 	"order-service/pkg/repository"
 	"order-service/pkg/usecase"
 )
@@ -20,7 +22,12 @@ func InitializeAPI(cfg config.Config) (*server.Server, error) {
 	orderRepository := repository.NewOrderRepository(gormDB)
 	cartClient := client.NewCartClient(&cfg)
 	productClient := client.NewProductClient(&cfg)
-	orderUseCase := usecase.NewOrderUseCase(orderRepository, cartClient, productClient)
+	providers := []interfacePayment.Provider{
+		payment.NewMockProvider(payment.WebhookSecret(cfg.PaymentWebhookSecret)),
+		payment.NewCashOnDelivery(),
+		payment.NewWalletProvider(orderRepository),
+	}
+	orderUseCase := usecase.NewOrderUseCase(orderRepository, cartClient, productClient, providers)
 
 	orderServiceServer := services.NewOrderServer(orderUseCase)
 	grpcServer, err := server.NewGRPCServer(cfg, orderServiceServer)
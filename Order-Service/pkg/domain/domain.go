@@ -1,6 +1,152 @@
 package domain
 
-import "gorm.io/gorm"
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Shipment statuses of an order. An order starts as pending and moves forward
+// one step at a time; it can be cancelled until it is shipped and returned once
+// it is delivered. An order whose placement could not be completed is failed.
+const (
+	OrderStatusPending        = "pending"
+	OrderStatusConfirmed      = "confirmed"
+	OrderStatusPacked         = "packed"
+	OrderStatusShipped        = "shipped"
+	OrderStatusOutForDelivery = "out_for_delivery"
+	OrderStatusDelivered      = "delivered"
+	OrderStatusCancelled      = "cancelled"
+	OrderStatusReturned       = "returned"
+	OrderStatusFailed         = "failed"
+)
+
+// Payment statuses of an order.
+const (
+	PaymentStatusNotPaid       = "not paid"
+	PaymentStatusPaid          = "paid"
+	PaymentStatusRefundPending = "refund pending"
+	PaymentStatusFailed        = "payment failed"
+	PaymentStatusRefunded      = "refunded"
+)
+
+// PaymentCaptured reports whether the payment status of an order says its
+// price was collected. An order that is partly returned is marked for refund
+// or refunded, but the rest of it was still paid for.
+func PaymentCaptured(status string) bool {
+	switch status {
+	case PaymentStatusPaid, PaymentStatusRefundPending, PaymentStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// Payment methods a customer can choose when ordering. They are the names of
+// the rows in payment_methods.
+const (
+	PaymentMethodOnline = "online"
+	PaymentMethodCOD    = "cod"
+	PaymentMethodWallet = "wallet"
+)
+
+// PaymentMethods lists every payment method, in the order they are seeded.
+var PaymentMethods = []string{PaymentMethodOnline, PaymentMethodCOD, PaymentMethodWallet}
+
+// Statuses of a payment intent. An intent is pending until its provider
+// reports the outcome; cash on delivery stays pending until the order is
+// delivered.
+const (
+	IntentStatusPending   = "pending"
+	IntentStatusSucceeded = "succeeded"
+	IntentStatusFailed    = "failed"
+	IntentStatusCancelled = "cancelled"
+)
+
+// orderTransitions lists the statuses each status may move to.
+var orderTransitions = map[string][]string{
+	OrderStatusPending:        {OrderStatusConfirmed, OrderStatusCancelled, OrderStatusFailed},
+	OrderStatusConfirmed:      {OrderStatusPacked, OrderStatusCancelled},
+	OrderStatusPacked:         {OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusShipped:        {OrderStatusOutForDelivery},
+	OrderStatusOutForDelivery: {OrderStatusDelivered},
+	OrderStatusDelivered:      {OrderStatusReturned},
+	OrderStatusCancelled:      {},
+	OrderStatusReturned:       {},
+	OrderStatusFailed:         {},
+}
+
+// IsValidOrderStatus reports whether status is a known shipment status.
+func IsValidOrderStatus(status string) bool {
+	_, ok := orderTransitions[status]
+	return ok
+}
+
+// CanTransition reports whether an order may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrUnknownOrderStatus      = errors.New("unknown order status")
+	ErrInvalidStatusTransition = errors.New("order status transition not allowed")
+	ErrStatusNeedsWorkflow     = errors.New("order status can only be reached by cancelling or returning the order")
+	ErrOrderNotCancellable     = errors.New("order can only be cancelled before it is shipped")
+	ErrOrderItemNotFound       = errors.New("product is not part of this order")
+	ErrOrderNotDelivered       = errors.New("only delivered orders can be returned")
+	ErrOrderNotPaid            = errors.New("only paid orders can be returned")
+	ErrInvalidReturnReason     = errors.New("unknown return reason code")
+	ErrReturnAlreadyRequested  = errors.New("a return was already requested for this item")
+	ErrReturnNotFound          = errors.New("return request not found")
+	ErrReturnAlreadyReviewed   = errors.New("return request was already reviewed")
+	ErrPaymentMethodNotFound   = errors.New("payment method does not exist")
+	ErrPaymentNotFound         = errors.New("payment not found")
+	ErrPaymentDeclined         = errors.New("payment declined")
+	ErrOrderAlreadyPaid        = errors.New("order is already paid")
+	ErrOrderNotPayable         = errors.New("order can no longer be paid")
+	ErrUnknownPaymentProvider  = errors.New("unknown payment provider")
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrInvalidPaymentEvent     = errors.New("invalid payment event")
+	ErrSimulationUnsupported   = errors.New("payment provider cannot simulate payments")
+	ErrInsufficientBalance     = errors.New("insufficient wallet balance")
+	ErrRefundNotCaptured       = errors.New("refund exceeds the payments captured for the order")
+)
+
+// Statuses of a return request.
+const (
+	ReturnStatusRequested = "requested"
+	ReturnStatusApproved  = "approved"
+	ReturnStatusRejected  = "rejected"
+)
+
+// Statuses of a refund. A pending refund still has to be paid out; refunds are
+// paid out by crediting the customer's wallet.
+const (
+	RefundStatusPending  = "pending"
+	RefundStatusCredited = "credited"
+)
+
+// Types of wallet transactions.
+const (
+	WalletCredit = "credit"
+	WalletDebit  = "debit"
+)
+
+// ReturnReasons are the reason codes a customer can give for a return.
+var ReturnReasons = map[string]string{
+	"damaged":          "Item arrived damaged",
+	"defective":        "Item is defective or does not work",
+	"wrong_item":       "Wrong item was delivered",
+	"not_as_described": "Item is not as described",
+	"no_longer_needed": "Item is no longer needed",
+	"other":            "Other reason",
+}
 
 type Order struct {
 	gorm.Model
@@ -9,7 +155,12 @@ type Order struct {
 	ShipmentStatus string  `json:"shipment_status" gorm:"default:'pending'"`
 	PaymentStatus  string  `json:"payment_status" gorm:"default:'not paid'"`
 	FinalPrice     float64 `json:"final_price"`
+	Discount       float64 `json:"discount"`
+	CouponCode     string  `json:"coupon_code"`
 	Approval       bool    `json:"approval" gorm:"default:false"`
+	// RestockPending is set when the order is cancelled and cleared by the
+	// one restock that puts its stock back.
+	RestockPending bool `json:"restock_pending" gorm:"default:false"`
 }
 
 type OrderItem struct {
@@ -20,9 +171,52 @@ type OrderItem struct {
 	TotalPrice float64 `json:"total_price"`
 }
 
+// OrderStatusHistory records every status change of an order.
+type OrderStatusHistory struct {
+	ID         uint      `json:"id" gorm:"primaryKey;not null"`
+	OrderID    uint      `json:"order_id" gorm:"index;not null"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status" gorm:"not null"`
+	Actor      string    `json:"actor"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ReturnRequest is a customer's request to return one item of a delivered order.
+type ReturnRequest struct {
+	ID          uint      `json:"id" gorm:"primaryKey;not null"`
+	OrderID     uint      `json:"order_id" gorm:"index;not null"`
+	OrderItemID uint      `json:"order_item_id" gorm:"index;not null"`
+	UserID      int       `json:"user_id" gorm:"index;not null"`
+	ProductID   uint      `json:"product_id"`
+	Quantity    float64   `json:"quantity"`
+	Amount      float64   `json:"amount"`
+	ReasonCode  string    `json:"reason_code" gorm:"not null"`
+	Comment     string    `json:"comment"`
+	Status      string    `json:"status" gorm:"default:'requested'"`
+	ReviewedBy  string    `json:"reviewed_by"`
+	ReviewNote  string    `json:"review_note"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// RestockPending is set when the return is approved and cleared by the
+	// one restock that puts the item back.
+	RestockPending bool `json:"restock_pending" gorm:"default:false"`
+}
+
+// Refund records money owed back to the customer for an order.
+type Refund struct {
+	ID              uint      `json:"id" gorm:"primaryKey;not null"`
+	OrderID         uint      `json:"order_id" gorm:"index;not null"`
+	ReturnRequestID *uint     `json:"return_request_id"`
+	Amount          float64   `json:"amount"`
+	Status          string    `json:"status" gorm:"default:'pending'"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
 type OrderSuccessResponse struct {
-	OrderID        uint   `json:"order_id"`
-	ShipmentStatus string `json:"shipment_status" gorm:"default:delivered"`
+	OrderID        uint           `json:"order_id"`
+	ShipmentStatus string         `json:"shipment_status" gorm:"default:pending"`
+	PaymentStatus  string         `json:"payment_status,omitempty"`
+	Payment        *PaymentIntent `json:"payment,omitempty"`
 }
 type Address struct {
 	Id        int    `json:"id" gorm:"unique;not null"`
@@ -38,3 +232,47 @@ type PaymentMethod struct {
 	ID           uint   `json:"id" gorm:"primarykey;not null"`
 	Payment_Name string `json:"payment_name" gorm:"unique; not null"`
 }
+
+// PaymentIntent is one attempt to collect the price of an order through a
+// payment provider. An order can have several intents when the customer
+// retries a failed payment.
+type PaymentIntent struct {
+	ID            uint      `json:"id" gorm:"primaryKey;not null"`
+	OrderID       uint      `json:"order_id" gorm:"index;not null"`
+	UserID        int       `json:"user_id" gorm:"index;not null"`
+	Method        string    `json:"method" gorm:"not null"`
+	Provider      string    `json:"provider" gorm:"not null"`
+	ProviderRef   string    `json:"provider_ref" gorm:"index"`
+	Amount        float64   `json:"amount"`
+	Status        string    `json:"status" gorm:"default:'pending'"`
+	CheckoutURL   string    `json:"checkout_url"`
+	FailureReason string    `json:"failure_reason"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// PaymentEvent records a webhook event of a provider once it is applied, so
+// an event delivered again changes nothing.
+type PaymentEvent struct {
+	ID              uint      `json:"id" gorm:"primaryKey;not null"`
+	Provider        string    `json:"provider" gorm:"uniqueIndex:idx_payment_event;not null"`
+	EventID         string    `json:"event_id" gorm:"uniqueIndex:idx_payment_event;not null"`
+	PaymentIntentID uint      `json:"payment_intent_id" gorm:"index"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// WalletTransaction is one entry of a customer's wallet ledger. Entries are
+// never changed or removed; the balance is the sum of the credits less the
+// debits. The reference names what the entry is for, such as "refund:12",
+// and no two entries share one, so nothing is credited or debited twice.
+type WalletTransaction struct {
+	ID           uint      `json:"id" gorm:"primaryKey;not null"`
+	UserID       int       `json:"user_id" gorm:"index;not null"`
+	Type         string    `json:"type" gorm:"not null"`
+	Amount       float64   `json:"amount" gorm:"not null"`
+	BalanceAfter float64   `json:"balance_after"`
+	Reason       string    `json:"reason"`
+	Reference    string    `json:"reference" gorm:"uniqueIndex;not null"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{OrderStatusPending, OrderStatusConfirmed, true},
+		{OrderStatusPending, OrderStatusCancelled, true},
+		{OrderStatusPending, OrderStatusFailed, true},
+		{OrderStatusPending, OrderStatusShipped, false},
+		{OrderStatusConfirmed, OrderStatusPacked, true},
+		{OrderStatusConfirmed, OrderStatusCancelled, true},
+		{OrderStatusPacked, OrderStatusShipped, true},
+		{OrderStatusPacked, OrderStatusCancelled, true},
+		{OrderStatusShipped, OrderStatusCancelled, false},
+		{OrderStatusShipped, OrderStatusOutForDelivery, true},
+		{OrderStatusOutForDelivery, OrderStatusDelivered, true},
+		{OrderStatusDelivered, OrderStatusReturned, true},
+		{OrderStatusDelivered, OrderStatusCancelled, false},
+		{OrderStatusCancelled, OrderStatusPending, false},
+		{OrderStatusReturned, OrderStatusDelivered, false},
+		{OrderStatusFailed, OrderStatusPending, false},
+		{OrderStatusPending, OrderStatusPending, false},
+		{"unknown", OrderStatusConfirmed, false},
+		{OrderStatusPending, "unknown", false},
+	}
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -1,8 +1,12 @@
 package models
 
+import "time"
+
 type OrderDetails struct {
 	OrderId        int     `json:"order_id"`
 	FinalPrice     float64 `json:"final_price"`
+	Discount       float64 `json:"discount"`
+	CouponCode     string  `json:"coupon_code"`
 	ShipmentStatus string  `json:"shipment_status"`
 	PaymentStatus  string  `json:"payment_status"`
 }
@@ -46,3 +50,77 @@ type Cart struct {
 	Quantity   float64 `json:"quantity"`
 	TotalPrice float64 `json:"total_price"`
 }
+
+// CartTotal is what an order of the cart costs, after the discount of the
+// coupon applied to the cart.
+type CartTotal struct {
+	TotalPrice float64 `json:"total_price"`
+	Discount   float64 `json:"discount"`
+	FinalPrice float64 `json:"final_price"`
+	CouponCode string  `json:"coupon_code"`
+}
+
+type OrderStatusEvent struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Actor      string    `json:"actor"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type OrderTimeline struct {
+	OrderID        int                `json:"order_id"`
+	ShipmentStatus string             `json:"shipment_status"`
+	Events         []OrderStatusEvent `json:"events"`
+}
+
+type ReturnRequestInput struct {
+	OrderID    int    `json:"order_id"`
+	UserID     int    `json:"user_id"`
+	ProductID  int    `json:"product_id"`
+	ReasonCode string `json:"reason_code"`
+	Comment    string `json:"comment"`
+}
+
+type ReturnReview struct {
+	ReturnID int    `json:"return_id"`
+	Approve  bool   `json:"approve"`
+	Actor    string `json:"actor"`
+	Note     string `json:"note"`
+}
+
+// StockChange tells Product-Service why stock moves, for its inventory ledger.
+type StockChange struct {
+	Reason    string `json:"reason"`
+	Reference string `json:"reference"`
+	Actor     string `json:"actor"`
+}
+
+// ProviderPayment is what a payment provider answers when a payment is
+// opened with it.
+type ProviderPayment struct {
+	Reference   string `json:"reference"`
+	Status      string `json:"status"`
+	CheckoutURL string `json:"checkout_url"`
+	Reason      string `json:"reason"`
+}
+
+// PaymentEvent is a verified webhook event telling the outcome of a payment.
+type PaymentEvent struct {
+	EventID   string  `json:"event_id"`
+	Reference string  `json:"reference"`
+	Status    string  `json:"status"`
+	Amount    float64 `json:"amount"`
+	Reason    string  `json:"reason"`
+}
+
+// PaymentWebhook is a webhook call as the provider sent it.
+type PaymentWebhook struct {
+	Provider  string `json:"provider"`
+	Payload   []byte `json:"payload"`
+	Signature string `json:"signature"`
+}
+
+type Wallet struct {
+	UserID  int     `json:"user_id"`
+	Balance float64 `json:"balance"`
+}
@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"fmt"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"order-service/pkg/payment/interfaces"
+)
+
+// cashOnDelivery takes no money when the order is placed. The payment stays
+// pending until the order is delivered and the cash is collected.
+type cashOnDelivery struct{}
+
+func NewCashOnDelivery() interfaces.Provider {
+	return cashOnDelivery{}
+}
+
+func (cashOnDelivery) Name() string {
+	return "cod"
+}
+
+func (cashOnDelivery) Method() string {
+	return domain.PaymentMethodCOD
+}
+
+func (cashOnDelivery) CreatePayment(intent domain.PaymentIntent) (models.ProviderPayment, error) {
+	return models.ProviderPayment{
+		Reference: fmt.Sprintf("cod_%d", intent.ID),
+		Status:    domain.IntentStatusPending,
+	}, nil
+}
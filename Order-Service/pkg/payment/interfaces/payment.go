@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+)
+
+// Provider collects payments for one payment method.
+type Provider interface {
+	// Name identifies the provider in payment intents and webhook routes.
+	Name() string
+	// Method is the payment method the provider collects.
+	Method() string
+	// CreatePayment opens a payment for the intent. A provider that settles
+	// at once answers with the final status; others answer pending and
+	// report the outcome later.
+	CreatePayment(intent domain.PaymentIntent) (models.ProviderPayment, error)
+}
+
+// WebhookProvider is a provider that reports payment outcomes through signed
+// webhook calls.
+type WebhookProvider interface {
+	Provider
+	// ParseWebhook checks the signature of a webhook call and returns the
+	// event it carries.
+	ParseWebhook(payload []byte, signature string) (models.PaymentEvent, error)
+}
+
+// Simulator is a provider that can produce the webhook call it would send
+// once the customer paid, so payments can be tried without a real gateway.
+type Simulator interface {
+	SimulateWebhook(intent domain.PaymentIntent, status string) (payload []byte, signature string, err error)
+}
+
+// Reverser is a provider that can give back a payment it settled at once
+// when the order service could not record the outcome.
+type Reverser interface {
+	ReversePayment(intent domain.PaymentIntent) error
+}
+
+// Wallet is the ledger wallet payments are taken from.
+type Wallet interface {
+	AddWalletTransaction(entry domain.WalletTransaction) (domain.WalletTransaction, error)
+	ReverseWalletTransaction(reference string) error
+}
@@ -0,0 +1,132 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"order-service/pkg/payment/interfaces"
+)
+
+// Webhook events of the mock provider.
+const (
+	mockEventSucceeded = "payment.succeeded"
+	mockEventFailed    = "payment.failed"
+)
+
+// mockProvider stands in for a card and UPI payment gateway without leaving
+// the process. A payment is opened under a reference derived from its intent,
+// and its outcome arrives as a webhook call signed with an HMAC of the body,
+// which SimulateWebhook produces on demand. The same intent and outcome always
+// give the same event, so simulating a payment twice delivers the event twice.
+type mockProvider struct {
+	secret []byte
+}
+
+type mockEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Reference string  `json:"reference"`
+		Amount    float64 `json:"amount"`
+		Reason    string  `json:"reason,omitempty"`
+	} `json:"data"`
+}
+
+func NewMockProvider(secret []byte) interfaces.WebhookProvider {
+	return &mockProvider{secret: secret}
+}
+
+// WebhookSecret returns the key webhook calls are signed with. Without a
+// configured secret a random one is used, and webhooks signed before the
+// service restarts are rejected.
+func WebhookSecret(configured string) []byte {
+	if configured != "" {
+		return []byte(configured)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatal("cannot generate a payment webhook secret: ", err)
+	}
+	log.Println("PAYMENT_WEBHOOK_SECRET is not set, payment webhooks will not survive a restart")
+	return secret
+}
+
+func (p *mockProvider) Name() string {
+	return "mock"
+}
+
+func (p *mockProvider) Method() string {
+	return domain.PaymentMethodOnline
+}
+
+func (p *mockProvider) CreatePayment(intent domain.PaymentIntent) (models.ProviderPayment, error) {
+	reference := fmt.Sprintf("mock_pay_%d", intent.ID)
+	return models.ProviderPayment{
+		Reference:   reference,
+		Status:      domain.IntentStatusPending,
+		CheckoutURL: "mock://checkout/" + reference,
+	}, nil
+}
+
+func (p *mockProvider) ParseWebhook(payload []byte, signature string) (models.PaymentEvent, error) {
+	if !hmac.Equal([]byte(signature), []byte(p.sign(payload))) {
+		return models.PaymentEvent{}, domain.ErrInvalidWebhookSignature
+	}
+	var event mockEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return models.PaymentEvent{}, fmt.Errorf("%w: %v", domain.ErrInvalidPaymentEvent, err)
+	}
+	result := models.PaymentEvent{
+		EventID:   event.ID,
+		Reference: event.Data.Reference,
+		Amount:    event.Data.Amount,
+		Reason:    event.Data.Reason,
+	}
+	switch event.Type {
+	case mockEventSucceeded:
+		result.Status = domain.IntentStatusSucceeded
+	case mockEventFailed:
+		result.Status = domain.IntentStatusFailed
+	default:
+		return models.PaymentEvent{}, fmt.Errorf("%w: unknown event type %q", domain.ErrInvalidPaymentEvent, event.Type)
+	}
+	if result.EventID == "" || result.Reference == "" {
+		return models.PaymentEvent{}, fmt.Errorf("%w: id and reference are required", domain.ErrInvalidPaymentEvent)
+	}
+	return result, nil
+}
+
+// SimulateWebhook returns the webhook call the provider sends once the
+// customer completed or abandoned the payment of the intent.
+func (p *mockProvider) SimulateWebhook(intent domain.PaymentIntent, status string) ([]byte, string, error) {
+	var event mockEvent
+	switch status {
+	case domain.IntentStatusSucceeded:
+		event.Type = mockEventSucceeded
+	case domain.IntentStatusFailed:
+		event.Type = mockEventFailed
+		event.Data.Reason = "card declined"
+	default:
+		return nil, "", fmt.Errorf("%w: outcome must be %q or %q", domain.ErrInvalidPaymentEvent, domain.IntentStatusSucceeded, domain.IntentStatusFailed)
+	}
+	event.ID = fmt.Sprintf("evt_%s_%s", intent.ProviderRef, status)
+	event.Data.Reference = intent.ProviderRef
+	event.Data.Amount = intent.Amount
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, p.sign(payload), nil
+}
+
+func (p *mockProvider) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
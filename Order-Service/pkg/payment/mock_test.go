@@ -0,0 +1,50 @@
+package payment
+
+import (
+	"bytes"
+	"errors"
+	"order-service/pkg/domain"
+	"testing"
+)
+
+func TestMockWebhookSignature(t *testing.T) {
+	provider := &mockProvider{secret: []byte("secret")}
+	intent := domain.PaymentIntent{ID: 7, ProviderRef: "mock_pay_7", Amount: 499.5}
+	payload, signature, err := provider.SimulateWebhook(intent, domain.IntentStatusSucceeded)
+	if err != nil {
+		t.Fatalf("SimulateWebhook: %v", err)
+	}
+	other := &mockProvider{secret: []byte("other")}
+	_, otherSignature, err := other.SimulateWebhook(intent, domain.IntentStatusSucceeded)
+	if err != nil {
+		t.Fatalf("SimulateWebhook: %v", err)
+	}
+	tampered := bytes.Replace(payload, []byte("499.5"), []byte("0.5"), 1)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		wantErr   error
+	}{
+		{"signed by the provider", payload, signature, nil},
+		{"missing signature", payload, "", domain.ErrInvalidWebhookSignature},
+		{"signed with another secret", payload, otherSignature, domain.ErrInvalidWebhookSignature},
+		{"payload changed after signing", tampered, signature, domain.ErrInvalidWebhookSignature},
+		{"signature of the wrong case", payload, "X" + signature[1:], domain.ErrInvalidWebhookSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := provider.ParseWebhook(tt.payload, tt.signature)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseWebhook error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if event.Reference != intent.ProviderRef || event.Amount != intent.Amount || event.Status != domain.IntentStatusSucceeded {
+				t.Errorf("ParseWebhook = %+v, want a succeeded event for %s of %.2f", event, intent.ProviderRef, intent.Amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"order-service/pkg/payment/interfaces"
+)
+
+// walletProvider pays orders from the customer's wallet balance. The payment
+// settles at once with a debit from the wallet ledger, or fails when the
+// balance does not cover it.
+type walletProvider struct {
+	wallet interfaces.Wallet
+}
+
+func NewWalletProvider(wallet interfaces.Wallet) interfaces.Provider {
+	return &walletProvider{wallet: wallet}
+}
+
+func (p *walletProvider) Name() string {
+	return "wallet"
+}
+
+func (p *walletProvider) Method() string {
+	return domain.PaymentMethodWallet
+}
+
+func (p *walletProvider) CreatePayment(intent domain.PaymentIntent) (models.ProviderPayment, error) {
+	entry, err := p.wallet.AddWalletTransaction(domain.WalletTransaction{
+		UserID:    intent.UserID,
+		Type:      domain.WalletDebit,
+		Amount:    intent.Amount,
+		Reason:    "payment",
+		Reference: walletPaymentReference(intent),
+	})
+	if errors.Is(err, domain.ErrInsufficientBalance) {
+		return models.ProviderPayment{
+			Status: domain.IntentStatusFailed,
+			Reason: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return models.ProviderPayment{}, err
+	}
+	return models.ProviderPayment{
+		Reference: fmt.Sprintf("wallet_%d", entry.ID),
+		Status:    domain.IntentStatusSucceeded,
+	}, nil
+}
+
+// ReversePayment credits back the debit of the intent, if there was one.
+// Reversing a payment twice credits it once.
+func (p *walletProvider) ReversePayment(intent domain.PaymentIntent) error {
+	return p.wallet.ReverseWalletTransaction(walletPaymentReference(intent))
+}
+
+func walletPaymentReference(intent domain.PaymentIntent) string {
+	return fmt.Sprintf("payment:%d", intent.ID)
+}
@@ -19,12 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Cart_AddToCart_FullMethodName            = "/cart.Cart/AddToCart"
-	Cart_GetCart_FullMethodName              = "/cart.Cart/GetCart"
-	Cart_GetAllItemsFromCart_FullMethodName  = "/cart.Cart/GetAllItemsFromCart"
-	Cart_DoesCartExist_FullMethodName        = "/cart.Cart/DoesCartExist"
-	Cart_TotalAmountInCart_FullMethodName    = "/cart.Cart/TotalAmountInCart"
-	Cart_UpdateCartAfterOrder_FullMethodName = "/cart.Cart/UpdateCartAfterOrder"
+	Cart_AddToCart_FullMethodName                   = "/cart.Cart/AddToCart"
+	Cart_GetCart_FullMethodName                     = "/cart.Cart/GetCart"
+	Cart_GetAllItemsFromCart_FullMethodName         = "/cart.Cart/GetAllItemsFromCart"
+	Cart_DoesCartExist_FullMethodName               = "/cart.Cart/DoesCartExist"
+	Cart_TotalAmountInCart_FullMethodName           = "/cart.Cart/TotalAmountInCart"
+	Cart_UpdateCartAfterOrder_FullMethodName        = "/cart.Cart/UpdateCartAfterOrder"
+	Cart_ClearCart_FullMethodName                   = "/cart.Cart/ClearCart"
+	Cart_UpdateCartItemQuantity_FullMethodName      = "/cart.Cart/UpdateCartItemQuantity"
+	Cart_RemoveFromCart_FullMethodName              = "/cart.Cart/RemoveFromCart"
+	Cart_AddCoupon_FullMethodName                   = "/cart.Cart/AddCoupon"
+	Cart_UpdateCoupon_FullMethodName                = "/cart.Cart/UpdateCoupon"
+	Cart_ListCoupons_FullMethodName                 = "/cart.Cart/ListCoupons"
+	Cart_DeleteCoupon_FullMethodName                = "/cart.Cart/DeleteCoupon"
+	Cart_ApplyCoupon_FullMethodName                 = "/cart.Cart/ApplyCoupon"
+	Cart_RemoveCoupon_FullMethodName                = "/cart.Cart/RemoveCoupon"
+	Cart_RedeemCoupon_FullMethodName                = "/cart.Cart/RedeemCoupon"
+	Cart_ReleaseCoupon_FullMethodName               = "/cart.Cart/ReleaseCoupon"
+	Cart_AddToGuestCart_FullMethodName              = "/cart.Cart/AddToGuestCart"
+	Cart_UpdateGuestCartItemQuantity_FullMethodName = "/cart.Cart/UpdateGuestCartItemQuantity"
+	Cart_RemoveFromGuestCart_FullMethodName         = "/cart.Cart/RemoveFromGuestCart"
+	Cart_GetGuestCart_FullMethodName                = "/cart.Cart/GetGuestCart"
+	Cart_MergeGuestCart_FullMethodName              = "/cart.Cart/MergeGuestCart"
+	Cart_AddToWishlist_FullMethodName               = "/cart.Cart/AddToWishlist"
+	Cart_RemoveFromWishlist_FullMethodName          = "/cart.Cart/RemoveFromWishlist"
+	Cart_ListWishlist_FullMethodName                = "/cart.Cart/ListWishlist"
+	Cart_MoveToCart_FullMethodName                  = "/cart.Cart/MoveToCart"
+	Cart_SaveForLater_FullMethodName                = "/cart.Cart/SaveForLater"
 )
 
 // CartClient is the client API for Cart service.
@@ -37,6 +58,27 @@ type CartClient interface {
 	DoesCartExist(ctx context.Context, in *DoesCartExistRequest, opts ...grpc.CallOption) (*DoesCartExistReponse, error)
 	TotalAmountInCart(ctx context.Context, in *TotalAmountInCartRequest, opts ...grpc.CallOption) (*TotalAmountInCartResponse, error)
 	UpdateCartAfterOrder(ctx context.Context, in *UpdateCartAfterOrderRequest, opts ...grpc.CallOption) (*UpdateCartAfterOrderResponse, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
+	UpdateCartItemQuantity(ctx context.Context, in *UpdateCartItemQuantityRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	AddCoupon(ctx context.Context, in *AddCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error)
+	DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error)
+	ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error)
+	ReleaseCoupon(ctx context.Context, in *ReleaseCouponRequest, opts ...grpc.CallOption) (*ReleaseCouponResponse, error)
+	AddToGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	UpdateGuestCartItemQuantity(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	RemoveFromGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	GetGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	MergeGuestCart(ctx context.Context, in *MergeGuestCartRequest, opts ...grpc.CallOption) (*MergeGuestCartResponse, error)
+	AddToWishlist(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*WishlistItemResponse, error)
+	RemoveFromWishlist(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error)
+	ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error)
+	MoveToCart(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	SaveForLater(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*WishlistItemResponse, error)
 }
 
 type cartClient struct {
@@ -107,6 +149,216 @@ func (c *cartClient) UpdateCartAfterOrder(ctx context.Context, in *UpdateCartAft
 	return out, nil
 }
 
+func (c *cartClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearCartResponse)
+	err := c.cc.Invoke(ctx, Cart_ClearCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateCartItemQuantity(ctx context.Context, in *UpdateCartItemQuantityRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_UpdateCartItemQuantity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveFromCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) AddCoupon(ctx context.Context, in *AddCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, Cart_AddCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, Cart_UpdateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCouponsResponse)
+	err := c.cc.Invoke(ctx, Cart_ListCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCouponResponse)
+	err := c.cc.Invoke(ctx, Cart_DeleteCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_ApplyCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCouponResponse)
+	err := c.cc.Invoke(ctx, Cart_RedeemCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ReleaseCoupon(ctx context.Context, in *ReleaseCouponRequest, opts ...grpc.CallOption) (*ReleaseCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseCouponResponse)
+	err := c.cc.Invoke(ctx, Cart_ReleaseCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) AddToGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_AddToGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateGuestCartItemQuantity(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_UpdateGuestCartItemQuantity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveFromGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveFromGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) GetGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_GetGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MergeGuestCart(ctx context.Context, in *MergeGuestCartRequest, opts ...grpc.CallOption) (*MergeGuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGuestCartResponse)
+	err := c.cc.Invoke(ctx, Cart_MergeGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) AddToWishlist(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*WishlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistItemResponse)
+	err := c.cc.Invoke(ctx, Cart_AddToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveFromWishlist(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveFromWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListWishlist(ctx context.Context, in *ListWishlistRequest, opts ...grpc.CallOption) (*ListWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_ListWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MoveToCart(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, Cart_MoveToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) SaveForLater(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*WishlistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistItemResponse)
+	err := c.cc.Invoke(ctx, Cart_SaveForLater_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServer is the server API for Cart service.
 // All implementations must embed UnimplementedCartServer
 // for forward compatibility.
@@ -117,6 +369,27 @@ type CartServer interface {
 	DoesCartExist(context.Context, *DoesCartExistRequest) (*DoesCartExistReponse, error)
 	TotalAmountInCart(context.Context, *TotalAmountInCartRequest) (*TotalAmountInCartResponse, error)
 	UpdateCartAfterOrder(context.Context, *UpdateCartAfterOrderRequest) (*UpdateCartAfterOrderResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
+	UpdateCartItemQuantity(context.Context, *UpdateCartItemQuantityRequest) (*GetCartResponse, error)
+	RemoveFromCart(context.Context, *RemoveFromCartRequest) (*GetCartResponse, error)
+	AddCoupon(context.Context, *AddCouponRequest) (*CouponResponse, error)
+	UpdateCoupon(context.Context, *UpdateCouponRequest) (*CouponResponse, error)
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error)
+	DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error)
+	ApplyCoupon(context.Context, *ApplyCouponRequest) (*GetCartResponse, error)
+	RemoveCoupon(context.Context, *RemoveCouponRequest) (*GetCartResponse, error)
+	RedeemCoupon(context.Context, *RedeemCouponRequest) (*RedeemCouponResponse, error)
+	ReleaseCoupon(context.Context, *ReleaseCouponRequest) (*ReleaseCouponResponse, error)
+	AddToGuestCart(context.Context, *GuestCartRequest) (*GetCartResponse, error)
+	UpdateGuestCartItemQuantity(context.Context, *GuestCartRequest) (*GetCartResponse, error)
+	RemoveFromGuestCart(context.Context, *GuestCartRequest) (*GetCartResponse, error)
+	GetGuestCart(context.Context, *GuestCartRequest) (*GetCartResponse, error)
+	MergeGuestCart(context.Context, *MergeGuestCartRequest) (*MergeGuestCartResponse, error)
+	AddToWishlist(context.Context, *WishlistRequest) (*WishlistItemResponse, error)
+	RemoveFromWishlist(context.Context, *WishlistRequest) (*RemoveFromWishlistResponse, error)
+	ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error)
+	MoveToCart(context.Context, *WishlistRequest) (*GetCartResponse, error)
+	SaveForLater(context.Context, *WishlistRequest) (*WishlistItemResponse, error)
 	mustEmbedUnimplementedCartServer()
 }
 
@@ -145,6 +418,69 @@ func (UnimplementedCartServer) TotalAmountInCart(context.Context, *TotalAmountIn
 func (UnimplementedCartServer) UpdateCartAfterOrder(context.Context, *UpdateCartAfterOrderRequest) (*UpdateCartAfterOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartAfterOrder not implemented")
 }
+func (UnimplementedCartServer) ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
+func (UnimplementedCartServer) UpdateCartItemQuantity(context.Context, *UpdateCartItemQuantityRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartItemQuantity not implemented")
+}
+func (UnimplementedCartServer) RemoveFromCart(context.Context, *RemoveFromCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCart not implemented")
+}
+func (UnimplementedCartServer) AddCoupon(context.Context, *AddCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCoupon not implemented")
+}
+func (UnimplementedCartServer) UpdateCoupon(context.Context, *UpdateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoupon not implemented")
+}
+func (UnimplementedCartServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedCartServer) DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoupon not implemented")
+}
+func (UnimplementedCartServer) ApplyCoupon(context.Context, *ApplyCouponRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCoupon not implemented")
+}
+func (UnimplementedCartServer) RemoveCoupon(context.Context, *RemoveCouponRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoupon not implemented")
+}
+func (UnimplementedCartServer) RedeemCoupon(context.Context, *RedeemCouponRequest) (*RedeemCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedCartServer) ReleaseCoupon(context.Context, *ReleaseCouponRequest) (*ReleaseCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseCoupon not implemented")
+}
+func (UnimplementedCartServer) AddToGuestCart(context.Context, *GuestCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToGuestCart not implemented")
+}
+func (UnimplementedCartServer) UpdateGuestCartItemQuantity(context.Context, *GuestCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuestCartItemQuantity not implemented")
+}
+func (UnimplementedCartServer) RemoveFromGuestCart(context.Context, *GuestCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromGuestCart not implemented")
+}
+func (UnimplementedCartServer) GetGuestCart(context.Context, *GuestCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestCart not implemented")
+}
+func (UnimplementedCartServer) MergeGuestCart(context.Context, *MergeGuestCartRequest) (*MergeGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuestCart not implemented")
+}
+func (UnimplementedCartServer) AddToWishlist(context.Context, *WishlistRequest) (*WishlistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWishlist not implemented")
+}
+func (UnimplementedCartServer) RemoveFromWishlist(context.Context, *WishlistRequest) (*RemoveFromWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWishlist not implemented")
+}
+func (UnimplementedCartServer) ListWishlist(context.Context, *ListWishlistRequest) (*ListWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlist not implemented")
+}
+func (UnimplementedCartServer) MoveToCart(context.Context, *WishlistRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedCartServer) SaveForLater(context.Context, *WishlistRequest) (*WishlistItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveForLater not implemented")
+}
 func (UnimplementedCartServer) mustEmbedUnimplementedCartServer() {}
 func (UnimplementedCartServer) testEmbeddedByValue()              {}
 
@@ -274,6 +610,384 @@ func _Cart_UpdateCartAfterOrder_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateCartItemQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCartItemQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateCartItemQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateCartItemQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateCartItemQuantity(ctx, req.(*UpdateCartItemQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveFromCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveFromCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveFromCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveFromCart(ctx, req.(*RemoveFromCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_AddCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AddCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AddCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AddCoupon(ctx, req.(*AddCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).DeleteCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_DeleteCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ApplyCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ApplyCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ApplyCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ApplyCoupon(ctx, req.(*ApplyCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveCoupon(ctx, req.(*RemoveCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RedeemCoupon(ctx, req.(*RedeemCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ReleaseCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ReleaseCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ReleaseCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ReleaseCoupon(ctx, req.(*ReleaseCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_AddToGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AddToGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AddToGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AddToGuestCart(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateGuestCartItemQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateGuestCartItemQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateGuestCartItemQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateGuestCartItemQuantity(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveFromGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveFromGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveFromGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveFromGuestCart(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_GetGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).GetGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_GetGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).GetGuestCart(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MergeGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MergeGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MergeGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MergeGuestCart(ctx, req.(*MergeGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_AddToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AddToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AddToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AddToWishlist(ctx, req.(*WishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveFromWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveFromWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveFromWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveFromWishlist(ctx, req.(*WishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListWishlist(ctx, req.(*ListWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MoveToCart(ctx, req.(*WishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_SaveForLater_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SaveForLater(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SaveForLater_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SaveForLater(ctx, req.(*WishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cart_ServiceDesc is the grpc.ServiceDesc for Cart service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +1019,90 @@ var Cart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCartAfterOrder",
 			Handler:    _Cart_UpdateCartAfterOrder_Handler,
 		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _Cart_ClearCart_Handler,
+		},
+		{
+			MethodName: "UpdateCartItemQuantity",
+			Handler:    _Cart_UpdateCartItemQuantity_Handler,
+		},
+		{
+			MethodName: "RemoveFromCart",
+			Handler:    _Cart_RemoveFromCart_Handler,
+		},
+		{
+			MethodName: "AddCoupon",
+			Handler:    _Cart_AddCoupon_Handler,
+		},
+		{
+			MethodName: "UpdateCoupon",
+			Handler:    _Cart_UpdateCoupon_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _Cart_ListCoupons_Handler,
+		},
+		{
+			MethodName: "DeleteCoupon",
+			Handler:    _Cart_DeleteCoupon_Handler,
+		},
+		{
+			MethodName: "ApplyCoupon",
+			Handler:    _Cart_ApplyCoupon_Handler,
+		},
+		{
+			MethodName: "RemoveCoupon",
+			Handler:    _Cart_RemoveCoupon_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _Cart_RedeemCoupon_Handler,
+		},
+		{
+			MethodName: "ReleaseCoupon",
+			Handler:    _Cart_ReleaseCoupon_Handler,
+		},
+		{
+			MethodName: "AddToGuestCart",
+			Handler:    _Cart_AddToGuestCart_Handler,
+		},
+		{
+			MethodName: "UpdateGuestCartItemQuantity",
+			Handler:    _Cart_UpdateGuestCartItemQuantity_Handler,
+		},
+		{
+			MethodName: "RemoveFromGuestCart",
+			Handler:    _Cart_RemoveFromGuestCart_Handler,
+		},
+		{
+			MethodName: "GetGuestCart",
+			Handler:    _Cart_GetGuestCart_Handler,
+		},
+		{
+			MethodName: "MergeGuestCart",
+			Handler:    _Cart_MergeGuestCart_Handler,
+		},
+		{
+			MethodName: "AddToWishlist",
+			Handler:    _Cart_AddToWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWishlist",
+			Handler:    _Cart_RemoveFromWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlist",
+			Handler:    _Cart_ListWishlist_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _Cart_MoveToCart_Handler,
+		},
+		{
+			MethodName: "SaveForLater",
+			Handler:    _Cart_SaveForLater_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/cart/cart.proto",
@@ -9,6 +9,7 @@ package product
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -122,23 +123,318 @@ func (x *CheckProductResponse) GetError() string {
 	return ""
 }
 
+type LaptopSpecs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand            string  `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	CPU              string  `protobuf:"bytes,2,opt,name=CPU,proto3" json:"CPU,omitempty"`
+	RAMGB            int64   `protobuf:"varint,3,opt,name=RAMGB,proto3" json:"RAMGB,omitempty"`
+	StorageType      string  `protobuf:"bytes,4,opt,name=StorageType,proto3" json:"StorageType,omitempty"`
+	StorageGB        int64   `protobuf:"varint,5,opt,name=StorageGB,proto3" json:"StorageGB,omitempty"`
+	GPU              string  `protobuf:"bytes,6,opt,name=GPU,proto3" json:"GPU,omitempty"`
+	ScreenResolution string  `protobuf:"bytes,7,opt,name=ScreenResolution,proto3" json:"ScreenResolution,omitempty"`
+	WeightKg         float32 `protobuf:"fixed32,8,opt,name=WeightKg,proto3" json:"WeightKg,omitempty"`
+	BatteryWh        float32 `protobuf:"fixed32,9,opt,name=BatteryWh,proto3" json:"BatteryWh,omitempty"`
+	OS               string  `protobuf:"bytes,10,opt,name=OS,proto3" json:"OS,omitempty"`
+}
+
+func (x *LaptopSpecs) Reset() {
+	*x = LaptopSpecs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LaptopSpecs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaptopSpecs) ProtoMessage() {}
+
+func (x *LaptopSpecs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaptopSpecs.ProtoReflect.Descriptor instead.
+func (*LaptopSpecs) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LaptopSpecs) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *LaptopSpecs) GetCPU() string {
+	if x != nil {
+		return x.CPU
+	}
+	return ""
+}
+
+func (x *LaptopSpecs) GetRAMGB() int64 {
+	if x != nil {
+		return x.RAMGB
+	}
+	return 0
+}
+
+func (x *LaptopSpecs) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *LaptopSpecs) GetStorageGB() int64 {
+	if x != nil {
+		return x.StorageGB
+	}
+	return 0
+}
+
+func (x *LaptopSpecs) GetGPU() string {
+	if x != nil {
+		return x.GPU
+	}
+	return ""
+}
+
+func (x *LaptopSpecs) GetScreenResolution() string {
+	if x != nil {
+		return x.ScreenResolution
+	}
+	return ""
+}
+
+func (x *LaptopSpecs) GetWeightKg() float32 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *LaptopSpecs) GetBatteryWh() float32 {
+	if x != nil {
+		return x.BatteryWh
+	}
+	return 0
+}
+
+func (x *LaptopSpecs) GetOS() string {
+	if x != nil {
+		return x.OS
+	}
+	return ""
+}
+
+type ProductFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand            string  `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	CPU              string  `protobuf:"bytes,2,opt,name=CPU,proto3" json:"CPU,omitempty"`
+	MinRAMGB         int64   `protobuf:"varint,3,opt,name=MinRAMGB,proto3" json:"MinRAMGB,omitempty"`
+	MaxRAMGB         int64   `protobuf:"varint,4,opt,name=MaxRAMGB,proto3" json:"MaxRAMGB,omitempty"`
+	StorageType      string  `protobuf:"bytes,5,opt,name=StorageType,proto3" json:"StorageType,omitempty"`
+	MinStorageGB     int64   `protobuf:"varint,6,opt,name=MinStorageGB,proto3" json:"MinStorageGB,omitempty"`
+	GPU              string  `protobuf:"bytes,7,opt,name=GPU,proto3" json:"GPU,omitempty"`
+	ScreenResolution string  `protobuf:"bytes,8,opt,name=ScreenResolution,proto3" json:"ScreenResolution,omitempty"`
+	MaxWeightKg      float32 `protobuf:"fixed32,9,opt,name=MaxWeightKg,proto3" json:"MaxWeightKg,omitempty"`
+	MinBatteryWh     float32 `protobuf:"fixed32,10,opt,name=MinBatteryWh,proto3" json:"MinBatteryWh,omitempty"`
+	OS               string  `protobuf:"bytes,11,opt,name=OS,proto3" json:"OS,omitempty"`
+	Query            string  `protobuf:"bytes,12,opt,name=Query,proto3" json:"Query,omitempty"`
+	CategoryID       int64   `protobuf:"varint,13,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	MinPrice         float32 `protobuf:"fixed32,14,opt,name=MinPrice,proto3" json:"MinPrice,omitempty"`
+	MaxPrice         float32 `protobuf:"fixed32,15,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
+	InStock          bool    `protobuf:"varint,16,opt,name=InStock,proto3" json:"InStock,omitempty"`
+	Status           string  `protobuf:"bytes,17,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *ProductFilter) Reset() {
+	*x = ProductFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFilter) ProtoMessage() {}
+
+func (x *ProductFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFilter.ProtoReflect.Descriptor instead.
+func (*ProductFilter) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductFilter) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetCPU() string {
+	if x != nil {
+		return x.CPU
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetMinRAMGB() int64 {
+	if x != nil {
+		return x.MinRAMGB
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMaxRAMGB() int64 {
+	if x != nil {
+		return x.MaxRAMGB
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetMinStorageGB() int64 {
+	if x != nil {
+		return x.MinStorageGB
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetGPU() string {
+	if x != nil {
+		return x.GPU
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetScreenResolution() string {
+	if x != nil {
+		return x.ScreenResolution
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetMaxWeightKg() float32 {
+	if x != nil {
+		return x.MaxWeightKg
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMinBatteryWh() float32 {
+	if x != nil {
+		return x.MinBatteryWh
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetOS() string {
+	if x != nil {
+		return x.OS
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetCategoryID() int64 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMinPrice() float32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetMaxPrice() float32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ProductFilter) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ProductFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type AddProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string  `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description string  `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	CategoryID  int64   `protobuf:"varint,3,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
-	Size        int64   `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
-	Stock       int64   `protobuf:"varint,5,opt,name=Stock,proto3" json:"Stock,omitempty"`
-	Price       float32 `protobuf:"fixed32,6,opt,name=Price,proto3" json:"Price,omitempty"`
+	Name        string       `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	CategoryID  int64        `protobuf:"varint,3,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	Size        int64        `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	Stock       int64        `protobuf:"varint,5,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	Price       float32      `protobuf:"fixed32,6,opt,name=Price,proto3" json:"Price,omitempty"`
+	Specs       *LaptopSpecs `protobuf:"bytes,7,opt,name=Specs,proto3" json:"Specs,omitempty"`
 }
 
 func (x *AddProductRequest) Reset() {
 	*x = AddProductRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[2]
+		mi := &file_pkg_pb_product_product_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -151,7 +447,7 @@ func (x *AddProductRequest) String() string {
 func (*AddProductRequest) ProtoMessage() {}
 
 func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[2]
+	mi := &file_pkg_pb_product_product_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +460,7 @@ func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
 func (*AddProductRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{2}
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AddProductRequest) GetName() string {
@@ -209,25 +505,33 @@ func (x *AddProductRequest) GetPrice() float32 {
 	return 0
 }
 
+func (x *AddProductRequest) GetSpecs() *LaptopSpecs {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
 type AddProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name        string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description string  `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	CategoryID  int64   `protobuf:"varint,4,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
-	Size        int64   `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
-	Stock       int64   `protobuf:"varint,6,opt,name=Stock,proto3" json:"Stock,omitempty"`
-	Price       float32 `protobuf:"fixed32,7,opt,name=Price,proto3" json:"Price,omitempty"`
-	Error       string  `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+	ID          int64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string       `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	CategoryID  int64        `protobuf:"varint,4,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	Size        int64        `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	Stock       int64        `protobuf:"varint,6,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	Price       float32      `protobuf:"fixed32,7,opt,name=Price,proto3" json:"Price,omitempty"`
+	Error       string       `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+	Specs       *LaptopSpecs `protobuf:"bytes,9,opt,name=Specs,proto3" json:"Specs,omitempty"`
 }
 
 func (x *AddProductResponse) Reset() {
 	*x = AddProductResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[3]
+		mi := &file_pkg_pb_product_product_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -240,7 +544,7 @@ func (x *AddProductResponse) String() string {
 func (*AddProductResponse) ProtoMessage() {}
 
 func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[3]
+	mi := &file_pkg_pb_product_product_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +557,7 @@ func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
 func (*AddProductResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{3}
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AddProductResponse) GetID() int64 {
@@ -312,19 +616,28 @@ func (x *AddProductResponse) GetError() string {
 	return ""
 }
 
+func (x *AddProductResponse) GetSpecs() *LaptopSpecs {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
 type ListProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Page   int64          `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Count  int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Filter *ProductFilter `protobuf:"bytes,3,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	Sort   string         `protobuf:"bytes,4,opt,name=Sort,proto3" json:"Sort,omitempty"`
 }
 
 func (x *ListProductRequest) Reset() {
 	*x = ListProductRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[4]
+		mi := &file_pkg_pb_product_product_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +650,7 @@ func (x *ListProductRequest) String() string {
 func (*ListProductRequest) ProtoMessage() {}
 
 func (x *ListProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[4]
+	mi := &file_pkg_pb_product_product_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +663,7 @@ func (x *ListProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductRequest.ProtoReflect.Descriptor instead.
 func (*ListProductRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{4}
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListProductRequest) GetPage() int64 {
@@ -367,26 +680,44 @@ func (x *ListProductRequest) GetCount() int64 {
 	return 0
 }
 
+func (x *ListProductRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListProductRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type ProductDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name          string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string  `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	CategoryID    int64   `protobuf:"varint,4,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
-	Size          int64   `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
-	Stock         int64   `protobuf:"varint,6,opt,name=Stock,proto3" json:"Stock,omitempty"`
-	Price         float32 `protobuf:"fixed32,7,opt,name=Price,proto3" json:"Price,omitempty"`
-	ProductStatus string  `protobuf:"bytes,8,opt,name=ProductStatus,proto3" json:"ProductStatus,omitempty"`
-	Error         string  `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	ID            int64           `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string          `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string          `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	CategoryID    int64           `protobuf:"varint,4,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	Size          int64           `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	Stock         int64           `protobuf:"varint,6,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	Price         float32         `protobuf:"fixed32,7,opt,name=Price,proto3" json:"Price,omitempty"`
+	ProductStatus string          `protobuf:"bytes,8,opt,name=ProductStatus,proto3" json:"ProductStatus,omitempty"`
+	Error         string          `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	Specs         *LaptopSpecs    `protobuf:"bytes,10,opt,name=Specs,proto3" json:"Specs,omitempty"`
+	Status        string          `protobuf:"bytes,11,opt,name=Status,proto3" json:"Status,omitempty"`
+	DeletedAt     string          `protobuf:"bytes,12,opt,name=DeletedAt,proto3" json:"DeletedAt,omitempty"`
+	Images        []*ProductImage `protobuf:"bytes,13,rep,name=Images,proto3" json:"Images,omitempty"`
 }
 
 func (x *ProductDetails) Reset() {
 	*x = ProductDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[5]
+		mi := &file_pkg_pb_product_product_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +730,7 @@ func (x *ProductDetails) String() string {
 func (*ProductDetails) ProtoMessage() {}
 
 func (x *ProductDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[5]
+	mi := &file_pkg_pb_product_product_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +743,7 @@ func (x *ProductDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductDetails.ProtoReflect.Descriptor instead.
 func (*ProductDetails) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{5}
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ProductDetails) GetID() int64 {
@@ -478,79 +809,64 @@ func (x *ProductDetails) GetError() string {
 	return ""
 }
 
-type ListProductResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ProductDetails) GetSpecs() *LaptopSpecs {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
 
-	Details []*ProductDetails `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty"`
+func (x *ProductDetails) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *ListProductResponse) Reset() {
-	*x = ListProductResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListProductResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListProductResponse) ProtoMessage() {}
-
-func (x *ListProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ProductDetails) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProductResponse.ProtoReflect.Descriptor instead.
-func (*ListProductResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{6}
+	return ""
 }
 
-func (x *ListProductResponse) GetDetails() []*ProductDetails {
+func (x *ProductDetails) GetImages() []*ProductImage {
 	if x != nil {
-		return x.Details
+		return x.Images
 	}
 	return nil
 }
 
-type UpdateProductRequest struct {
+type ProductImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID    int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Stock int64 `protobuf:"varint,2,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	ID           int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProductID    int64  `protobuf:"varint,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	URL          string `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	ThumbnailURL string `protobuf:"bytes,4,opt,name=ThumbnailURL,proto3" json:"ThumbnailURL,omitempty"`
+	Position     int64  `protobuf:"varint,5,opt,name=Position,proto3" json:"Position,omitempty"`
+	IsPrimary    bool   `protobuf:"varint,6,opt,name=IsPrimary,proto3" json:"IsPrimary,omitempty"`
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[7]
+		mi := &file_pkg_pb_product_product_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ProductImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ProductImage) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[7]
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,98 +877,65 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateProductRequest) GetID() int64 {
+func (x *ProductImage) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetStock() int64 {
+func (x *ProductImage) GetProductID() int64 {
 	if x != nil {
-		return x.Stock
+		return x.ProductID
 	}
 	return 0
 }
 
-type UpdateProductResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID    int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Stock int64  `protobuf:"varint,2,opt,name=Stock,proto3" json:"Stock,omitempty"`
-	Error string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
-}
-
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_pb_product_product_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UpdateProductResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateProductResponse) ProtoMessage() {}
-
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_product_product_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ProductImage) GetURL() string {
+	if x != nil {
+		return x.URL
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{8}
+	return ""
 }
 
-func (x *UpdateProductResponse) GetID() int64 {
+func (x *ProductImage) GetThumbnailURL() string {
 	if x != nil {
-		return x.ID
+		return x.ThumbnailURL
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProductResponse) GetStock() int64 {
+func (x *ProductImage) GetPosition() int64 {
 	if x != nil {
-		return x.Stock
+		return x.Position
 	}
 	return 0
 }
 
-func (x *UpdateProductResponse) GetError() string {
+func (x *ProductImage) GetIsPrimary() bool {
 	if x != nil {
-		return x.Error
+		return x.IsPrimary
 	}
-	return ""
+	return false
 }
 
-type DeleteProductRequest struct {
+type UploadProductImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProductID int64  `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Primary   bool   `protobuf:"varint,3,opt,name=Primary,proto3" json:"Primary,omitempty"`
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
+func (x *UploadProductImageRequest) Reset() {
+	*x = UploadProductImageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,13 +943,13 @@ func (x *DeleteProductRequest) Reset() {
 	}
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *UploadProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*UploadProductImageRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadProductImageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,28 +961,43 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadProductImageRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteProductRequest) GetID() int64 {
+func (x *UploadProductImageRequest) GetProductID() int64 {
 	if x != nil {
-		return x.ID
+		return x.ProductID
 	}
 	return 0
 }
 
-type DeleteProductResponse struct {
+func (x *UploadProductImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadProductImageRequest) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+type ProductImageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	Image *ProductImage `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty"`
+	Error string        `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
+func (x *ProductImageResponse) Reset() {
+	*x = ProductImageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,13 +1005,13 @@ func (x *DeleteProductResponse) Reset() {
 	}
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ProductImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ProductImageResponse) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+func (x *ProductImageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,28 +1023,36 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductImageResponse.ProtoReflect.Descriptor instead.
+func (*ProductImageResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteProductResponse) GetError() string {
+func (x *ProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ProductImageResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type GetQuantityFromProductIDRequest struct {
+type ProductImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	ImageID   int64 `protobuf:"varint,2,opt,name=ImageID,proto3" json:"ImageID,omitempty"`
 }
 
-func (x *GetQuantityFromProductIDRequest) Reset() {
-	*x = GetQuantityFromProductIDRequest{}
+func (x *ProductImageRequest) Reset() {
+	*x = ProductImageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,13 +1060,13 @@ func (x *GetQuantityFromProductIDRequest) Reset() {
 	}
 }
 
-func (x *GetQuantityFromProductIDRequest) String() string {
+func (x *ProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuantityFromProductIDRequest) ProtoMessage() {}
+func (*ProductImageRequest) ProtoMessage() {}
 
-func (x *GetQuantityFromProductIDRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductImageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -772,29 +1078,36 @@ func (x *GetQuantityFromProductIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuantityFromProductIDRequest.ProtoReflect.Descriptor instead.
-func (*GetQuantityFromProductIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductImageRequest.ProtoReflect.Descriptor instead.
+func (*ProductImageRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetQuantityFromProductIDRequest) GetID() int64 {
+func (x *ProductImageRequest) GetProductID() int64 {
 	if x != nil {
-		return x.ID
+		return x.ProductID
 	}
 	return 0
 }
 
-type GetQuantityFromProductIDResponse struct {
+func (x *ProductImageRequest) GetImageID() int64 {
+	if x != nil {
+		return x.ImageID
+	}
+	return 0
+}
+
+type ReorderProductImagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Quantity int64  `protobuf:"varint,1,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
-	Error    string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	ProductID int64   `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	ImageIDs  []int64 `protobuf:"varint,2,rep,packed,name=ImageIDs,proto3" json:"ImageIDs,omitempty"`
 }
 
-func (x *GetQuantityFromProductIDResponse) Reset() {
-	*x = GetQuantityFromProductIDResponse{}
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -802,13 +1115,13 @@ func (x *GetQuantityFromProductIDResponse) Reset() {
 	}
 }
 
-func (x *GetQuantityFromProductIDResponse) String() string {
+func (x *ReorderProductImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuantityFromProductIDResponse) ProtoMessage() {}
+func (*ReorderProductImagesRequest) ProtoMessage() {}
 
-func (x *GetQuantityFromProductIDResponse) ProtoReflect() protoreflect.Message {
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -820,35 +1133,36 @@ func (x *GetQuantityFromProductIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuantityFromProductIDResponse.ProtoReflect.Descriptor instead.
-func (*GetQuantityFromProductIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetQuantityFromProductIDResponse) GetQuantity() int64 {
+func (x *ReorderProductImagesRequest) GetProductID() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.ProductID
 	}
 	return 0
 }
 
-func (x *GetQuantityFromProductIDResponse) GetError() string {
+func (x *ReorderProductImagesRequest) GetImageIDs() []int64 {
 	if x != nil {
-		return x.Error
+		return x.ImageIDs
 	}
-	return ""
+	return nil
 }
 
-type GetPriceofProductFromIDRequest struct {
+type ProductImagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Images []*ProductImage `protobuf:"bytes,1,rep,name=Images,proto3" json:"Images,omitempty"`
+	Error  string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *GetPriceofProductFromIDRequest) Reset() {
-	*x = GetPriceofProductFromIDRequest{}
+func (x *ProductImagesResponse) Reset() {
+	*x = ProductImagesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,13 +1170,13 @@ func (x *GetPriceofProductFromIDRequest) Reset() {
 	}
 }
 
-func (x *GetPriceofProductFromIDRequest) String() string {
+func (x *ProductImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceofProductFromIDRequest) ProtoMessage() {}
+func (*ProductImagesResponse) ProtoMessage() {}
 
-func (x *GetPriceofProductFromIDRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductImagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,29 +1188,35 @@ func (x *GetPriceofProductFromIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceofProductFromIDRequest.ProtoReflect.Descriptor instead.
-func (*GetPriceofProductFromIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ProductImagesResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetPriceofProductFromIDRequest) GetID() int64 {
+func (x *ProductImagesResponse) GetImages() []*ProductImage {
 	if x != nil {
-		return x.ID
+		return x.Images
 	}
-	return 0
+	return nil
 }
 
-type GetPriceofProductFromIDResponse struct {
+func (x *ProductImagesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProductIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Price int64  `protobuf:"varint,1,opt,name=Price,proto3" json:"Price,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 }
 
-func (x *GetPriceofProductFromIDResponse) Reset() {
-	*x = GetPriceofProductFromIDResponse{}
+func (x *ProductIDRequest) Reset() {
+	*x = ProductIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -904,13 +1224,13 @@ func (x *GetPriceofProductFromIDResponse) Reset() {
 	}
 }
 
-func (x *GetPriceofProductFromIDResponse) String() string {
+func (x *ProductIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceofProductFromIDResponse) ProtoMessage() {}
+func (*ProductIDRequest) ProtoMessage() {}
 
-func (x *GetPriceofProductFromIDResponse) ProtoReflect() protoreflect.Message {
+func (x *ProductIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -922,36 +1242,29 @@ func (x *GetPriceofProductFromIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceofProductFromIDResponse.ProtoReflect.Descriptor instead.
-func (*GetPriceofProductFromIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductIDRequest.ProtoReflect.Descriptor instead.
+func (*ProductIDRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetPriceofProductFromIDResponse) GetPrice() int64 {
+func (x *ProductIDRequest) GetID() int64 {
 	if x != nil {
-		return x.Price
+		return x.ID
 	}
 	return 0
 }
 
-func (x *GetPriceofProductFromIDResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-type ProductStockMinusRequest struct {
+type ProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID    int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Stock int64 `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	Product *ProductDetails `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	Error   string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *ProductStockMinusRequest) Reset() {
-	*x = ProductStockMinusRequest{}
+func (x *ProductResponse) Reset() {
+	*x = ProductResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,13 +1272,13 @@ func (x *ProductStockMinusRequest) Reset() {
 	}
 }
 
-func (x *ProductStockMinusRequest) String() string {
+func (x *ProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductStockMinusRequest) ProtoMessage() {}
+func (*ProductResponse) ProtoMessage() {}
 
-func (x *ProductStockMinusRequest) ProtoReflect() protoreflect.Message {
+func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,35 +1290,36 @@ func (x *ProductStockMinusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductStockMinusRequest.ProtoReflect.Descriptor instead.
-func (*ProductStockMinusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
+func (*ProductResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ProductStockMinusRequest) GetID() int64 {
+func (x *ProductResponse) GetProduct() *ProductDetails {
 	if x != nil {
-		return x.ID
+		return x.Product
 	}
-	return 0
+	return nil
 }
 
-func (x *ProductStockMinusRequest) GetStock() int64 {
+func (x *ProductResponse) GetError() string {
 	if x != nil {
-		return x.Stock
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type ProductStockMinusReponse struct {
+type FacetValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
 }
 
-func (x *ProductStockMinusReponse) Reset() {
-	*x = ProductStockMinusReponse{}
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_pb_product_product_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,13 +1327,13 @@ func (x *ProductStockMinusReponse) Reset() {
 	}
 }
 
-func (x *ProductStockMinusReponse) String() string {
+func (x *FacetValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductStockMinusReponse) ProtoMessage() {}
+func (*FacetValue) ProtoMessage() {}
 
-func (x *ProductStockMinusReponse) ProtoReflect() protoreflect.Message {
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_pb_product_product_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,232 +1345,4967 @@ func (x *ProductStockMinusReponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductStockMinusReponse.ProtoReflect.Descriptor instead.
-func (*ProductStockMinusReponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
 	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ProductStockMinusReponse) GetError() string {
+func (x *FacetValue) GetValue() string {
 	if x != nil {
-		return x.Error
+		return x.Value
 	}
 	return ""
 }
 
-var File_pkg_pb_product_product_proto protoreflect.FileDescriptor
+func (x *FacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
 
-var file_pkg_pb_product_product_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x33, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x14,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9,
-	0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53,
-	0x74, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x41,
-	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53,
-	0x74, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63,
-	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3e, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf2, 0x01,
-	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74,
-	0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b,
-	0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3c, 0x0a, 0x14,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x53, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x20, 0x47, 0x65, 0x74,
-	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
-	0x44, 0x22, 0x4d, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x40, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b,
-	0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x6f,
-	0x63, 0x6b, 0x22, 0x30, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f,
-	0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x32, 0xd3, 0x05, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x12, 0x47, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f,
-	0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x51,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72,
-	0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
-	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69,
-	0x6e, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69, 0x6e,
-	0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10, 0x2e, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string        `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Values []*FacetValue `protobuf:"bytes,2,rep,name=Values,proto3" json:"Values,omitempty"`
 }
 
-var (
-	file_pkg_pb_product_product_proto_rawDescOnce sync.Once
-	file_pkg_pb_product_product_proto_rawDescData = file_pkg_pb_product_product_proto_rawDesc
-)
+func (x *Facet) Reset() {
+	*x = Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pkg_pb_product_product_proto_rawDescGZIP() []byte {
-	file_pkg_pb_product_product_proto_rawDescOnce.Do(func() {
-		file_pkg_pb_product_product_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_product_product_proto_rawDescData)
-	})
-	return file_pkg_pb_product_product_proto_rawDescData
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pkg_pb_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_pkg_pb_product_product_proto_goTypes = []any{
-	(*CheckProductRequest)(nil),              // 0: product.CheckProductRequest
-	(*CheckProductResponse)(nil),             // 1: product.CheckProductResponse
-	(*AddProductRequest)(nil),                // 2: product.AddProductRequest
-	(*AddProductResponse)(nil),               // 3: product.AddProductResponse
-	(*ListProductRequest)(nil),               // 4: product.ListProductRequest
-	(*ProductDetails)(nil),                   // 5: product.ProductDetails
-	(*ListProductResponse)(nil),              // 6: product.ListProductResponse
-	(*UpdateProductRequest)(nil),             // 7: product.UpdateProductRequest
-	(*UpdateProductResponse)(nil),            // 8: product.UpdateProductResponse
-	(*DeleteProductRequest)(nil),             // 9: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),            // 10: product.DeleteProductResponse
-	(*GetQuantityFromProductIDRequest)(nil),  // 11: product.GetQuantityFromProductIDRequest
-	(*GetQuantityFromProductIDResponse)(nil), // 12: product.GetQuantityFromProductIDResponse
-	(*GetPriceofProductFromIDRequest)(nil),   // 13: product.GetPriceofProductFromIDRequest
-	(*GetPriceofProductFromIDResponse)(nil),  // 14: product.GetPriceofProductFromIDResponse
-	(*ProductStockMinusRequest)(nil),         // 15: product.ProductStockMinusRequest
-	(*ProductStockMinusReponse)(nil),         // 16: product.ProductStockMinusReponse
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pkg_pb_product_product_proto_depIdxs = []int32{
-	5,  // 0: product.ListProductResponse.details:type_name -> product.ProductDetails
-	2,  // 1: product.Product.AddProduct:input_type -> product.AddProductRequest
-	4,  // 2: product.Product.ListProducts:input_type -> product.ListProductRequest
-	7,  // 3: product.Product.UpdateProducts:input_type -> product.UpdateProductRequest
-	9,  // 4: product.Product.DeleteProduct:input_type -> product.DeleteProductRequest
-	11, // 5: product.Product.GetQuantityFromProductID:input_type -> product.GetQuantityFromProductIDRequest
-	13, // 6: product.Product.GetPriceofProductFromID:input_type -> product.GetPriceofProductFromIDRequest
-	15, // 7: product.Product.ProductStockMinus:input_type -> product.ProductStockMinusRequest
-	0,  // 8: product.Product.CheckProduct:input_type -> product.CheckProductRequest
-	3,  // 9: product.Product.AddProduct:output_type -> product.AddProductResponse
-	6,  // 10: product.Product.ListProducts:output_type -> product.ListProductResponse
-	8,  // 11: product.Product.UpdateProducts:output_type -> product.UpdateProductResponse
-	10, // 12: product.Product.DeleteProduct:output_type -> product.DeleteProductResponse
-	12, // 13: product.Product.GetQuantityFromProductID:output_type -> product.GetQuantityFromProductIDResponse
-	14, // 14: product.Product.GetPriceofProductFromID:output_type -> product.GetPriceofProductFromIDResponse
-	16, // 15: product.Product.ProductStockMinus:output_type -> product.ProductStockMinusReponse
-	1,  // 16: product.Product.CheckProduct:output_type -> product.CheckProductResponse
-	9,  // [9:17] is the sub-list for method output_type
-	1,  // [1:9] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_pkg_pb_product_product_proto_init() }
-func file_pkg_pb_product_product_proto_init() {
-	if File_pkg_pb_product_product_proto != nil {
-		return
+func (x *Facet) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_pb_product_product_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckProductRequest); i {
+	return ""
+}
+
+func (x *Facet) GetValues() []*FacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Details []*ProductDetails `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty"`
+	Total   int64             `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	Facets  []*Facet          `protobuf:"bytes,3,rep,name=Facets,proto3" json:"Facets,omitempty"`
+}
+
+func (x *ListProductResponse) Reset() {
+	*x = ListProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductResponse) ProtoMessage() {}
+
+func (x *ListProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductResponse.ProtoReflect.Descriptor instead.
+func (*ListProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListProductResponse) GetDetails() []*ProductDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ListProductResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Stock int64  `protobuf:"varint,2,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	Actor string `protobuf:"bytes,3,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateProductRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ProductPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string       `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	CategoryID  int64        `protobuf:"varint,3,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	Size        int64        `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	Stock       int64        `protobuf:"varint,5,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	Price       float32      `protobuf:"fixed32,6,opt,name=Price,proto3" json:"Price,omitempty"`
+	Specs       *LaptopSpecs `protobuf:"bytes,7,opt,name=Specs,proto3" json:"Specs,omitempty"`
+}
+
+func (x *ProductPatch) Reset() {
+	*x = ProductPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPatch) ProtoMessage() {}
+
+func (x *ProductPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPatch.ProtoReflect.Descriptor instead.
+func (*ProductPatch) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProductPatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductPatch) GetCategoryID() int64 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductPatch) GetSpecs() *LaptopSpecs {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+// PatchProductRequest changes the fields of a product named in UpdateMask:
+// name, description, category_id, size, stock, price, specs, or a single
+// spec such as specs.ram_gb.
+type PatchProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Product    *ProductPatch          `protobuf:"bytes,2,opt,name=Product,proto3" json:"Product,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+	Actor      string                 `protobuf:"bytes,4,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *PatchProductRequest) Reset() {
+	*x = PatchProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProductRequest) ProtoMessage() {}
+
+func (x *PatchProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchProductRequest.ProtoReflect.Descriptor instead.
+func (*PatchProductRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PatchProductRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PatchProductRequest) GetProduct() *ProductPatch {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *PatchProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchProductRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type PatchProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product *ProductDetails `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	Error   string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *PatchProductResponse) Reset() {
+	*x = PatchProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProductResponse) ProtoMessage() {}
+
+func (x *PatchProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchProductResponse.ProtoReflect.Descriptor instead.
+func (*PatchProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PatchProductResponse) GetProduct() *ProductDetails {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *PatchProductResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Stock int64  `protobuf:"varint,2,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateProductResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateProductResponse) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteProductRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProductResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetQuantityFromProductIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GetQuantityFromProductIDRequest) Reset() {
+	*x = GetQuantityFromProductIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuantityFromProductIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuantityFromProductIDRequest) ProtoMessage() {}
+
+func (x *GetQuantityFromProductIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuantityFromProductIDRequest.ProtoReflect.Descriptor instead.
+func (*GetQuantityFromProductIDRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetQuantityFromProductIDRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type GetQuantityFromProductIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantity int64  `protobuf:"varint,1,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetQuantityFromProductIDResponse) Reset() {
+	*x = GetQuantityFromProductIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuantityFromProductIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuantityFromProductIDResponse) ProtoMessage() {}
+
+func (x *GetQuantityFromProductIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuantityFromProductIDResponse.ProtoReflect.Descriptor instead.
+func (*GetQuantityFromProductIDResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetQuantityFromProductIDResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetQuantityFromProductIDResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetPriceofProductFromIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GetPriceofProductFromIDRequest) Reset() {
+	*x = GetPriceofProductFromIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceofProductFromIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceofProductFromIDRequest) ProtoMessage() {}
+
+func (x *GetPriceofProductFromIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceofProductFromIDRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceofProductFromIDRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPriceofProductFromIDRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type GetPriceofProductFromIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price int64  `protobuf:"varint,1,opt,name=Price,proto3" json:"Price,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *GetPriceofProductFromIDResponse) Reset() {
+	*x = GetPriceofProductFromIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceofProductFromIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceofProductFromIDResponse) ProtoMessage() {}
+
+func (x *GetPriceofProductFromIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceofProductFromIDResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceofProductFromIDResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPriceofProductFromIDResponse) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GetPriceofProductFromIDResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProductStockMinusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Stock     int64  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Reference string `protobuf:"bytes,4,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Actor     string `protobuf:"bytes,5,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *ProductStockMinusRequest) Reset() {
+	*x = ProductStockMinusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStockMinusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockMinusRequest) ProtoMessage() {}
+
+func (x *ProductStockMinusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockMinusRequest.ProtoReflect.Descriptor instead.
+func (*ProductStockMinusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProductStockMinusRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ProductStockMinusRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductStockMinusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProductStockMinusRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ProductStockMinusRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ProductStockMinusReponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ProductStockMinusReponse) Reset() {
+	*x = ProductStockMinusReponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStockMinusReponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockMinusReponse) ProtoMessage() {}
+
+func (x *ProductStockMinusReponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockMinusReponse.ProtoReflect.Descriptor instead.
+func (*ProductStockMinusReponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProductStockMinusReponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProductStockPlusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Stock     int64  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Reference string `protobuf:"bytes,4,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Actor     string `protobuf:"bytes,5,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *ProductStockPlusRequest) Reset() {
+	*x = ProductStockPlusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStockPlusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockPlusRequest) ProtoMessage() {}
+
+func (x *ProductStockPlusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockPlusRequest.ProtoReflect.Descriptor instead.
+func (*ProductStockPlusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProductStockPlusRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ProductStockPlusRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductStockPlusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProductStockPlusRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ProductStockPlusRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ProductStockPlusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ProductStockPlusResponse) Reset() {
+	*x = ProductStockPlusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStockPlusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockPlusResponse) ProtoMessage() {}
+
+func (x *ProductStockPlusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockPlusResponse.ProtoReflect.Descriptor instead.
+func (*ProductStockPlusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProductStockPlusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProductStock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Stock int64 `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+}
+
+func (x *ProductStock) Reset() {
+	*x = ProductStock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStock) ProtoMessage() {}
+
+func (x *ProductStock) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStock.ProtoReflect.Descriptor instead.
+func (*ProductStock) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProductStock) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ProductStock) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type ProductStockBulkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items     []*ProductStock `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Reason    string          `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Reference string          `protobuf:"bytes,3,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Actor     string          `protobuf:"bytes,4,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *ProductStockBulkRequest) Reset() {
+	*x = ProductStockBulkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStockBulkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockBulkRequest) ProtoMessage() {}
+
+func (x *ProductStockBulkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockBulkRequest.ProtoReflect.Descriptor instead.
+func (*ProductStockBulkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProductStockBulkRequest) GetItems() []*ProductStock {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ProductStockBulkRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProductStockBulkRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ProductStockBulkRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ProductStockBulkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ProductStockBulkResponse) Reset() {
+	*x = ProductStockBulkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductStockBulkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockBulkResponse) ProtoMessage() {}
+
+func (x *ProductStockBulkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockBulkResponse.ProtoReflect.Descriptor instead.
+func (*ProductStockBulkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProductStockBulkResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference  string          `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Items      []*ProductStock `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TTLSeconds int64           `protobuf:"varint,3,opt,name=TTLSeconds,proto3" json:"TTLSeconds,omitempty"`
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReserveStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetItems() []*ProductStock {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetTTLSeconds() int64 {
+	if x != nil {
+		return x.TTLSeconds
+	}
+	return 0
+}
+
+type ReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference  string  `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	ProductIDs []int64 `protobuf:"varint,2,rep,packed,name=ProductIDs,proto3" json:"ProductIDs,omitempty"`
+}
+
+func (x *ReservationRequest) Reset() {
+	*x = ReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationRequest) ProtoMessage() {}
+
+func (x *ReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReservationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReservationRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReservationRequest) GetProductIDs() []int64 {
+	if x != nil {
+		return x.ProductIDs
+	}
+	return nil
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	ProductID int64  `protobuf:"varint,3,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Quantity  int64  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Status    string `protobuf:"bytes,5,opt,name=Status,proto3" json:"Status,omitempty"`
+	ExpiresAt string `protobuf:"bytes,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Reservation) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Reservation) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Reservation) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *Reservation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Reservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservations []*Reservation `protobuf:"bytes,1,rep,name=Reservations,proto3" json:"Reservations,omitempty"`
+	Error        string         `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ReservationResponse) Reset() {
+	*x = ReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationResponse) ProtoMessage() {}
+
+func (x *ReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReservationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReservationResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *ReservationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Page      int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Count     int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListStockMovementsRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StockMovement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProductID int64  `protobuf:"varint,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Delta     int64  `protobuf:"varint,3,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Reference string `protobuf:"bytes,5,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Actor     string `protobuf:"bytes,6,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	CreatedAt string `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StockMovement) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *StockMovement) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *StockMovement) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Movements []*StockMovement `protobuf:"bytes,1,rep,name=Movements,proto3" json:"Movements,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReconcileStockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64  `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Fix       bool   `protobuf:"varint,2,opt,name=Fix,proto3" json:"Fix,omitempty"`
+	Actor     string `protobuf:"bytes,3,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *ReconcileStockRequest) Reset() {
+	*x = ReconcileStockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStockRequest) ProtoMessage() {}
+
+func (x *ReconcileStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStockRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileStockRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReconcileStockRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *ReconcileStockRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+func (x *ReconcileStockRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type StockReconciliation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID   int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Stock       int64 `protobuf:"varint,2,opt,name=Stock,proto3" json:"Stock,omitempty"`
+	LedgerStock int64 `protobuf:"varint,3,opt,name=LedgerStock,proto3" json:"LedgerStock,omitempty"`
+	Difference  int64 `protobuf:"varint,4,opt,name=Difference,proto3" json:"Difference,omitempty"`
+}
+
+func (x *StockReconciliation) Reset() {
+	*x = StockReconciliation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliation) ProtoMessage() {}
+
+func (x *StockReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliation.ProtoReflect.Descriptor instead.
+func (*StockReconciliation) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StockReconciliation) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *StockReconciliation) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *StockReconciliation) GetLedgerStock() int64 {
+	if x != nil {
+		return x.LedgerStock
+	}
+	return 0
+}
+
+func (x *StockReconciliation) GetDifference() int64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+type ReconcileStockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mismatches []*StockReconciliation `protobuf:"bytes,1,rep,name=Mismatches,proto3" json:"Mismatches,omitempty"`
+	Error      string                 `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ReconcileStockResponse) Reset() {
+	*x = ReconcileStockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStockResponse) ProtoMessage() {}
+
+func (x *ReconcileStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStockResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileStockResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReconcileStockResponse) GetMismatches() []*StockReconciliation {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *ReconcileStockResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Category struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Slug        string `protobuf:"bytes,3,opt,name=Slug,proto3" json:"Slug,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	ParentID    int64  `protobuf:"varint,5,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Category) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetParentID() int64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+type CategoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Slug        string `protobuf:"bytes,3,opt,name=Slug,proto3" json:"Slug,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	ParentID    int64  `protobuf:"varint,5,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+}
+
+func (x *CategoryRequest) Reset() {
+	*x = CategoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryRequest) ProtoMessage() {}
+
+func (x *CategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryRequest.ProtoReflect.Descriptor instead.
+func (*CategoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CategoryRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CategoryRequest) GetParentID() int64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+type CategoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category *Category `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+	Error    string    `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *CategoryResponse) Reset() {
+	*x = CategoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryResponse) ProtoMessage() {}
+
+func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
+func (*CategoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteCategoryRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteCategoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{52}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*Category `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategoryNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category *Category       `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+	Children []*CategoryNode `protobuf:"bytes,2,rep,name=Children,proto3" json:"Children,omitempty"`
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type CategoryTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*CategoryNode `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+}
+
+func (x *CategoryTreeResponse) Reset() {
+	*x = CategoryTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeResponse) ProtoMessage() {}
+
+func (x *CategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*CategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CategoryTreeResponse) GetCategories() []*CategoryNode {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Times are RFC 3339 strings; an empty EndsAt schedules a permanent change.
+type PriceSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProductID     int64   `protobuf:"varint,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Label         string  `protobuf:"bytes,3,opt,name=Label,proto3" json:"Label,omitempty"`
+	Price         float32 `protobuf:"fixed32,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	PreviousPrice float32 `protobuf:"fixed32,5,opt,name=PreviousPrice,proto3" json:"PreviousPrice,omitempty"`
+	StartsAt      string  `protobuf:"bytes,6,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt        string  `protobuf:"bytes,7,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	Status        string  `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	CreatedBy     string  `protobuf:"bytes,9,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+}
+
+func (x *PriceSchedule) Reset() {
+	*x = PriceSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceSchedule) ProtoMessage() {}
+
+func (x *PriceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceSchedule.ProtoReflect.Descriptor instead.
+func (*PriceSchedule) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PriceSchedule) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetPreviousPrice() float32 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PriceSchedule) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type SchedulePriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64   `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Label     string  `protobuf:"bytes,2,opt,name=Label,proto3" json:"Label,omitempty"`
+	Price     float32 `protobuf:"fixed32,3,opt,name=Price,proto3" json:"Price,omitempty"`
+	StartsAt  string  `protobuf:"bytes,4,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt    string  `protobuf:"bytes,5,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	Actor     string  `protobuf:"bytes,6,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *SchedulePriceRequest) Reset() {
+	*x = SchedulePriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceRequest) ProtoMessage() {}
+
+func (x *SchedulePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SchedulePriceRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *SchedulePriceRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SchedulePriceRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SchedulePriceRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *SchedulePriceRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type CancelPriceScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Actor string `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *CancelPriceScheduleRequest) Reset() {
+	*x = CancelPriceScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPriceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceScheduleRequest) ProtoMessage() {}
+
+func (x *CancelPriceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelPriceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CancelPriceScheduleRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CancelPriceScheduleRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type PriceScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *PriceSchedule `protobuf:"bytes,1,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	Error    string         `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *PriceScheduleResponse) Reset() {
+	*x = PriceScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceScheduleResponse) ProtoMessage() {}
+
+func (x *PriceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PriceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PriceScheduleResponse) GetSchedule() *PriceSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *PriceScheduleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListPriceSchedulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*PriceSchedule `protobuf:"bytes,1,rep,name=Schedules,proto3" json:"Schedules,omitempty"`
+}
+
+func (x *ListPriceSchedulesResponse) Reset() {
+	*x = ListPriceSchedulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceSchedulesResponse) ProtoMessage() {}
+
+func (x *ListPriceSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListPriceSchedulesResponse) GetSchedules() []*PriceSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type ListPriceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64 `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Page      int64 `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Count     int64 `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *ListPriceHistoryRequest) Reset() {
+	*x = ListPriceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryRequest) ProtoMessage() {}
+
+func (x *ListPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListPriceHistoryRequest) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ProductID  int64   `protobuf:"varint,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	OldPrice   float32 `protobuf:"fixed32,3,opt,name=OldPrice,proto3" json:"OldPrice,omitempty"`
+	NewPrice   float32 `protobuf:"fixed32,4,opt,name=NewPrice,proto3" json:"NewPrice,omitempty"`
+	Reason     string  `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ScheduleID int64   `protobuf:"varint,6,opt,name=ScheduleID,proto3" json:"ScheduleID,omitempty"`
+	Actor      string  `protobuf:"bytes,7,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	CreatedAt  string  `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PriceChange) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PriceChange) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *PriceChange) GetOldPrice() float32 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceChange) GetNewPrice() float32 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PriceChange) GetScheduleID() int64 {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return 0
+}
+
+func (x *PriceChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PriceChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListPriceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*PriceChange `protobuf:"bytes,1,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (x *ListPriceHistoryResponse) Reset() {
+	*x = ListPriceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryResponse) ProtoMessage() {}
+
+func (x *ListPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListPriceHistoryResponse) GetChanges() []*PriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type Offer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ProductID     int64   `protobuf:"varint,3,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	CategoryID    int64   `protobuf:"varint,4,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	DiscountType  string  `protobuf:"bytes,5,opt,name=DiscountType,proto3" json:"DiscountType,omitempty"`
+	DiscountValue float32 `protobuf:"fixed32,6,opt,name=DiscountValue,proto3" json:"DiscountValue,omitempty"`
+	ValidFrom     string  `protobuf:"bytes,7,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidUntil    string  `protobuf:"bytes,8,opt,name=ValidUntil,proto3" json:"ValidUntil,omitempty"`
+	Active        bool    `protobuf:"varint,9,opt,name=Active,proto3" json:"Active,omitempty"`
+	CreatedBy     string  `protobuf:"bytes,10,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Offer) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Offer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Offer) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *Offer) GetCategoryID() int64 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *Offer) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Offer) GetDiscountValue() float32 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *Offer) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *Offer) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Offer) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Offer) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type AddOfferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *Offer `protobuf:"bytes,1,opt,name=Offer,proto3" json:"Offer,omitempty"`
+	Actor string `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *AddOfferRequest) Reset() {
+	*x = AddOfferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOfferRequest) ProtoMessage() {}
+
+func (x *AddOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOfferRequest.ProtoReflect.Descriptor instead.
+func (*AddOfferRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AddOfferRequest) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *AddOfferRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type UpdateOfferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Offer *Offer `protobuf:"bytes,2,opt,name=Offer,proto3" json:"Offer,omitempty"`
+}
+
+func (x *UpdateOfferRequest) Reset() {
+	*x = UpdateOfferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferRequest) ProtoMessage() {}
+
+func (x *UpdateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateOfferRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type OfferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offer *Offer `protobuf:"bytes,1,opt,name=Offer,proto3" json:"Offer,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *OfferResponse) Reset() {
+	*x = OfferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferResponse) ProtoMessage() {}
+
+func (x *OfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferResponse.ProtoReflect.Descriptor instead.
+func (*OfferResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *OfferResponse) GetOffer() *Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *OfferResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListOffersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page  int64 `protobuf:"varint,1,opt,name=Page,proto3" json:"Page,omitempty"`
+	Count int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *ListOffersRequest) Reset() {
+	*x = ListOffersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffersRequest) ProtoMessage() {}
+
+func (x *ListOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListOffersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListOffersRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOffersRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListOffersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*Offer `protobuf:"bytes,1,rep,name=Offers,proto3" json:"Offers,omitempty"`
+}
+
+func (x *ListOffersResponse) Reset() {
+	*x = ListOffersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffersResponse) ProtoMessage() {}
+
+func (x *ListOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListOffersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListOffersResponse) GetOffers() []*Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type DeleteOfferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteOfferRequest) Reset() {
+	*x = DeleteOfferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOfferRequest) ProtoMessage() {}
+
+func (x *DeleteOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOfferRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOfferRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteOfferRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteOfferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *DeleteOfferResponse) Reset() {
+	*x = DeleteOfferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOfferResponse) ProtoMessage() {}
+
+func (x *DeleteOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOfferResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOfferResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteOfferResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProductOffersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductIDs []int64 `protobuf:"varint,1,rep,packed,name=ProductIDs,proto3" json:"ProductIDs,omitempty"`
+}
+
+func (x *ProductOffersRequest) Reset() {
+	*x = ProductOffersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOffersRequest) ProtoMessage() {}
+
+func (x *ProductOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOffersRequest.ProtoReflect.Descriptor instead.
+func (*ProductOffersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ProductOffersRequest) GetProductIDs() []int64 {
+	if x != nil {
+		return x.ProductIDs
+	}
+	return nil
+}
+
+type ProductOffers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductID int64    `protobuf:"varint,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Offers    []*Offer `protobuf:"bytes,2,rep,name=Offers,proto3" json:"Offers,omitempty"`
+}
+
+func (x *ProductOffers) Reset() {
+	*x = ProductOffers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductOffers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOffers) ProtoMessage() {}
+
+func (x *ProductOffers) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOffers.ProtoReflect.Descriptor instead.
+func (*ProductOffers) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ProductOffers) GetProductID() int64 {
+	if x != nil {
+		return x.ProductID
+	}
+	return 0
+}
+
+func (x *ProductOffers) GetOffers() []*Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type ProductOffersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products []*ProductOffers `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+}
+
+func (x *ProductOffersResponse) Reset() {
+	*x = ProductOffersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_pb_product_product_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOffersResponse) ProtoMessage() {}
+
+func (x *ProductOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_product_product_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOffersResponse.ProtoReflect.Descriptor instead.
+func (*ProductOffersResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_product_product_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ProductOffersResponse) GetProducts() []*ProductOffers {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+var File_pkg_pb_product_product_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_product_product_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x33, 0x0a, 0x13, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x22, 0x40,
+	0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x93, 0x02, 0x0a, 0x0b, 0x4c, 0x61, 0x70, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x63, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x43, 0x50, 0x55, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x41, 0x4d, 0x47,
+	0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x52, 0x41, 0x4d, 0x47, 0x42, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x42, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x42, 0x12, 0x10,
+	0x0a, 0x03, 0x47, 0x50, 0x55, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x50, 0x55,
+	0x12, 0x2a, 0x0a, 0x10, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x57, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x42, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x79, 0x57, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x22, 0xe9, 0x03, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x43, 0x50, 0x55, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x43, 0x50, 0x55,
+	0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x52, 0x41, 0x4d, 0x47, 0x42, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x52, 0x41, 0x4d, 0x47, 0x42, 0x12, 0x1a, 0x0a, 0x08,
+	0x4d, 0x61, 0x78, 0x52, 0x41, 0x4d, 0x47, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x4d, 0x61, 0x78, 0x52, 0x41, 0x4d, 0x47, 0x42, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x69,
+	0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x42, 0x12, 0x10,
+	0x0a, 0x03, 0x47, 0x50, 0x55, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x50, 0x55,
+	0x12, 0x2a, 0x0a, 0x10, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x4d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x4d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4b, 0x67, 0x12, 0x22,
+	0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x57, 0x68, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x4d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x57, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x4f, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a,
+	0x0a, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x61, 0x70, 0x74, 0x6f, 0x70, 0x53, 0x70,
+	0x65, 0x63, 0x73, 0x52, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a,
+	0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x61, 0x70, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65,
+	0x63, 0x73, 0x52, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x22, 0x83,
+	0x03, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x61, 0x70,
+	0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x59, 0x0a, 0x14,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4d, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x1b, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x44, 0x73, 0x22,
+	0x5c, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x22, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x5a, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a,
+	0x0a, 0x46, 0x61, 0x63, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x05, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x06, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x52, 0x06, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xd0,
+	0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x63, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c,
+	0x61, 0x70, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x05, 0x53, 0x70, 0x65, 0x63,
+	0x73, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x5f, 0x0a, 0x14,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x53, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x6f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x30, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x30, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x50, 0x6c, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x92, 0x01, 0x0a, 0x17, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42, 0x75, 0x6c, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x30, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x54, 0x4c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x63, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x68, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5d, 0x0a, 0x15,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x46, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x13,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x44,
+	0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6c, 0x0a, 0x16, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x6c, 0x75, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x10, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x70, 0x0a, 0x0c,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x08,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x08, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x4d,
+	0x0a, 0x14, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf9, 0x01,
+	0x0a, 0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0d, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45,
+	0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x64, 0x73,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x61, 0x0a, 0x15, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x52, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x61, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x4f, 0x6c, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4d, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x24, 0x0a, 0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x0d, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0a, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x22, 0x55, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x06, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x06, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x32, 0x89, 0x19, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x47, 0x0a, 0x0a,
+	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x14, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x13, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x12,
+	0x28, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46,
+	0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49,
+	0x44, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x66, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x6c,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x50, 0x6c, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x15, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x69,
+	0x6e, 0x75, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42, 0x75,
+	0x6c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x6c,
+	0x75, 0x73, 0x42, 0x75, 0x6c, 0x6b, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42, 0x75, 0x6c,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x54, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08,
+	0x41, 0x64, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10,
+	0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_pb_product_product_proto_rawDescOnce sync.Once
+	file_pkg_pb_product_product_proto_rawDescData = file_pkg_pb_product_product_proto_rawDesc
+)
+
+func file_pkg_pb_product_product_proto_rawDescGZIP() []byte {
+	file_pkg_pb_product_product_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_product_product_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_product_product_proto_rawDescData)
+	})
+	return file_pkg_pb_product_product_proto_rawDescData
+}
+
+var file_pkg_pb_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
+var file_pkg_pb_product_product_proto_goTypes = []any{
+	(*CheckProductRequest)(nil),              // 0: product.CheckProductRequest
+	(*CheckProductResponse)(nil),             // 1: product.CheckProductResponse
+	(*LaptopSpecs)(nil),                      // 2: product.LaptopSpecs
+	(*ProductFilter)(nil),                    // 3: product.ProductFilter
+	(*AddProductRequest)(nil),                // 4: product.AddProductRequest
+	(*AddProductResponse)(nil),               // 5: product.AddProductResponse
+	(*ListProductRequest)(nil),               // 6: product.ListProductRequest
+	(*ProductDetails)(nil),                   // 7: product.ProductDetails
+	(*ProductImage)(nil),                     // 8: product.ProductImage
+	(*UploadProductImageRequest)(nil),        // 9: product.UploadProductImageRequest
+	(*ProductImageResponse)(nil),             // 10: product.ProductImageResponse
+	(*ProductImageRequest)(nil),              // 11: product.ProductImageRequest
+	(*ReorderProductImagesRequest)(nil),      // 12: product.ReorderProductImagesRequest
+	(*ProductImagesResponse)(nil),            // 13: product.ProductImagesResponse
+	(*ProductIDRequest)(nil),                 // 14: product.ProductIDRequest
+	(*ProductResponse)(nil),                  // 15: product.ProductResponse
+	(*FacetValue)(nil),                       // 16: product.FacetValue
+	(*Facet)(nil),                            // 17: product.Facet
+	(*ListProductResponse)(nil),              // 18: product.ListProductResponse
+	(*UpdateProductRequest)(nil),             // 19: product.UpdateProductRequest
+	(*ProductPatch)(nil),                     // 20: product.ProductPatch
+	(*PatchProductRequest)(nil),              // 21: product.PatchProductRequest
+	(*PatchProductResponse)(nil),             // 22: product.PatchProductResponse
+	(*UpdateProductResponse)(nil),            // 23: product.UpdateProductResponse
+	(*DeleteProductRequest)(nil),             // 24: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),            // 25: product.DeleteProductResponse
+	(*GetQuantityFromProductIDRequest)(nil),  // 26: product.GetQuantityFromProductIDRequest
+	(*GetQuantityFromProductIDResponse)(nil), // 27: product.GetQuantityFromProductIDResponse
+	(*GetPriceofProductFromIDRequest)(nil),   // 28: product.GetPriceofProductFromIDRequest
+	(*GetPriceofProductFromIDResponse)(nil),  // 29: product.GetPriceofProductFromIDResponse
+	(*ProductStockMinusRequest)(nil),         // 30: product.ProductStockMinusRequest
+	(*ProductStockMinusReponse)(nil),         // 31: product.ProductStockMinusReponse
+	(*ProductStockPlusRequest)(nil),          // 32: product.ProductStockPlusRequest
+	(*ProductStockPlusResponse)(nil),         // 33: product.ProductStockPlusResponse
+	(*ProductStock)(nil),                     // 34: product.ProductStock
+	(*ProductStockBulkRequest)(nil),          // 35: product.ProductStockBulkRequest
+	(*ProductStockBulkResponse)(nil),         // 36: product.ProductStockBulkResponse
+	(*ReserveStockRequest)(nil),              // 37: product.ReserveStockRequest
+	(*ReservationRequest)(nil),               // 38: product.ReservationRequest
+	(*Reservation)(nil),                      // 39: product.Reservation
+	(*ReservationResponse)(nil),              // 40: product.ReservationResponse
+	(*ListStockMovementsRequest)(nil),        // 41: product.ListStockMovementsRequest
+	(*StockMovement)(nil),                    // 42: product.StockMovement
+	(*ListStockMovementsResponse)(nil),       // 43: product.ListStockMovementsResponse
+	(*ReconcileStockRequest)(nil),            // 44: product.ReconcileStockRequest
+	(*StockReconciliation)(nil),              // 45: product.StockReconciliation
+	(*ReconcileStockResponse)(nil),           // 46: product.ReconcileStockResponse
+	(*Category)(nil),                         // 47: product.Category
+	(*CategoryRequest)(nil),                  // 48: product.CategoryRequest
+	(*CategoryResponse)(nil),                 // 49: product.CategoryResponse
+	(*DeleteCategoryRequest)(nil),            // 50: product.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),           // 51: product.DeleteCategoryResponse
+	(*ListCategoriesRequest)(nil),            // 52: product.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),           // 53: product.ListCategoriesResponse
+	(*CategoryNode)(nil),                     // 54: product.CategoryNode
+	(*CategoryTreeResponse)(nil),             // 55: product.CategoryTreeResponse
+	(*PriceSchedule)(nil),                    // 56: product.PriceSchedule
+	(*SchedulePriceRequest)(nil),             // 57: product.SchedulePriceRequest
+	(*CancelPriceScheduleRequest)(nil),       // 58: product.CancelPriceScheduleRequest
+	(*PriceScheduleResponse)(nil),            // 59: product.PriceScheduleResponse
+	(*ListPriceSchedulesResponse)(nil),       // 60: product.ListPriceSchedulesResponse
+	(*ListPriceHistoryRequest)(nil),          // 61: product.ListPriceHistoryRequest
+	(*PriceChange)(nil),                      // 62: product.PriceChange
+	(*ListPriceHistoryResponse)(nil),         // 63: product.ListPriceHistoryResponse
+	(*Offer)(nil),                            // 64: product.Offer
+	(*AddOfferRequest)(nil),                  // 65: product.AddOfferRequest
+	(*UpdateOfferRequest)(nil),               // 66: product.UpdateOfferRequest
+	(*OfferResponse)(nil),                    // 67: product.OfferResponse
+	(*ListOffersRequest)(nil),                // 68: product.ListOffersRequest
+	(*ListOffersResponse)(nil),               // 69: product.ListOffersResponse
+	(*DeleteOfferRequest)(nil),               // 70: product.DeleteOfferRequest
+	(*DeleteOfferResponse)(nil),              // 71: product.DeleteOfferResponse
+	(*ProductOffersRequest)(nil),             // 72: product.ProductOffersRequest
+	(*ProductOffers)(nil),                    // 73: product.ProductOffers
+	(*ProductOffersResponse)(nil),            // 74: product.ProductOffersResponse
+	(*fieldmaskpb.FieldMask)(nil),            // 75: google.protobuf.FieldMask
+}
+var file_pkg_pb_product_product_proto_depIdxs = []int32{
+	2,  // 0: product.AddProductRequest.Specs:type_name -> product.LaptopSpecs
+	2,  // 1: product.AddProductResponse.Specs:type_name -> product.LaptopSpecs
+	3,  // 2: product.ListProductRequest.Filter:type_name -> product.ProductFilter
+	2,  // 3: product.ProductDetails.Specs:type_name -> product.LaptopSpecs
+	8,  // 4: product.ProductDetails.Images:type_name -> product.ProductImage
+	8,  // 5: product.ProductImageResponse.Image:type_name -> product.ProductImage
+	8,  // 6: product.ProductImagesResponse.Images:type_name -> product.ProductImage
+	7,  // 7: product.ProductResponse.Product:type_name -> product.ProductDetails
+	16, // 8: product.Facet.Values:type_name -> product.FacetValue
+	7,  // 9: product.ListProductResponse.details:type_name -> product.ProductDetails
+	17, // 10: product.ListProductResponse.Facets:type_name -> product.Facet
+	2,  // 11: product.ProductPatch.Specs:type_name -> product.LaptopSpecs
+	20, // 12: product.PatchProductRequest.Product:type_name -> product.ProductPatch
+	75, // 13: product.PatchProductRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	7,  // 14: product.PatchProductResponse.Product:type_name -> product.ProductDetails
+	34, // 15: product.ProductStockBulkRequest.items:type_name -> product.ProductStock
+	34, // 16: product.ReserveStockRequest.items:type_name -> product.ProductStock
+	39, // 17: product.ReservationResponse.Reservations:type_name -> product.Reservation
+	42, // 18: product.ListStockMovementsResponse.Movements:type_name -> product.StockMovement
+	45, // 19: product.ReconcileStockResponse.Mismatches:type_name -> product.StockReconciliation
+	47, // 20: product.CategoryResponse.Category:type_name -> product.Category
+	47, // 21: product.ListCategoriesResponse.Categories:type_name -> product.Category
+	47, // 22: product.CategoryNode.Category:type_name -> product.Category
+	54, // 23: product.CategoryNode.Children:type_name -> product.CategoryNode
+	54, // 24: product.CategoryTreeResponse.Categories:type_name -> product.CategoryNode
+	56, // 25: product.PriceScheduleResponse.Schedule:type_name -> product.PriceSchedule
+	56, // 26: product.ListPriceSchedulesResponse.Schedules:type_name -> product.PriceSchedule
+	62, // 27: product.ListPriceHistoryResponse.Changes:type_name -> product.PriceChange
+	64, // 28: product.AddOfferRequest.Offer:type_name -> product.Offer
+	64, // 29: product.UpdateOfferRequest.Offer:type_name -> product.Offer
+	64, // 30: product.OfferResponse.Offer:type_name -> product.Offer
+	64, // 31: product.ListOffersResponse.Offers:type_name -> product.Offer
+	64, // 32: product.ProductOffers.Offers:type_name -> product.Offer
+	73, // 33: product.ProductOffersResponse.Products:type_name -> product.ProductOffers
+	4,  // 34: product.Product.AddProduct:input_type -> product.AddProductRequest
+	6,  // 35: product.Product.ListProducts:input_type -> product.ListProductRequest
+	19, // 36: product.Product.UpdateProducts:input_type -> product.UpdateProductRequest
+	21, // 37: product.Product.PatchProduct:input_type -> product.PatchProductRequest
+	24, // 38: product.Product.DeleteProduct:input_type -> product.DeleteProductRequest
+	14, // 39: product.Product.RestoreProduct:input_type -> product.ProductIDRequest
+	14, // 40: product.Product.GetProduct:input_type -> product.ProductIDRequest
+	9,  // 41: product.Product.UploadProductImage:input_type -> product.UploadProductImageRequest
+	14, // 42: product.Product.ListProductImages:input_type -> product.ProductIDRequest
+	11, // 43: product.Product.SetPrimaryImage:input_type -> product.ProductImageRequest
+	12, // 44: product.Product.ReorderProductImages:input_type -> product.ReorderProductImagesRequest
+	11, // 45: product.Product.DeleteProductImage:input_type -> product.ProductImageRequest
+	57, // 46: product.Product.SchedulePriceChange:input_type -> product.SchedulePriceRequest
+	58, // 47: product.Product.CancelPriceSchedule:input_type -> product.CancelPriceScheduleRequest
+	14, // 48: product.Product.ListPriceSchedules:input_type -> product.ProductIDRequest
+	61, // 49: product.Product.ListPriceHistory:input_type -> product.ListPriceHistoryRequest
+	26, // 50: product.Product.GetQuantityFromProductID:input_type -> product.GetQuantityFromProductIDRequest
+	28, // 51: product.Product.GetPriceofProductFromID:input_type -> product.GetPriceofProductFromIDRequest
+	30, // 52: product.Product.ProductStockMinus:input_type -> product.ProductStockMinusRequest
+	32, // 53: product.Product.ProductStockPlus:input_type -> product.ProductStockPlusRequest
+	35, // 54: product.Product.ProductStockMinusBulk:input_type -> product.ProductStockBulkRequest
+	35, // 55: product.Product.ProductStockPlusBulk:input_type -> product.ProductStockBulkRequest
+	37, // 56: product.Product.ReserveStock:input_type -> product.ReserveStockRequest
+	38, // 57: product.Product.ReleaseStock:input_type -> product.ReservationRequest
+	38, // 58: product.Product.CommitReservation:input_type -> product.ReservationRequest
+	41, // 59: product.Product.ListStockMovements:input_type -> product.ListStockMovementsRequest
+	44, // 60: product.Product.ReconcileStock:input_type -> product.ReconcileStockRequest
+	0,  // 61: product.Product.CheckProduct:input_type -> product.CheckProductRequest
+	48, // 62: product.Product.AddCategory:input_type -> product.CategoryRequest
+	48, // 63: product.Product.UpdateCategory:input_type -> product.CategoryRequest
+	50, // 64: product.Product.DeleteCategory:input_type -> product.DeleteCategoryRequest
+	52, // 65: product.Product.ListCategories:input_type -> product.ListCategoriesRequest
+	52, // 66: product.Product.GetCategoryTree:input_type -> product.ListCategoriesRequest
+	65, // 67: product.Product.AddOffer:input_type -> product.AddOfferRequest
+	66, // 68: product.Product.UpdateOffer:input_type -> product.UpdateOfferRequest
+	68, // 69: product.Product.ListOffers:input_type -> product.ListOffersRequest
+	70, // 70: product.Product.DeleteOffer:input_type -> product.DeleteOfferRequest
+	72, // 71: product.Product.GetProductOffers:input_type -> product.ProductOffersRequest
+	5,  // 72: product.Product.AddProduct:output_type -> product.AddProductResponse
+	18, // 73: product.Product.ListProducts:output_type -> product.ListProductResponse
+	23, // 74: product.Product.UpdateProducts:output_type -> product.UpdateProductResponse
+	22, // 75: product.Product.PatchProduct:output_type -> product.PatchProductResponse
+	25, // 76: product.Product.DeleteProduct:output_type -> product.DeleteProductResponse
+	15, // 77: product.Product.RestoreProduct:output_type -> product.ProductResponse
+	15, // 78: product.Product.GetProduct:output_type -> product.ProductResponse
+	10, // 79: product.Product.UploadProductImage:output_type -> product.ProductImageResponse
+	13, // 80: product.Product.ListProductImages:output_type -> product.ProductImagesResponse
+	13, // 81: product.Product.SetPrimaryImage:output_type -> product.ProductImagesResponse
+	13, // 82: product.Product.ReorderProductImages:output_type -> product.ProductImagesResponse
+	13, // 83: product.Product.DeleteProductImage:output_type -> product.ProductImagesResponse
+	59, // 84: product.Product.SchedulePriceChange:output_type -> product.PriceScheduleResponse
+	59, // 85: product.Product.CancelPriceSchedule:output_type -> product.PriceScheduleResponse
+	60, // 86: product.Product.ListPriceSchedules:output_type -> product.ListPriceSchedulesResponse
+	63, // 87: product.Product.ListPriceHistory:output_type -> product.ListPriceHistoryResponse
+	27, // 88: product.Product.GetQuantityFromProductID:output_type -> product.GetQuantityFromProductIDResponse
+	29, // 89: product.Product.GetPriceofProductFromID:output_type -> product.GetPriceofProductFromIDResponse
+	31, // 90: product.Product.ProductStockMinus:output_type -> product.ProductStockMinusReponse
+	33, // 91: product.Product.ProductStockPlus:output_type -> product.ProductStockPlusResponse
+	36, // 92: product.Product.ProductStockMinusBulk:output_type -> product.ProductStockBulkResponse
+	36, // 93: product.Product.ProductStockPlusBulk:output_type -> product.ProductStockBulkResponse
+	40, // 94: product.Product.ReserveStock:output_type -> product.ReservationResponse
+	40, // 95: product.Product.ReleaseStock:output_type -> product.ReservationResponse
+	40, // 96: product.Product.CommitReservation:output_type -> product.ReservationResponse
+	43, // 97: product.Product.ListStockMovements:output_type -> product.ListStockMovementsResponse
+	46, // 98: product.Product.ReconcileStock:output_type -> product.ReconcileStockResponse
+	1,  // 99: product.Product.CheckProduct:output_type -> product.CheckProductResponse
+	49, // 100: product.Product.AddCategory:output_type -> product.CategoryResponse
+	49, // 101: product.Product.UpdateCategory:output_type -> product.CategoryResponse
+	51, // 102: product.Product.DeleteCategory:output_type -> product.DeleteCategoryResponse
+	53, // 103: product.Product.ListCategories:output_type -> product.ListCategoriesResponse
+	55, // 104: product.Product.GetCategoryTree:output_type -> product.CategoryTreeResponse
+	67, // 105: product.Product.AddOffer:output_type -> product.OfferResponse
+	67, // 106: product.Product.UpdateOffer:output_type -> product.OfferResponse
+	69, // 107: product.Product.ListOffers:output_type -> product.ListOffersResponse
+	71, // 108: product.Product.DeleteOffer:output_type -> product.DeleteOfferResponse
+	74, // 109: product.Product.GetProductOffers:output_type -> product.ProductOffersResponse
+	72, // [72:110] is the sub-list for method output_type
+	34, // [34:72] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_product_product_proto_init() }
+func file_pkg_pb_product_product_proto_init() {
+	if File_pkg_pb_product_product_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_pb_product_product_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*LaptopSpecs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AddProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AddProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadProductImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderProductImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*FacetValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Facet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuantityFromProductIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuantityFromProductIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPriceofProductFromIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPriceofProductFromIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStockMinusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStockMinusReponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStockPlusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStockPlusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStockBulkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductStockBulkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1267,8 +6316,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckProductResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ReserveStockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1279,8 +6328,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*AddProductRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ReservationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1291,8 +6340,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*AddProductResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*Reservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,8 +6352,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ListProductRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ReservationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1315,8 +6364,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductDetails); i {
+		file_pkg_pb_product_product_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ListStockMovementsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1327,8 +6376,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ListProductResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*StockMovement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1339,8 +6388,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateProductRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ListStockMovementsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1351,8 +6400,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateProductResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileStockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1363,8 +6412,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteProductRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*StockReconciliation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1375,8 +6424,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteProductResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileStockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1387,8 +6436,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetQuantityFromProductIDRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*Category); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1399,8 +6448,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetQuantityFromProductIDResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1411,8 +6460,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPriceofProductFromIDRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1423,8 +6472,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPriceofProductFromIDResponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteCategoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1435,8 +6484,8 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductStockMinusRequest); i {
+		file_pkg_pb_product_product_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteCategoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1447,8 +6496,272 @@ func file_pkg_pb_product_product_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_pb_product_product_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ProductStockMinusReponse); i {
+		file_pkg_pb_product_product_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCategoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCategoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*CategoryTreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*SchedulePriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelPriceScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPriceSchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPriceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPriceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*Offer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*AddOfferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateOfferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOffersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOffersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteOfferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteOfferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductOffersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductOffers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_pb_product_product_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductOffersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,7 +6779,7 @@ func file_pkg_pb_product_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_pb_product_product_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
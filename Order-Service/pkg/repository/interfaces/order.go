@@ -7,10 +7,44 @@ import (
 
 type OrderRepository interface {
 	AddressExist(orderBody models.OrderIncoming) (bool, error)
-	PaymentExist(orderBody models.OrderIncoming) (bool, error)
 	PaymentStatus(orderID int) (string, error)
-	OrderItems(ob models.OrderIncoming, price float64) (int, error)
+	OrderItems(ob models.OrderIncoming, total models.CartTotal) (int, error)
 	AddOrderProducts(order_id int, cart []models.Cart) error
+	CreateOrder(ob models.OrderIncoming, total models.CartTotal, cart []models.Cart) (int, error)
 	GetBriefOrderDetails(orderID int) (domain.OrderSuccessResponse, error)
 	GetOrderDetails(userId int, page int, count int) ([]models.FullOrderDetails, error)
+	GetOrder(orderID int) (domain.Order, error)
+	UpdateOrderStatus(orderID int, from, to, actor string) error
+	GetOrderTimeline(orderID int) ([]models.OrderStatusEvent, error)
+	CancelOrder(orderID int, from, paymentStatus, actor string) error
+	ClaimRestock(orderID int) (bool, error)
+	UnclaimRestock(orderID int) error
+	GetOrderItems(orderID int) ([]domain.OrderItem, error)
+
+	GetOrderItem(orderID, productID int) (domain.OrderItem, error)
+	HasOpenReturn(orderItemID int) (bool, error)
+	CreateReturnRequest(request domain.ReturnRequest) (domain.ReturnRequest, error)
+	GetReturnRequest(returnID int) (domain.ReturnRequest, error)
+	ListReturnRequests(userID int, status string, page, count int) ([]domain.ReturnRequest, error)
+	ApproveReturn(request domain.ReturnRequest, actor, note string) error
+	RejectReturn(returnID int, actor, note string) error
+	ClaimReturnRestock(returnID int) (bool, error)
+	UnclaimReturnRestock(returnID int) error
+
+	GetPaymentMethod(id int) (domain.PaymentMethod, error)
+	CreatePaymentIntent(intent domain.PaymentIntent) (domain.PaymentIntent, error)
+	SetPaymentReference(intentID int, reference, checkoutURL string) error
+	GetPaymentIntent(id int) (domain.PaymentIntent, error)
+	GetPaymentIntentByReference(provider, reference string) (domain.PaymentIntent, error)
+	ListOrderPayments(orderID int) ([]domain.PaymentIntent, error)
+	CancelPendingPayments(orderID int) error
+	SettlePayment(intentID int, status, reason string, event *domain.PaymentEvent) (bool, error)
+
+	AddWalletTransaction(entry domain.WalletTransaction) (domain.WalletTransaction, error)
+	ReverseWalletTransaction(reference string) error
+	WalletBalance(userID int) (float64, error)
+	ListWalletTransactions(userID, page, count int) ([]domain.WalletTransaction, error)
+	PendingRefunds(orderID int) ([]domain.Refund, error)
+	CreditRefund(refundID int) error
+	RefundPaidOrder(orderID int) error
 }
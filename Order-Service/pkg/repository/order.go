@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"fmt"
 	"order-service/pkg/domain"
 	"order-service/pkg/models"
 	"order-service/pkg/repository/interfaces"
@@ -27,14 +28,6 @@ func (or *orderRepository) AddressExist(orderBody models.OrderIncoming) (bool, e
 
 	return true, nil
 
-}
-func (or *orderRepository) PaymentExist(orderBody models.OrderIncoming) (bool, error) {
-	var count int
-	if err := or.DB.Raw("SELECT COUNT(*) FROM payment_methods WHERE id = ?", orderBody.PaymentID).Scan(&count).Error; err != nil {
-		return false, err
-	}
-	return true, nil
-
 }
 func (or *orderRepository) PaymentStatus(orderID int) (string, error) {
 	var status string
@@ -45,14 +38,36 @@ func (or *orderRepository) PaymentStatus(orderID int) (string, error) {
 	return status, nil
 }
 
-func (or *orderRepository) OrderItems(ob models.OrderIncoming, price float64) (int, error) {
-	shipment_status := "Pending"
-	PaymentMethod := "Paid"
+func (or *orderRepository) OrderItems(ob models.OrderIncoming, total models.CartTotal) (int, error) {
 	var id int
-	query := `INSERT INTO orders (created_at , user_id , address_id , shipment_status , payment_status , final_price)
-    VALUES (NOW(),?, ?, ?, ?,?)
+	query := `INSERT INTO orders (created_at , user_id , address_id , shipment_status , payment_status , final_price , discount , coupon_code)
+    VALUES (NOW(),?, ?, ?, ?,?, ?, ?)
     RETURNING id`
-	or.DB.Raw(query, ob.UserID, ob.AddressID, shipment_status, PaymentMethod, price).Scan(&id)
+	err := or.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw(query, ob.UserID, ob.AddressID, domain.OrderStatusPending, domain.PaymentStatusNotPaid, total.FinalPrice, total.Discount, total.CouponCode).Scan(&id).Error; err != nil {
+			return err
+		}
+		return addStatusHistory(tx, id, "", domain.OrderStatusPending, fmt.Sprintf("user:%d", ob.UserID))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+// CreateOrder inserts an order together with its items in one transaction.
+func (or *orderRepository) CreateOrder(ob models.OrderIncoming, total models.CartTotal, cart []models.Cart) (int, error) {
+	var id int
+	err := or.DB.Transaction(func(tx *gorm.DB) error {
+		repo := &orderRepository{DB: tx}
+		var err error
+		if id, err = repo.OrderItems(ob, total); err != nil {
+			return err
+		}
+		return repo.AddOrderProducts(id, cart)
+	})
+	if err != nil {
+		return 0, err
+	}
 	return id, nil
 }
 func (or *orderRepository) AddOrderProducts(order_id int, cart []models.Cart) error {
@@ -67,7 +82,7 @@ func (or *orderRepository) AddOrderProducts(order_id int, cart []models.Cart) er
 }
 func (or *orderRepository) GetBriefOrderDetails(orderID int) (domain.OrderSuccessResponse, error) {
 	var orderSuccessResponse domain.OrderSuccessResponse
-	err := or.DB.Raw(`SELECT id as order_id,shipment_status FROM orders WHERE id = ?`, orderID).Scan(&orderSuccessResponse).Error
+	err := or.DB.Raw(`SELECT id as order_id,shipment_status,payment_status FROM orders WHERE id = ?`, orderID).Scan(&orderSuccessResponse).Error
 	if err != nil {
 		return domain.OrderSuccessResponse{}, err
 	}
@@ -80,7 +95,7 @@ func (or *orderRepository) GetOrderDetails(userId int, page int, count int) ([]m
 	}
 	offset := (page - 1) * count
 	var orderDetails []models.OrderDetails
-	or.DB.Raw("SELECT id as order_id,final_price,shipment_status,payment_status FROM orders WHERE user_id = ? LIMIT ? OFFSET ? ", userId, count, offset).Scan(&orderDetails)
+	or.DB.Raw("SELECT id as order_id,final_price,discount,coupon_code,shipment_status,payment_status FROM orders WHERE user_id = ? LIMIT ? OFFSET ? ", userId, count, offset).Scan(&orderDetails)
 	var fullOrderDetails []models.FullOrderDetails
 	for _, od := range orderDetails {
 		var orderProductDetails []models.OrderProductDetails
@@ -96,3 +111,97 @@ func (or *orderRepository) GetOrderDetails(userId int, page int, count int) ([]m
 	}
 	return fullOrderDetails, nil
 }
+
+func (or *orderRepository) GetOrder(orderID int) (domain.Order, error) {
+	var order domain.Order
+	result := or.DB.Raw("SELECT * FROM orders WHERE id = ? AND deleted_at IS NULL", orderID).Scan(&order)
+	if result.Error != nil {
+		return domain.Order{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Order{}, domain.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// UpdateOrderStatus moves an order from one status to another and records the
+// change in the status history. The update only applies while the order is
+// still in the from status, so concurrent changes cannot skip a validation.
+func (or *orderRepository) UpdateOrderStatus(orderID int, from, to, actor string) error {
+	return or.DB.Transaction(func(tx *gorm.DB) error {
+		return changeStatus(tx, orderID, from, to, actor)
+	})
+}
+
+// CancelOrder marks an order cancelled together with its new payment status,
+// marks its stock to be put back and cancels the payments still waiting for
+// the customer. An order marked for refund gets a refund of its full price.
+func (or *orderRepository) CancelOrder(orderID int, from, paymentStatus, actor string) error {
+	return or.DB.Transaction(func(tx *gorm.DB) error {
+		if err := changeStatus(tx, orderID, from, domain.OrderStatusCancelled, actor); err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE orders SET restock_pending = true WHERE id = ?", orderID).Error; err != nil {
+			return err
+		}
+		if paymentStatus == domain.PaymentStatusRefundPending {
+			if err := addOrderRefund(tx, orderID); err != nil {
+				return err
+			}
+		} else if err := tx.Exec("UPDATE orders SET payment_status = ? WHERE id = ?", paymentStatus, orderID).Error; err != nil {
+			return err
+		}
+		return cancelPendingPayments(tx, orderID)
+	})
+}
+
+// ClaimRestock clears the restock mark of a cancelled order and reports
+// whether this call cleared it. Only the caller that claims the mark puts the
+// stock back, so concurrent and repeated cancellations restock once.
+func (or *orderRepository) ClaimRestock(orderID int) (bool, error) {
+	result := or.DB.Exec("UPDATE orders SET restock_pending = false WHERE id = ? AND restock_pending", orderID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UnclaimRestock marks the order for restocking again after a claimed
+// restock failed.
+func (or *orderRepository) UnclaimRestock(orderID int) error {
+	return or.DB.Exec("UPDATE orders SET restock_pending = true WHERE id = ?", orderID).Error
+}
+
+func (or *orderRepository) GetOrderItems(orderID int) ([]domain.OrderItem, error) {
+	var items []domain.OrderItem
+	if err := or.DB.Raw("SELECT * FROM order_items WHERE order_id = ?", orderID).Scan(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (or *orderRepository) GetOrderTimeline(orderID int) ([]models.OrderStatusEvent, error) {
+	var events []models.OrderStatusEvent
+	err := or.DB.Raw(`SELECT from_status, to_status, actor, created_at FROM order_status_histories
+	WHERE order_id = ? ORDER BY created_at, id`, orderID).Scan(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func changeStatus(tx *gorm.DB, orderID int, from, to, actor string) error {
+	result := tx.Exec("UPDATE orders SET shipment_status = ?, updated_at = NOW() WHERE id = ? AND shipment_status = ?", to, orderID, from)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrInvalidStatusTransition
+	}
+	return addStatusHistory(tx, orderID, from, to, actor)
+}
+
+func addStatusHistory(tx *gorm.DB, orderID int, from, to, actor string) error {
+	return tx.Exec(`INSERT INTO order_status_histories (order_id, from_status, to_status, actor, created_at)
+	VALUES (?, ?, ?, ?, NOW())`, orderID, from, to, actor).Error
+}
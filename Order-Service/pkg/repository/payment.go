@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"order-service/pkg/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (or *orderRepository) GetPaymentMethod(id int) (domain.PaymentMethod, error) {
+	var method domain.PaymentMethod
+	result := or.DB.Raw("SELECT * FROM payment_methods WHERE id = ?", id).Scan(&method)
+	if result.Error != nil {
+		return domain.PaymentMethod{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.PaymentMethod{}, domain.ErrPaymentMethodNotFound
+	}
+	return method, nil
+}
+
+func (or *orderRepository) CreatePaymentIntent(intent domain.PaymentIntent) (domain.PaymentIntent, error) {
+	if err := or.DB.Create(&intent).Error; err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	return intent, nil
+}
+
+// SetPaymentReference records where the provider opened the payment.
+func (or *orderRepository) SetPaymentReference(intentID int, reference, checkoutURL string) error {
+	return or.DB.Exec("UPDATE payment_intents SET provider_ref = ?, checkout_url = ?, updated_at = NOW() WHERE id = ?",
+		reference, checkoutURL, intentID).Error
+}
+
+func (or *orderRepository) GetPaymentIntent(id int) (domain.PaymentIntent, error) {
+	var intent domain.PaymentIntent
+	result := or.DB.Raw("SELECT * FROM payment_intents WHERE id = ?", id).Scan(&intent)
+	if result.Error != nil {
+		return domain.PaymentIntent{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.PaymentIntent{}, domain.ErrPaymentNotFound
+	}
+	return intent, nil
+}
+
+func (or *orderRepository) GetPaymentIntentByReference(provider, reference string) (domain.PaymentIntent, error) {
+	var intent domain.PaymentIntent
+	result := or.DB.Raw("SELECT * FROM payment_intents WHERE provider = ? AND provider_ref = ?", provider, reference).Scan(&intent)
+	if result.Error != nil {
+		return domain.PaymentIntent{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.PaymentIntent{}, domain.ErrPaymentNotFound
+	}
+	return intent, nil
+}
+
+func (or *orderRepository) ListOrderPayments(orderID int) ([]domain.PaymentIntent, error) {
+	var intents []domain.PaymentIntent
+	if err := or.DB.Raw("SELECT * FROM payment_intents WHERE order_id = ? ORDER BY created_at, id", orderID).Scan(&intents).Error; err != nil {
+		return nil, err
+	}
+	return intents, nil
+}
+
+func (or *orderRepository) CancelPendingPayments(orderID int) error {
+	return cancelPendingPayments(or.DB, orderID)
+}
+
+// SettlePayment moves a payment intent to its outcome and updates the payment
+// status of its order to match. It reports whether anything changed: an event
+// that was applied before, or an outcome for an intent that was already
+// settled, leaves everything as it is. Money that arrives for an order that
+// was cancelled or already paid is recorded as a refund.
+func (or *orderRepository) SettlePayment(intentID int, status, reason string, event *domain.PaymentEvent) (bool, error) {
+	changed := false
+	err := or.DB.Transaction(func(tx *gorm.DB) error {
+		if event != nil {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+		}
+
+		var intent domain.PaymentIntent
+		result := tx.Raw("SELECT * FROM payment_intents WHERE id = ? FOR UPDATE", intentID).Scan(&intent)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrPaymentNotFound
+		}
+		if !settles(intent.Status, status) {
+			return nil
+		}
+		if err := tx.Exec("UPDATE payment_intents SET status = ?, failure_reason = ?, updated_at = NOW() WHERE id = ?",
+			status, reason, intentID).Error; err != nil {
+			return err
+		}
+		changed = true
+
+		var order domain.Order
+		if err := tx.Raw("SELECT * FROM orders WHERE id = ? FOR UPDATE", intent.OrderID).Scan(&order).Error; err != nil {
+			return err
+		}
+		paymentStatus, refund := settledOrder(order, status)
+		if refund {
+			if err := tx.Create(&domain.Refund{
+				OrderID: intent.OrderID,
+				Amount:  intent.Amount,
+				Status:  domain.RefundStatusPending,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		if paymentStatus == "" {
+			return nil
+		}
+		return setPaymentStatus(tx, int(intent.OrderID), paymentStatus)
+	})
+	if err != nil {
+		return false, err
+	}
+	return changed, nil
+}
+
+// settles reports whether an intent in status current takes the outcome
+// status. Only a pending intent has an outcome to learn, except that a payment
+// the customer completed after it was cancelled still took their money, so a
+// repeated outcome changes nothing.
+func settles(current, status string) bool {
+	return current == domain.IntentStatusPending ||
+		current == domain.IntentStatusCancelled && status == domain.IntentStatusSucceeded
+}
+
+// settledOrder returns the payment status an order gets when one of its
+// payments settles with status, or "" to leave it as it is, and whether the
+// money taken has to be refunded: a cancelled or failed order does not need
+// it, and an order that is already paid was paid twice.
+func settledOrder(order domain.Order, status string) (string, bool) {
+	switch status {
+	case domain.IntentStatusSucceeded:
+		if order.ShipmentStatus == domain.OrderStatusCancelled || order.ShipmentStatus == domain.OrderStatusFailed ||
+			order.PaymentStatus == domain.PaymentStatusPaid || order.PaymentStatus == domain.PaymentStatusRefundPending {
+			return domain.PaymentStatusRefundPending, true
+		}
+		return domain.PaymentStatusPaid, false
+	case domain.IntentStatusFailed:
+		if order.PaymentStatus == domain.PaymentStatusNotPaid {
+			return domain.PaymentStatusFailed, false
+		}
+	}
+	return "", false
+}
+
+func cancelPendingPayments(tx *gorm.DB, orderID int) error {
+	return tx.Exec("UPDATE payment_intents SET status = ?, updated_at = NOW() WHERE order_id = ? AND status = ?",
+		domain.IntentStatusCancelled, orderID, domain.IntentStatusPending).Error
+}
+
+func setPaymentStatus(tx *gorm.DB, orderID int, status string) error {
+	return tx.Exec("UPDATE orders SET payment_status = ?, updated_at = NOW() WHERE id = ?", status, orderID).Error
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"order-service/pkg/domain"
+	"testing"
+)
+
+func TestSettles(t *testing.T) {
+	tests := []struct {
+		name            string
+		current, status string
+		want            bool
+	}{
+		{"pending payment succeeds", domain.IntentStatusPending, domain.IntentStatusSucceeded, true},
+		{"pending payment fails", domain.IntentStatusPending, domain.IntentStatusFailed, true},
+		{"duplicate success", domain.IntentStatusSucceeded, domain.IntentStatusSucceeded, false},
+		{"duplicate failure", domain.IntentStatusFailed, domain.IntentStatusFailed, false},
+		{"failure after success", domain.IntentStatusSucceeded, domain.IntentStatusFailed, false},
+		{"success after failure", domain.IntentStatusFailed, domain.IntentStatusSucceeded, false},
+		{"cancelled payment completed anyway", domain.IntentStatusCancelled, domain.IntentStatusSucceeded, true},
+		{"cancelled payment fails", domain.IntentStatusCancelled, domain.IntentStatusFailed, false},
+	}
+	for _, tt := range tests {
+		if got := settles(tt.current, tt.status); got != tt.want {
+			t.Errorf("%s: settles(%q, %q) = %v, want %v", tt.name, tt.current, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestSettledOrder(t *testing.T) {
+	tests := []struct {
+		name              string
+		shipment, payment string
+		status            string
+		wantPayment       string
+		wantRefund        bool
+	}{
+		{"unpaid order paid", domain.OrderStatusPending, domain.PaymentStatusNotPaid, domain.IntentStatusSucceeded, domain.PaymentStatusPaid, false},
+		{"order paid after a failed attempt", domain.OrderStatusPending, domain.PaymentStatusFailed, domain.IntentStatusSucceeded, domain.PaymentStatusPaid, false},
+		{"order paid twice", domain.OrderStatusPending, domain.PaymentStatusPaid, domain.IntentStatusSucceeded, domain.PaymentStatusRefundPending, true},
+		{"cancelled order paid", domain.OrderStatusCancelled, domain.PaymentStatusNotPaid, domain.IntentStatusSucceeded, domain.PaymentStatusRefundPending, true},
+		{"failed order paid", domain.OrderStatusFailed, domain.PaymentStatusNotPaid, domain.IntentStatusSucceeded, domain.PaymentStatusRefundPending, true},
+		{"unpaid order payment fails", domain.OrderStatusPending, domain.PaymentStatusNotPaid, domain.IntentStatusFailed, domain.PaymentStatusFailed, false},
+		{"paid order payment fails", domain.OrderStatusPending, domain.PaymentStatusPaid, domain.IntentStatusFailed, "", false},
+	}
+	for _, tt := range tests {
+		order := domain.Order{ShipmentStatus: tt.shipment, PaymentStatus: tt.payment}
+		payment, refund := settledOrder(order, tt.status)
+		if payment != tt.wantPayment || refund != tt.wantRefund {
+			t.Errorf("%s: settledOrder = (%q, %v), want (%q, %v)", tt.name, payment, refund, tt.wantPayment, tt.wantRefund)
+		}
+	}
+}
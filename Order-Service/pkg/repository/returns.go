@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"order-service/pkg/domain"
+
+	"gorm.io/gorm"
+)
+
+func (or *orderRepository) GetOrderItem(orderID, productID int) (domain.OrderItem, error) {
+	var item domain.OrderItem
+	result := or.DB.Raw("SELECT * FROM order_items WHERE order_id = ? AND product_id = ?", orderID, productID).Scan(&item)
+	if result.Error != nil {
+		return domain.OrderItem{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.OrderItem{}, domain.ErrOrderItemNotFound
+	}
+	return item, nil
+}
+
+// HasOpenReturn reports whether an order item already has a return that is
+// waiting for review or was approved.
+func (or *orderRepository) HasOpenReturn(orderItemID int) (bool, error) {
+	var count int
+	err := or.DB.Raw("SELECT COUNT(*) FROM return_requests WHERE order_item_id = ? AND status IN (?, ?)",
+		orderItemID, domain.ReturnStatusRequested, domain.ReturnStatusApproved).Scan(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (or *orderRepository) CreateReturnRequest(request domain.ReturnRequest) (domain.ReturnRequest, error) {
+	request.Status = domain.ReturnStatusRequested
+	if err := or.DB.Create(&request).Error; err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	return request, nil
+}
+
+func (or *orderRepository) GetReturnRequest(returnID int) (domain.ReturnRequest, error) {
+	var request domain.ReturnRequest
+	result := or.DB.Raw("SELECT * FROM return_requests WHERE id = ?", returnID).Scan(&request)
+	if result.Error != nil {
+		return domain.ReturnRequest{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ReturnRequest{}, domain.ErrReturnNotFound
+	}
+	return request, nil
+}
+
+// ListReturnRequests lists return requests, newest first. A zero userID or an
+// empty status does not filter on that column.
+func (or *orderRepository) ListReturnRequests(userID int, status string, page, count int) ([]domain.ReturnRequest, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	query := or.DB.Model(&domain.ReturnRequest{})
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var requests []domain.ReturnRequest
+	if err := query.Order("created_at DESC").Limit(count).Offset(offset).Find(&requests).Error; err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// ApproveReturn approves a pending return. When the order was paid for, the
+// refund is recorded and the order payment is marked for refund. Once every
+// item of the order is returned, the order itself moves to returned.
+func (or *orderRepository) ApproveReturn(request domain.ReturnRequest, actor, note string) error {
+	return or.DB.Transaction(func(tx *gorm.DB) error {
+		var paymentStatus string
+		if err := tx.Raw("SELECT payment_status FROM orders WHERE id = ? FOR UPDATE", request.OrderID).Scan(&paymentStatus).Error; err != nil {
+			return err
+		}
+		if err := reviewReturn(tx, int(request.ID), domain.ReturnStatusApproved, actor, note); err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE return_requests SET restock_pending = true WHERE id = ?", request.ID).Error; err != nil {
+			return err
+		}
+		if domain.PaymentCaptured(paymentStatus) {
+			refund := domain.Refund{
+				OrderID:         request.OrderID,
+				ReturnRequestID: &request.ID,
+				Amount:          request.Amount,
+				Status:          domain.RefundStatusPending,
+			}
+			if err := tx.Create(&refund).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("UPDATE orders SET payment_status = ? WHERE id = ?", domain.PaymentStatusRefundPending, request.OrderID).Error; err != nil {
+				return err
+			}
+		}
+
+		var remaining int
+		err := tx.Raw(`SELECT COUNT(*) FROM order_items WHERE order_id = ? AND id NOT IN
+		(SELECT order_item_id FROM return_requests WHERE order_id = ? AND status = ?)`,
+			request.OrderID, request.OrderID, domain.ReturnStatusApproved).Scan(&remaining).Error
+		if err != nil {
+			return err
+		}
+		if remaining == 0 {
+			return changeStatus(tx, int(request.OrderID), domain.OrderStatusDelivered, domain.OrderStatusReturned, actor)
+		}
+		return nil
+	})
+}
+
+// ClaimReturnRestock clears the restock mark of an approved return and
+// reports whether this call cleared it, so the item goes back into stock once.
+func (or *orderRepository) ClaimReturnRestock(returnID int) (bool, error) {
+	result := or.DB.Exec("UPDATE return_requests SET restock_pending = false WHERE id = ? AND restock_pending", returnID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UnclaimReturnRestock marks the return for restocking again after a claimed
+// restock failed.
+func (or *orderRepository) UnclaimReturnRestock(returnID int) error {
+	return or.DB.Exec("UPDATE return_requests SET restock_pending = true WHERE id = ?", returnID).Error
+}
+
+func (or *orderRepository) RejectReturn(returnID int, actor, note string) error {
+	return reviewReturn(or.DB, returnID, domain.ReturnStatusRejected, actor, note)
+}
+
+// reviewReturn sets the outcome of a return that is still waiting for review.
+func reviewReturn(tx *gorm.DB, returnID int, status, actor, note string) error {
+	result := tx.Exec(`UPDATE return_requests SET status = ?, reviewed_by = ?, review_note = ?, updated_at = NOW()
+	WHERE id = ? AND status = ?`, status, actor, note, returnID, domain.ReturnStatusRequested)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrReturnAlreadyReviewed
+	}
+	return nil
+}
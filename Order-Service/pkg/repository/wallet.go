@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"fmt"
+	"math"
+	"order-service/pkg/domain"
+
+	"gorm.io/gorm"
+)
+
+// AddWalletTransaction appends an entry to a user's wallet ledger and returns
+// it with the balance it leaves. The entries of one user are written one at a
+// time, so concurrent debits cannot overdraw the wallet. An entry whose
+// reference was used before is not written again; the earlier entry is
+// returned instead.
+func (or *orderRepository) AddWalletTransaction(entry domain.WalletTransaction) (domain.WalletTransaction, error) {
+	err := or.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		entry, err = addWalletTransaction(tx, entry)
+		return err
+	})
+	if err != nil {
+		return domain.WalletTransaction{}, err
+	}
+	return entry, nil
+}
+
+// ReverseWalletTransaction credits back the debit written under reference,
+// once. A reference without a debit reverses nothing, so a payment can be
+// reversed without knowing whether its debit was written.
+func (or *orderRepository) ReverseWalletTransaction(reference string) error {
+	return or.DB.Transaction(func(tx *gorm.DB) error {
+		var debit domain.WalletTransaction
+		result := tx.Raw("SELECT * FROM wallet_transactions WHERE reference = ? AND type = ?", reference, domain.WalletDebit).Scan(&debit)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		_, err := addWalletTransaction(tx, domain.WalletTransaction{
+			UserID:    debit.UserID,
+			Type:      domain.WalletCredit,
+			Amount:    debit.Amount,
+			Reason:    "reversal",
+			Reference: "reversal:" + reference,
+		})
+		return err
+	})
+}
+
+func (or *orderRepository) WalletBalance(userID int) (float64, error) {
+	return walletBalance(or.DB, userID)
+}
+
+func (or *orderRepository) ListWalletTransactions(userID, page, count int) ([]domain.WalletTransaction, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	var entries []domain.WalletTransaction
+	err := or.DB.Where("user_id = ?", userID).Order("created_at DESC, id DESC").Limit(count).Offset(offset).Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (or *orderRepository) PendingRefunds(orderID int) ([]domain.Refund, error) {
+	var refunds []domain.Refund
+	err := or.DB.Raw("SELECT * FROM refunds WHERE order_id = ? AND status = ? ORDER BY id", orderID, domain.RefundStatusPending).Scan(&refunds).Error
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+// CreditRefund pays a pending refund out to the wallet of the order's
+// customer. Once no refund of the order is pending, the order is marked
+// refunded. Crediting a refund that was paid out already does nothing. A
+// refund is only paid out while the payments that went through for the order
+// cover it together with the refunds paid out before.
+func (or *orderRepository) CreditRefund(refundID int) error {
+	return or.DB.Transaction(func(tx *gorm.DB) error {
+		var refund domain.Refund
+		result := tx.Raw("SELECT * FROM refunds WHERE id = ? FOR UPDATE", refundID).Scan(&refund)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 || refund.Status != domain.RefundStatusPending {
+			return nil
+		}
+		var userID int
+		if err := tx.Raw("SELECT user_id FROM orders WHERE id = ? FOR UPDATE", refund.OrderID).Scan(&userID).Error; err != nil {
+			return err
+		}
+		var totals struct {
+			Captured float64
+			Credited float64
+		}
+		err := tx.Raw(`SELECT
+		(SELECT COALESCE(SUM(amount), 0) FROM payment_intents WHERE order_id = ? AND status = ?) AS captured,
+		(SELECT COALESCE(SUM(amount), 0) FROM refunds WHERE order_id = ? AND status = ?) AS credited`,
+			refund.OrderID, domain.IntentStatusSucceeded, refund.OrderID, domain.RefundStatusCredited).Scan(&totals).Error
+		if err != nil {
+			return err
+		}
+		if totals.Credited+refund.Amount > totals.Captured+0.005 {
+			return fmt.Errorf("%w: refund %d of %.2f, captured %.2f, refunded %.2f",
+				domain.ErrRefundNotCaptured, refund.ID, refund.Amount, totals.Captured, totals.Credited)
+		}
+
+		reason := "cancellation"
+		if refund.ReturnRequestID != nil {
+			reason = "return"
+		}
+		_, err = addWalletTransaction(tx, domain.WalletTransaction{
+			UserID:    userID,
+			Type:      domain.WalletCredit,
+			Amount:    refund.Amount,
+			Reason:    reason,
+			Reference: fmt.Sprintf("refund:%d", refund.ID),
+		})
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE refunds SET status = ? WHERE id = ?", domain.RefundStatusCredited, refund.ID).Error; err != nil {
+			return err
+		}
+
+		var pending int
+		if err := tx.Raw("SELECT COUNT(*) FROM refunds WHERE order_id = ? AND status = ?", refund.OrderID, domain.RefundStatusPending).Scan(&pending).Error; err != nil {
+			return err
+		}
+		if pending == 0 {
+			return setPaymentStatus(tx, int(refund.OrderID), domain.PaymentStatusRefunded)
+		}
+		return nil
+	})
+}
+
+// RefundPaidOrder records a refund of the full price of an order that was
+// paid, for orders that could not be placed after the payment went through.
+func (or *orderRepository) RefundPaidOrder(orderID int) error {
+	return or.DB.Transaction(func(tx *gorm.DB) error {
+		var paymentStatus string
+		if err := tx.Raw("SELECT payment_status FROM orders WHERE id = ? FOR UPDATE", orderID).Scan(&paymentStatus).Error; err != nil {
+			return err
+		}
+		if paymentStatus != domain.PaymentStatusPaid {
+			return nil
+		}
+		return addOrderRefund(tx, orderID)
+	})
+}
+
+// addOrderRefund records a refund of the full price of an order and marks its
+// payment for refund.
+func addOrderRefund(tx *gorm.DB, orderID int) error {
+	err := tx.Exec(`INSERT INTO refunds (order_id, amount, status, created_at)
+	SELECT id, final_price, ?, NOW() FROM orders WHERE id = ?`, domain.RefundStatusPending, orderID).Error
+	if err != nil {
+		return err
+	}
+	return setPaymentStatus(tx, orderID, domain.PaymentStatusRefundPending)
+}
+
+func addWalletTransaction(tx *gorm.DB, entry domain.WalletTransaction) (domain.WalletTransaction, error) {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('wallet'), ?)", entry.UserID).Error; err != nil {
+		return domain.WalletTransaction{}, err
+	}
+	var existing domain.WalletTransaction
+	result := tx.Raw("SELECT * FROM wallet_transactions WHERE reference = ?", entry.Reference).Scan(&existing)
+	if result.Error != nil {
+		return domain.WalletTransaction{}, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return existing, nil
+	}
+
+	entry.Amount = math.Round(entry.Amount*100) / 100
+	balance, err := walletBalance(tx, entry.UserID)
+	if err != nil {
+		return domain.WalletTransaction{}, err
+	}
+	entry.BalanceAfter, err = applyWalletEntry(balance, entry)
+	if err != nil {
+		return domain.WalletTransaction{}, err
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return domain.WalletTransaction{}, err
+	}
+	return entry, nil
+}
+
+// applyWalletEntry returns the balance of a wallet after the entry. A debit
+// may not take the balance below zero.
+func applyWalletEntry(balance float64, entry domain.WalletTransaction) (float64, error) {
+	if entry.Type == domain.WalletDebit {
+		if balance < entry.Amount {
+			return 0, domain.ErrInsufficientBalance
+		}
+		balance -= entry.Amount
+	} else {
+		balance += entry.Amount
+	}
+	return math.Round(balance*100) / 100, nil
+}
+
+func walletBalance(tx *gorm.DB, userID int) (float64, error) {
+	var balance float64
+	err := tx.Raw(`SELECT COALESCE(SUM(CASE WHEN type = ? THEN amount ELSE -amount END), 0)
+	FROM wallet_transactions WHERE user_id = ?`, domain.WalletCredit, userID).Scan(&balance).Error
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(balance*100) / 100, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"errors"
+	"order-service/pkg/domain"
+	"testing"
+)
+
+func TestApplyWalletEntry(t *testing.T) {
+	credit := func(amount float64) domain.WalletTransaction {
+		return domain.WalletTransaction{Type: domain.WalletCredit, Amount: amount}
+	}
+	debit := func(amount float64) domain.WalletTransaction {
+		return domain.WalletTransaction{Type: domain.WalletDebit, Amount: amount}
+	}
+	tests := []struct {
+		name        string
+		entries     []domain.WalletTransaction
+		wantBalance float64
+		wantErr     error
+	}{
+		{"credit then debit", []domain.WalletTransaction{credit(100), debit(30.25)}, 69.75, nil},
+		{"debit the whole balance", []domain.WalletTransaction{credit(49.99), debit(49.99)}, 0, nil},
+		{"debit more than the balance", []domain.WalletTransaction{credit(20), debit(20.01)}, 20, domain.ErrInsufficientBalance},
+		{"debit an empty wallet", []domain.WalletTransaction{debit(1)}, 0, domain.ErrInsufficientBalance},
+		{"cents add up", []domain.WalletTransaction{credit(0.1), credit(0.2), debit(0.3)}, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balance := 0.0
+			var err error
+			for _, entry := range tt.entries {
+				var next float64
+				next, err = applyWalletEntry(balance, entry)
+				if err != nil {
+					break
+				}
+				balance = next
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if balance != tt.wantBalance {
+				t.Errorf("balance = %.2f, want %.2f", balance, tt.wantBalance)
+			}
+		})
+	}
+}
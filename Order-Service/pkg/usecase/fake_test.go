@@ -0,0 +1,456 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"order-service/pkg/repository/interfaces"
+)
+
+// fakeOrderRepository keeps orders, payments and the wallet ledger in memory
+// for the use case tests. Methods a test does not need panic through the
+// embedded nil interface.
+type fakeOrderRepository struct {
+	interfaces.OrderRepository
+
+	orders  map[int]*domain.Order
+	items   map[int][]domain.OrderItem
+	intents []*domain.PaymentIntent
+	events  map[string]bool
+	refunds []*domain.Refund
+	wallet  []domain.WalletTransaction
+	methods map[int]string
+	returns map[int]*domain.ReturnRequest
+
+	// settleErr, when set, fails every SettlePayment.
+	settleErr error
+}
+
+func newFakeOrderRepository() *fakeOrderRepository {
+	return &fakeOrderRepository{
+		orders:  make(map[int]*domain.Order),
+		items:   make(map[int][]domain.OrderItem),
+		events:  make(map[string]bool),
+		returns: make(map[int]*domain.ReturnRequest),
+		methods: map[int]string{
+			1: domain.PaymentMethodOnline,
+			2: domain.PaymentMethodCOD,
+			3: domain.PaymentMethodWallet,
+		},
+	}
+}
+
+func (f *fakeOrderRepository) addOrder(order domain.Order, items ...domain.OrderItem) {
+	f.orders[int(order.ID)] = &order
+	f.items[int(order.ID)] = items
+}
+
+func (f *fakeOrderRepository) AddressExist(orderBody models.OrderIncoming) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeOrderRepository) CreateOrder(ob models.OrderIncoming, total models.CartTotal, cart []models.Cart) (int, error) {
+	id := len(f.orders) + 1
+	order := domain.Order{
+		UserID:         ob.UserID,
+		ShipmentStatus: domain.OrderStatusPending,
+		PaymentStatus:  domain.PaymentStatusNotPaid,
+		FinalPrice:     total.FinalPrice,
+	}
+	order.ID = uint(id)
+	var items []domain.OrderItem
+	for _, c := range cart {
+		items = append(items, domain.OrderItem{OrderID: uint(id), ProductID: c.ProductID, Quantity: c.Quantity})
+	}
+	f.addOrder(order, items...)
+	return id, nil
+}
+
+func (f *fakeOrderRepository) UpdateOrderStatus(orderID int, from, to, actor string) error {
+	order := f.orders[orderID]
+	if order.ShipmentStatus != from {
+		return domain.ErrInvalidStatusTransition
+	}
+	order.ShipmentStatus = to
+	return nil
+}
+
+func (f *fakeOrderRepository) GetBriefOrderDetails(orderID int) (domain.OrderSuccessResponse, error) {
+	order := f.orders[orderID]
+	return domain.OrderSuccessResponse{
+		OrderID:        order.ID,
+		ShipmentStatus: order.ShipmentStatus,
+		PaymentStatus:  order.PaymentStatus,
+	}, nil
+}
+
+func (f *fakeOrderRepository) GetOrder(orderID int) (domain.Order, error) {
+	order, ok := f.orders[orderID]
+	if !ok {
+		return domain.Order{}, domain.ErrOrderNotFound
+	}
+	return *order, nil
+}
+
+func (f *fakeOrderRepository) PaymentStatus(orderID int) (string, error) {
+	order, err := f.GetOrder(orderID)
+	return order.PaymentStatus, err
+}
+
+func (f *fakeOrderRepository) CancelOrder(orderID int, from, paymentStatus, actor string) error {
+	order := f.orders[orderID]
+	if order.ShipmentStatus != from {
+		return domain.ErrInvalidStatusTransition
+	}
+	order.ShipmentStatus = domain.OrderStatusCancelled
+	order.PaymentStatus = paymentStatus
+	order.RestockPending = true
+	if paymentStatus == domain.PaymentStatusRefundPending {
+		f.refunds = append(f.refunds, &domain.Refund{
+			ID:      uint(len(f.refunds) + 1),
+			OrderID: uint(orderID),
+			Amount:  order.FinalPrice,
+			Status:  domain.RefundStatusPending,
+		})
+	}
+	return f.CancelPendingPayments(orderID)
+}
+
+func (f *fakeOrderRepository) ClaimRestock(orderID int) (bool, error) {
+	order := f.orders[orderID]
+	if !order.RestockPending {
+		return false, nil
+	}
+	order.RestockPending = false
+	return true, nil
+}
+
+func (f *fakeOrderRepository) UnclaimRestock(orderID int) error {
+	f.orders[orderID].RestockPending = true
+	return nil
+}
+
+func (f *fakeOrderRepository) GetReturnRequest(returnID int) (domain.ReturnRequest, error) {
+	request, ok := f.returns[returnID]
+	if !ok {
+		return domain.ReturnRequest{}, domain.ErrReturnNotFound
+	}
+	return *request, nil
+}
+
+func (f *fakeOrderRepository) ApproveReturn(request domain.ReturnRequest, actor, note string) error {
+	stored := f.returns[int(request.ID)]
+	if stored.Status != domain.ReturnStatusRequested {
+		return domain.ErrReturnAlreadyReviewed
+	}
+	stored.Status = domain.ReturnStatusApproved
+	stored.RestockPending = true
+	order := f.orders[int(request.OrderID)]
+	if domain.PaymentCaptured(order.PaymentStatus) {
+		f.refunds = append(f.refunds, &domain.Refund{
+			ID:              uint(len(f.refunds) + 1),
+			OrderID:         request.OrderID,
+			ReturnRequestID: &stored.ID,
+			Amount:          request.Amount,
+			Status:          domain.RefundStatusPending,
+		})
+		order.PaymentStatus = domain.PaymentStatusRefundPending
+	}
+	return nil
+}
+
+func (f *fakeOrderRepository) RejectReturn(returnID int, actor, note string) error {
+	stored := f.returns[returnID]
+	if stored.Status != domain.ReturnStatusRequested {
+		return domain.ErrReturnAlreadyReviewed
+	}
+	stored.Status = domain.ReturnStatusRejected
+	return nil
+}
+
+func (f *fakeOrderRepository) ClaimReturnRestock(returnID int) (bool, error) {
+	request := f.returns[returnID]
+	if !request.RestockPending {
+		return false, nil
+	}
+	request.RestockPending = false
+	return true, nil
+}
+
+func (f *fakeOrderRepository) UnclaimReturnRestock(returnID int) error {
+	f.returns[returnID].RestockPending = true
+	return nil
+}
+
+func (f *fakeOrderRepository) GetOrderItems(orderID int) ([]domain.OrderItem, error) {
+	return f.items[orderID], nil
+}
+
+func (f *fakeOrderRepository) GetPaymentMethod(id int) (domain.PaymentMethod, error) {
+	name, ok := f.methods[id]
+	if !ok {
+		return domain.PaymentMethod{}, domain.ErrPaymentMethodNotFound
+	}
+	return domain.PaymentMethod{ID: uint(id), Payment_Name: name}, nil
+}
+
+func (f *fakeOrderRepository) CreatePaymentIntent(intent domain.PaymentIntent) (domain.PaymentIntent, error) {
+	intent.ID = uint(len(f.intents) + 1)
+	f.intents = append(f.intents, &intent)
+	return intent, nil
+}
+
+func (f *fakeOrderRepository) SetPaymentReference(intentID int, reference, checkoutURL string) error {
+	intent := f.intents[intentID-1]
+	intent.ProviderRef = reference
+	intent.CheckoutURL = checkoutURL
+	return nil
+}
+
+func (f *fakeOrderRepository) GetPaymentIntent(id int) (domain.PaymentIntent, error) {
+	if id < 1 || id > len(f.intents) {
+		return domain.PaymentIntent{}, domain.ErrPaymentNotFound
+	}
+	return *f.intents[id-1], nil
+}
+
+func (f *fakeOrderRepository) GetPaymentIntentByReference(provider, reference string) (domain.PaymentIntent, error) {
+	for _, intent := range f.intents {
+		if intent.Provider == provider && intent.ProviderRef == reference {
+			return *intent, nil
+		}
+	}
+	return domain.PaymentIntent{}, domain.ErrPaymentNotFound
+}
+
+func (f *fakeOrderRepository) ListOrderPayments(orderID int) ([]domain.PaymentIntent, error) {
+	var intents []domain.PaymentIntent
+	for _, intent := range f.intents {
+		if int(intent.OrderID) == orderID {
+			intents = append(intents, *intent)
+		}
+	}
+	return intents, nil
+}
+
+func (f *fakeOrderRepository) CancelPendingPayments(orderID int) error {
+	for _, intent := range f.intents {
+		if int(intent.OrderID) == orderID && intent.Status == domain.IntentStatusPending {
+			intent.Status = domain.IntentStatusCancelled
+		}
+	}
+	return nil
+}
+
+// SettlePayment follows the rules of the Postgres repository: an event is
+// applied once, only a pending (or a cancelled but completed) intent takes an
+// outcome, and money for an order that does not need it is refunded.
+func (f *fakeOrderRepository) SettlePayment(intentID int, status, reason string, event *domain.PaymentEvent) (bool, error) {
+	if f.settleErr != nil {
+		return false, f.settleErr
+	}
+	if event != nil {
+		key := event.Provider + "/" + event.EventID
+		if f.events[key] {
+			return false, nil
+		}
+		f.events[key] = true
+	}
+	intent := f.intents[intentID-1]
+	if intent.Status != domain.IntentStatusPending &&
+		!(intent.Status == domain.IntentStatusCancelled && status == domain.IntentStatusSucceeded) {
+		return false, nil
+	}
+	intent.Status = status
+	intent.FailureReason = reason
+	order := f.orders[int(intent.OrderID)]
+	switch status {
+	case domain.IntentStatusSucceeded:
+		if order.ShipmentStatus == domain.OrderStatusCancelled || order.ShipmentStatus == domain.OrderStatusFailed ||
+			order.PaymentStatus == domain.PaymentStatusPaid || order.PaymentStatus == domain.PaymentStatusRefundPending {
+			f.refunds = append(f.refunds, &domain.Refund{
+				ID:      uint(len(f.refunds) + 1),
+				OrderID: intent.OrderID,
+				Amount:  intent.Amount,
+				Status:  domain.RefundStatusPending,
+			})
+			order.PaymentStatus = domain.PaymentStatusRefundPending
+		} else {
+			order.PaymentStatus = domain.PaymentStatusPaid
+		}
+	case domain.IntentStatusFailed:
+		if order.PaymentStatus == domain.PaymentStatusNotPaid {
+			order.PaymentStatus = domain.PaymentStatusFailed
+		}
+	}
+	return true, nil
+}
+
+func (f *fakeOrderRepository) RefundPaidOrder(orderID int) error {
+	order := f.orders[orderID]
+	if order.PaymentStatus != domain.PaymentStatusPaid {
+		return nil
+	}
+	f.refunds = append(f.refunds, &domain.Refund{
+		ID:      uint(len(f.refunds) + 1),
+		OrderID: uint(orderID),
+		Amount:  order.FinalPrice,
+		Status:  domain.RefundStatusPending,
+	})
+	order.PaymentStatus = domain.PaymentStatusRefundPending
+	return nil
+}
+
+func (f *fakeOrderRepository) PendingRefunds(orderID int) ([]domain.Refund, error) {
+	var refunds []domain.Refund
+	for _, refund := range f.refunds {
+		if int(refund.OrderID) == orderID && refund.Status == domain.RefundStatusPending {
+			refunds = append(refunds, *refund)
+		}
+	}
+	return refunds, nil
+}
+
+func (f *fakeOrderRepository) CreditRefund(refundID int) error {
+	refund := f.refunds[refundID-1]
+	if refund.Status != domain.RefundStatusPending {
+		return nil
+	}
+	order := f.orders[int(refund.OrderID)]
+	if _, err := f.AddWalletTransaction(domain.WalletTransaction{
+		UserID:    order.UserID,
+		Type:      domain.WalletCredit,
+		Amount:    refund.Amount,
+		Reason:    "refund",
+		Reference: fmt.Sprintf("refund:%d", refund.ID),
+	}); err != nil {
+		return err
+	}
+	refund.Status = domain.RefundStatusCredited
+	order.PaymentStatus = domain.PaymentStatusRefunded
+	return nil
+}
+
+func (f *fakeOrderRepository) AddWalletTransaction(entry domain.WalletTransaction) (domain.WalletTransaction, error) {
+	for _, existing := range f.wallet {
+		if existing.Reference == entry.Reference {
+			return existing, nil
+		}
+	}
+	balance, _ := f.WalletBalance(entry.UserID)
+	if entry.Type == domain.WalletDebit {
+		if balance < entry.Amount {
+			return domain.WalletTransaction{}, domain.ErrInsufficientBalance
+		}
+		balance -= entry.Amount
+	} else {
+		balance += entry.Amount
+	}
+	entry.ID = uint(len(f.wallet) + 1)
+	entry.BalanceAfter = math.Round(balance*100) / 100
+	f.wallet = append(f.wallet, entry)
+	return entry, nil
+}
+
+func (f *fakeOrderRepository) ReverseWalletTransaction(reference string) error {
+	for _, debit := range f.wallet {
+		if debit.Reference == reference && debit.Type == domain.WalletDebit {
+			_, err := f.AddWalletTransaction(domain.WalletTransaction{
+				UserID:    debit.UserID,
+				Type:      domain.WalletCredit,
+				Amount:    debit.Amount,
+				Reason:    "reversal",
+				Reference: "reversal:" + reference,
+			})
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeOrderRepository) WalletBalance(userID int) (float64, error) {
+	balance := 0.0
+	for _, entry := range f.wallet {
+		if entry.UserID != userID {
+			continue
+		}
+		if entry.Type == domain.WalletCredit {
+			balance += entry.Amount
+		} else {
+			balance -= entry.Amount
+		}
+	}
+	return math.Round(balance*100) / 100, nil
+}
+
+// fakeCart and fakeProduct record the calls the use case makes to the cart
+// and product services.
+type fakeCart struct {
+	items    []models.Cart
+	total    models.CartTotal
+	redeemed []int
+	released []int
+	cleared  bool
+	clearErr error
+}
+
+func (f *fakeCart) GetAllItemsFromCart(userID int) ([]models.Cart, error) { return f.items, nil }
+func (f *fakeCart) DoesCartExist(userID int) (bool, error)                { return true, nil }
+func (f *fakeCart) TotalAmountInCart(userID int) (models.CartTotal, error) {
+	return f.total, nil
+}
+func (f *fakeCart) UpdateCartAfterOrder(userID, productID int, quantity float64) error { return nil }
+func (f *fakeCart) ClearCart(userID int, productIDs []int) error {
+	if f.clearErr != nil {
+		return f.clearErr
+	}
+	f.cleared = true
+	return nil
+}
+func (f *fakeCart) RedeemCoupon(userID, orderID int, code string, discount float64) error {
+	f.redeemed = append(f.redeemed, orderID)
+	return nil
+}
+func (f *fakeCart) ReleaseCoupon(orderID int) error {
+	f.released = append(f.released, orderID)
+	return nil
+}
+
+type fakeProduct struct {
+	restocked  []models.StockChange
+	restockErr error
+	// holds lists the calls on stock holds, such as "reserve cart:42".
+	holds     []string
+	commitErr error
+}
+
+func (f *fakeProduct) ProductStockMinus(productID, stock int) error { return nil }
+func (f *fakeProduct) ProductStockPlus(productID, stock int, change models.StockChange) error {
+	return f.ProductStockPlusBulk([]models.Cart{{ProductID: uint(productID), Quantity: float64(stock)}}, change)
+}
+func (f *fakeProduct) ProductStockMinusBulk(items []models.Cart, change models.StockChange) error {
+	return nil
+}
+func (f *fakeProduct) ProductStockPlusBulk(items []models.Cart, change models.StockChange) error {
+	if f.restockErr != nil {
+		return f.restockErr
+	}
+	f.restocked = append(f.restocked, change)
+	return nil
+}
+func (f *fakeProduct) ReserveStock(reference string, items []models.Cart) error {
+	f.holds = append(f.holds, "reserve "+reference)
+	return nil
+}
+func (f *fakeProduct) ReleaseStock(reference string, productIDs []int) error {
+	f.holds = append(f.holds, "release "+reference)
+	return nil
+}
+func (f *fakeProduct) CommitReservation(reference string, productIDs []int) error {
+	if f.commitErr != nil {
+		return f.commitErr
+	}
+	f.holds = append(f.holds, "commit "+reference)
+	return nil
+}
@@ -8,4 +8,16 @@ import (
 type OrderUseCase interface {
 	OrderItemsFromCart(orderFromCart models.OrderFromCart, userID int) (domain.OrderSuccessResponse, error)
 	GetOrderDetails(userId int, page int, count int) ([]models.FullOrderDetails, error)
+	UpdateOrderStatus(orderID int, status string, actor string) (domain.OrderSuccessResponse, error)
+	GetOrderTimeline(orderID int, userID int) (models.OrderTimeline, error)
+	CancelOrder(orderID int, userID int) (domain.OrderSuccessResponse, error)
+	RequestReturn(input models.ReturnRequestInput) (domain.ReturnRequest, error)
+	ListReturnRequests(userID int, status string, page, count int) ([]domain.ReturnRequest, error)
+	ReviewReturnRequest(review models.ReturnReview) (domain.ReturnRequest, error)
+	PayOrder(orderID, userID, paymentMethodID int) (domain.PaymentIntent, error)
+	GetOrderPayments(orderID, userID int) ([]domain.PaymentIntent, error)
+	HandlePaymentWebhook(webhook models.PaymentWebhook) (domain.PaymentIntent, error)
+	SimulatePayment(paymentID int, outcome string) (models.PaymentWebhook, error)
+	GetWallet(userID int) (models.Wallet, error)
+	ListWalletTransactions(userID, page, count int) ([]domain.WalletTransaction, error)
 }
@@ -2,11 +2,15 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
+	"log"
 	interfaceClient "order-service/pkg/client/interfaces"
 	"order-service/pkg/domain"
 	"order-service/pkg/models"
+	interfacePayment "order-service/pkg/payment/interfaces"
 	"order-service/pkg/repository/interfaces"
 	interfaceUse "order-service/pkg/usecase/interfaces"
+	"strings"
 
 	"github.com/jinzhu/copier"
 )
@@ -15,13 +19,19 @@ type orderUseCase struct {
 	orderRepository   interfaces.OrderRepository
 	cartRepository    interfaceClient.CartClient
 	productRepository interfaceClient.ProductClient
+	payments          map[string]interfacePayment.Provider
 }
 
-func NewOrderUseCase(repository interfaces.OrderRepository, cartRepo interfaceClient.CartClient, productRepo interfaceClient.ProductClient) interfaceUse.OrderUseCase {
+func NewOrderUseCase(repository interfaces.OrderRepository, cartRepo interfaceClient.CartClient, productRepo interfaceClient.ProductClient, providers []interfacePayment.Provider) interfaceUse.OrderUseCase {
+	payments := make(map[string]interfacePayment.Provider, len(providers))
+	for _, provider := range providers {
+		payments[provider.Method()] = provider
+	}
 	return &orderUseCase{
 		orderRepository:   repository,
 		cartRepository:    cartRepo,
 		productRepository: productRepo,
+		payments:          payments,
 	}
 }
 func (or *orderUseCase) OrderItemsFromCart(orderFromCart models.OrderFromCart, userID int) (domain.OrderSuccessResponse, error) {
@@ -48,15 +58,11 @@ func (or *orderUseCase) OrderItemsFromCart(orderFromCart models.OrderFromCart, u
 		return domain.OrderSuccessResponse{}, errors.New("address does not exist")
 	}
 
-	paymentExist, err := or.orderRepository.PaymentExist(orderBody)
+	provider, err := or.paymentProvider(orderBody.PaymentID)
 	if err != nil {
 		return domain.OrderSuccessResponse{}, err
 	}
 
-	if !paymentExist {
-		return domain.OrderSuccessResponse{}, errors.New("payment does not exist")
-	}
-
 	cartItems, err := or.cartRepository.GetAllItemsFromCart(orderBody.UserID)
 	if err != nil {
 		return domain.OrderSuccessResponse{}, err
@@ -67,12 +73,100 @@ func (or *orderUseCase) OrderItemsFromCart(orderFromCart models.OrderFromCart, u
 		return domain.OrderSuccessResponse{}, err
 	}
 
-	FinalPrice := total
-	order_id, err := or.orderRepository.OrderItems(orderBody, FinalPrice)
-	if err != nil {
-		return domain.OrderSuccessResponse{}, err
+	var order_id int
+	var payment domain.PaymentIntent
+	cartHold := fmt.Sprintf("cart:%d", userID)
+	productIDs := make([]int, 0, len(cartItems))
+	for _, c := range cartItems {
+		productIDs = append(productIDs, int(c.ProductID))
 	}
-	if err := or.orderRepository.AddOrderProducts(order_id, cartItems); err != nil {
+
+	// Placing an order spans the order, product and cart services. Each step
+	// is undone when a later one fails, and an order that could not be placed
+	// is kept as failed.
+	err = runSaga([]sagaStep{
+		{
+			name: "create order",
+			action: func() error {
+				id, err := or.orderRepository.CreateOrder(orderBody, total, cartItems)
+				order_id = id
+				return err
+			},
+			compensate: func() error {
+				return or.orderRepository.UpdateOrderStatus(order_id, domain.OrderStatusPending, domain.OrderStatusFailed, "system")
+			},
+		},
+		{
+			name: "redeem coupon",
+			action: func() error {
+				if total.CouponCode == "" {
+					return nil
+				}
+				return or.cartRepository.RedeemCoupon(userID, order_id, total.CouponCode, total.Discount)
+			},
+			compensate: func() error {
+				if total.CouponCode == "" {
+					return nil
+				}
+				return or.cartRepository.ReleaseCoupon(order_id)
+			},
+		},
+		{
+			// The cart holds its stock while the user shops. Holding it
+			// again covers holds that expired and quantities that changed
+			// since, without taking the stock a second time.
+			name: "hold stock",
+			action: func() error {
+				return or.productRepository.ReserveStock(cartHold, cartItems)
+			},
+			compensate: func() error {
+				return or.productRepository.ReleaseStock(cartHold, productIDs)
+			},
+		},
+		{
+			// Committing turns the hold into sold stock, so there is no hold
+			// left to release; putting the stock back undoes both steps.
+			name:    "commit stock",
+			settles: "hold stock",
+			action: func() error {
+				return or.productRepository.CommitReservation(cartHold, productIDs)
+			},
+			compensate: func() error {
+				return or.productRepository.ProductStockPlusBulk(cartItems, models.StockChange{
+					Reason:    "cancellation",
+					Reference: fmt.Sprintf("order:%d", order_id),
+					Actor:     "system",
+				})
+			},
+		},
+		{
+			name: "take payment",
+			action: func() error {
+				var err error
+				payment, err = or.startPayment(provider, order_id, userID, total.FinalPrice)
+				return err
+			},
+			compensate: func() error {
+				if err := or.reversePendingPayments(order_id); err != nil {
+					return err
+				}
+				if err := or.orderRepository.CancelPendingPayments(order_id); err != nil {
+					return err
+				}
+				if err := or.orderRepository.RefundPaidOrder(order_id); err != nil {
+					return err
+				}
+				return or.creditRefunds(order_id)
+			},
+		},
+		{
+			name: "clear cart",
+			action: func() error {
+				return or.cartRepository.ClearCart(userID, productIDs)
+			},
+		},
+	})
+	if err != nil {
 		return domain.OrderSuccessResponse{}, err
 	}
 
@@ -80,19 +174,7 @@ func (or *orderUseCase) OrderItemsFromCart(orderFromCart models.OrderFromCart, u
 	if err != nil {
 		return domain.OrderSuccessResponse{}, err
 	}
-	var orderItemDetails domain.OrderItem
-	for _, c := range cartItems {
-		orderItemDetails.ProductID = c.ProductID
-		orderItemDetails.Quantity = c.Quantity
-		err := or.cartRepository.UpdateCartAfterOrder(userID, int(orderItemDetails.ProductID), orderItemDetails.Quantity)
-		if err != nil {
-			return domain.OrderSuccessResponse{}, err
-		}
-		err = or.productRepository.ProductStockMinus(int(orderItemDetails.ProductID), int(orderItemDetails.Quantity))
-		if err != nil {
-			return domain.OrderSuccessResponse{}, err
-		}
-	}
+	orderSuccessResponse.Payment = &payment
 	return orderSuccessResponse, nil
 }
 func (or *orderUseCase) GetOrderDetails(userId int, page int, count int) ([]models.FullOrderDetails, error) {
@@ -104,3 +186,148 @@ func (or *orderUseCase) GetOrderDetails(userId int, page int, count int) ([]mode
 	return fullOrderDetails, nil
 
 }
+
+// UpdateOrderStatus advances an order to the given status if the state machine allows it.
+// Cancelled, returned and failed orders need their stock, payments and coupon
+// put back, so those statuses are only reached through CancelOrder, the
+// returns workflow and order placement.
+func (or *orderUseCase) UpdateOrderStatus(orderID int, status string, actor string) (domain.OrderSuccessResponse, error) {
+	if !domain.IsValidOrderStatus(status) {
+		return domain.OrderSuccessResponse{}, domain.ErrUnknownOrderStatus
+	}
+	switch status {
+	case domain.OrderStatusCancelled, domain.OrderStatusReturned, domain.OrderStatusFailed:
+		return domain.OrderSuccessResponse{}, fmt.Errorf("%w: %s", domain.ErrStatusNeedsWorkflow, status)
+	}
+	order, err := or.orderRepository.GetOrder(orderID)
+	if err != nil {
+		return domain.OrderSuccessResponse{}, err
+	}
+	if !domain.CanTransition(order.ShipmentStatus, status) {
+		return domain.OrderSuccessResponse{}, fmt.Errorf("%w: %s to %s", domain.ErrInvalidStatusTransition, order.ShipmentStatus, status)
+	}
+	if err := or.orderRepository.UpdateOrderStatus(orderID, order.ShipmentStatus, status, actor); err != nil {
+		return domain.OrderSuccessResponse{}, err
+	}
+	if status == domain.OrderStatusDelivered {
+		if err := or.collectCashOnDelivery(orderID); err != nil {
+			return domain.OrderSuccessResponse{}, fmt.Errorf("order delivered but recording the cash payment failed: %w", err)
+		}
+	}
+	return domain.OrderSuccessResponse{
+		OrderID:        order.ID,
+		ShipmentStatus: status,
+	}, nil
+}
+
+// GetOrderTimeline returns the status history of one of the user's orders.
+func (or *orderUseCase) GetOrderTimeline(orderID int, userID int) (models.OrderTimeline, error) {
+	order, err := or.orderRepository.GetOrder(orderID)
+	if err != nil {
+		return models.OrderTimeline{}, err
+	}
+	if order.UserID != userID {
+		return models.OrderTimeline{}, domain.ErrOrderNotFound
+	}
+	events, err := or.orderRepository.GetOrderTimeline(orderID)
+	if err != nil {
+		return models.OrderTimeline{}, err
+	}
+	return models.OrderTimeline{
+		OrderID:        int(order.ID),
+		ShipmentStatus: order.ShipmentStatus,
+		Events:         events,
+	}, nil
+}
+
+// CancelOrder cancels one of the user's orders before it is shipped, puts the
+// ordered quantities back into stock, gives its coupon use back, cancels its
+// pending payments and refunds a paid order to the user's wallet. Cancelling
+// an order that is already cancelled finishes whatever an earlier attempt
+// left undone.
+func (or *orderUseCase) CancelOrder(orderID int, userID int) (domain.OrderSuccessResponse, error) {
+	order, err := or.orderRepository.GetOrder(orderID)
+	if err != nil {
+		return domain.OrderSuccessResponse{}, err
+	}
+	if order.UserID != userID {
+		return domain.OrderSuccessResponse{}, domain.ErrOrderNotFound
+	}
+	actor := fmt.Sprintf("user:%d", userID)
+	if order.ShipmentStatus != domain.OrderStatusCancelled {
+		if !domain.CanTransition(order.ShipmentStatus, domain.OrderStatusCancelled) {
+			return domain.OrderSuccessResponse{}, domain.ErrOrderNotCancellable
+		}
+		paymentStatus := order.PaymentStatus
+		if strings.EqualFold(paymentStatus, domain.PaymentStatusPaid) {
+			paymentStatus = domain.PaymentStatusRefundPending
+		}
+		// The status change only succeeds once and marks the order for
+		// restocking, so stock is never restored twice.
+		if err := or.orderRepository.CancelOrder(orderID, order.ShipmentStatus, paymentStatus, actor); err != nil {
+			return domain.OrderSuccessResponse{}, err
+		}
+	}
+	if err := or.finishCancellation(orderID, actor); err != nil {
+		return domain.OrderSuccessResponse{}, fmt.Errorf("order cancelled but %w; cancel it again to retry", err)
+	}
+
+	paymentStatus, err := or.orderRepository.PaymentStatus(orderID)
+	if err != nil {
+		return domain.OrderSuccessResponse{}, err
+	}
+	return domain.OrderSuccessResponse{
+		OrderID:        order.ID,
+		ShipmentStatus: domain.OrderStatusCancelled,
+		PaymentStatus:  paymentStatus,
+	}, nil
+}
+
+// restockOrder puts every item of an order back into stock in one call.
+func (or *orderUseCase) restockOrder(orderID int, reason, actor string) error {
+	items, err := or.orderRepository.GetOrderItems(orderID)
+	if err != nil {
+		return err
+	}
+	cart := make([]models.Cart, 0, len(items))
+	for _, item := range items {
+		cart = append(cart, models.Cart{ProductID: item.ProductID, Quantity: item.Quantity, TotalPrice: item.TotalPrice})
+	}
+	return or.productRepository.ProductStockPlusBulk(cart, models.StockChange{
+		Reason:    reason,
+		Reference: fmt.Sprintf("order:%d", orderID),
+		Actor:     actor,
+	})
+}
+
+// finishCancellation puts the stock of a cancelled order back, refunds it and
+// gives its coupon use back. The restock runs only for the caller that claims
+// the order's restock mark and gives the mark back when it fails; refunds and
+// the coupon release do nothing the second time.
+func (or *orderUseCase) finishCancellation(orderID int, actor string) error {
+	order, err := or.orderRepository.GetOrder(orderID)
+	if err != nil {
+		return err
+	}
+	claimed, err := or.orderRepository.ClaimRestock(orderID)
+	if err != nil {
+		return err
+	}
+	if claimed {
+		if err := or.restockOrder(orderID, "cancellation", actor); err != nil {
+			if uerr := or.orderRepository.UnclaimRestock(orderID); uerr != nil {
+				log.Printf("order %d: marking it for restocking again: %v", orderID, uerr)
+			}
+			return fmt.Errorf("restocking failed: %w", err)
+		}
+	}
+	if err := or.creditRefunds(orderID); err != nil {
+		return fmt.Errorf("refunding it to the wallet failed: %w", err)
+	}
+	if order.CouponCode != "" {
+		if err := or.cartRepository.ReleaseCoupon(orderID); err != nil {
+			return fmt.Errorf("releasing coupon %s failed: %w", order.CouponCode, err)
+		}
+	}
+	return nil
+}
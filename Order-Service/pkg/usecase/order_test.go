@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"errors"
+	"order-service/pkg/domain"
+	"testing"
+)
+
+func TestCancelOrderRestocksOnce(t *testing.T) {
+	tests := []struct {
+		name string
+		// failures is how many restock calls fail before one succeeds.
+		failures    int
+		attempts    int
+		wantErrs    int
+		wantRestock int
+	}{
+		{"cancelled once", 0, 1, 0, 1},
+		{"cancelled again after it succeeded", 0, 3, 0, 1},
+		{"restock fails, then the retry succeeds", 1, 2, 1, 1},
+		{"restock keeps failing", 2, 2, 2, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeOrderRepository()
+			order := unpaidOrder(1, 300)
+			order.CouponCode = "SAVE10"
+			repo.addOrder(order, domain.OrderItem{OrderID: 1, ProductID: 7, Quantity: 2, TotalPrice: 300})
+			cart, product := &fakeCart{}, &fakeProduct{}
+			useCase := NewOrderUseCase(repo, cart, product, nil)
+
+			errs := 0
+			for i := 0; i < tt.attempts; i++ {
+				product.restockErr = nil
+				if i < tt.failures {
+					product.restockErr = errors.New("product service unavailable")
+				}
+				if _, err := useCase.CancelOrder(1, testUserID); err != nil {
+					errs++
+				}
+			}
+			if errs != tt.wantErrs {
+				t.Errorf("%d cancellations failed, want %d", errs, tt.wantErrs)
+			}
+			if len(product.restocked) != tt.wantRestock {
+				t.Errorf("stock put back %d times, want %d", len(product.restocked), tt.wantRestock)
+			}
+			if repo.orders[1].ShipmentStatus != domain.OrderStatusCancelled {
+				t.Errorf("order status = %q, want cancelled", repo.orders[1].ShipmentStatus)
+			}
+			if pending := repo.orders[1].RestockPending; pending != (tt.wantRestock == 0) {
+				t.Errorf("restock pending = %v after %d restocks", pending, tt.wantRestock)
+			}
+		})
+	}
+}
+
+// TestCancelOrderClaimedElsewhere cancels an order whose restock another
+// request already claimed: the stock is left to that request.
+func TestCancelOrderClaimedElsewhere(t *testing.T) {
+	repo := newFakeOrderRepository()
+	order := unpaidOrder(1, 300)
+	order.ShipmentStatus = domain.OrderStatusCancelled
+	repo.addOrder(order, domain.OrderItem{OrderID: 1, ProductID: 7, Quantity: 2, TotalPrice: 300})
+	product := &fakeProduct{}
+	useCase := NewOrderUseCase(repo, &fakeCart{}, product, nil)
+
+	if _, err := useCase.CancelOrder(1, testUserID); err != nil {
+		t.Fatalf("CancelOrder: %v", err)
+	}
+	if len(product.restocked) != 0 {
+		t.Errorf("stock put back %d times, want none", len(product.restocked))
+	}
+}
+
+func TestCancelPaidOrderRefundsOnce(t *testing.T) {
+	repo := newFakeOrderRepository()
+	order := unpaidOrder(1, 300)
+	order.PaymentStatus = domain.PaymentStatusPaid
+	repo.addOrder(order)
+	repo.intents = append(repo.intents, &domain.PaymentIntent{ID: 1, OrderID: 1, Amount: 300, Status: domain.IntentStatusSucceeded})
+	useCase := NewOrderUseCase(repo, &fakeCart{}, &fakeProduct{}, nil)
+
+	for i := 0; i < 2; i++ {
+		res, err := useCase.CancelOrder(1, testUserID)
+		if err != nil {
+			t.Fatalf("attempt %d: CancelOrder: %v", i+1, err)
+		}
+		if res.PaymentStatus != domain.PaymentStatusRefunded {
+			t.Errorf("attempt %d: payment status = %q, want refunded", i+1, res.PaymentStatus)
+		}
+	}
+	if balance, _ := repo.WalletBalance(testUserID); balance != 300 {
+		t.Errorf("wallet balance = %.2f, want 300.00", balance)
+	}
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	interfacePayment "order-service/pkg/payment/interfaces"
+)
+
+// PayOrder opens a new payment for one of the user's orders that is not paid
+// yet, for example after an online payment failed. Payments of the order that
+// are still pending are cancelled first.
+func (or *orderUseCase) PayOrder(orderID, userID, paymentMethodID int) (domain.PaymentIntent, error) {
+	order, err := or.orderRepository.GetOrder(orderID)
+	if err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	if order.UserID != userID {
+		return domain.PaymentIntent{}, domain.ErrOrderNotFound
+	}
+	switch order.ShipmentStatus {
+	case domain.OrderStatusCancelled, domain.OrderStatusFailed, domain.OrderStatusReturned:
+		return domain.PaymentIntent{}, domain.ErrOrderNotPayable
+	}
+	if order.PaymentStatus == domain.PaymentStatusPaid || order.PaymentStatus == domain.PaymentStatusRefundPending {
+		return domain.PaymentIntent{}, domain.ErrOrderAlreadyPaid
+	}
+	provider, err := or.paymentProvider(paymentMethodID)
+	if err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	if err := or.orderRepository.CancelPendingPayments(orderID); err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	return or.startPayment(provider, orderID, userID, order.FinalPrice)
+}
+
+// GetOrderPayments lists every payment of one of the user's orders.
+func (or *orderUseCase) GetOrderPayments(orderID, userID int) ([]domain.PaymentIntent, error) {
+	order, err := or.orderRepository.GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, domain.ErrOrderNotFound
+	}
+	return or.orderRepository.ListOrderPayments(orderID)
+}
+
+// HandlePaymentWebhook applies the outcome a provider reports for a payment.
+// Providers retry webhooks they could not deliver, so an event that was
+// applied before is accepted again without changing anything. Money that
+// arrives for an order that no longer needs it goes to the user's wallet.
+func (or *orderUseCase) HandlePaymentWebhook(webhook models.PaymentWebhook) (domain.PaymentIntent, error) {
+	provider, ok := or.providerByName(webhook.Provider).(interfacePayment.WebhookProvider)
+	if !ok {
+		return domain.PaymentIntent{}, fmt.Errorf("%w: %s", domain.ErrUnknownPaymentProvider, webhook.Provider)
+	}
+	event, err := provider.ParseWebhook(webhook.Payload, webhook.Signature)
+	if err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	intent, err := or.orderRepository.GetPaymentIntentByReference(provider.Name(), event.Reference)
+	if err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	if math.Abs(event.Amount-intent.Amount) >= 0.005 {
+		return domain.PaymentIntent{}, fmt.Errorf("%w: paid %.2f for a payment of %.2f", domain.ErrInvalidPaymentEvent, event.Amount, intent.Amount)
+	}
+	_, err = or.orderRepository.SettlePayment(int(intent.ID), event.Status, event.Reason, &domain.PaymentEvent{
+		Provider:        provider.Name(),
+		EventID:         event.EventID,
+		PaymentIntentID: intent.ID,
+		Status:          event.Status,
+	})
+	if err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	if err := or.creditRefunds(int(intent.OrderID)); err != nil {
+		return domain.PaymentIntent{}, err
+	}
+	return or.orderRepository.GetPaymentIntent(int(intent.ID))
+}
+
+// SimulatePayment returns the webhook call the provider of a payment would
+// send once the customer completed (succeeded) or abandoned (failed) it. Only
+// providers that stand in for a real gateway can do this.
+func (or *orderUseCase) SimulatePayment(paymentID int, outcome string) (models.PaymentWebhook, error) {
+	intent, err := or.orderRepository.GetPaymentIntent(paymentID)
+	if err != nil {
+		return models.PaymentWebhook{}, err
+	}
+	simulator, ok := or.providerByName(intent.Provider).(interfacePayment.Simulator)
+	if !ok {
+		return models.PaymentWebhook{}, fmt.Errorf("%w: %s", domain.ErrSimulationUnsupported, intent.Provider)
+	}
+	payload, signature, err := simulator.SimulateWebhook(intent, outcome)
+	if err != nil {
+		return models.PaymentWebhook{}, err
+	}
+	return models.PaymentWebhook{
+		Provider:  intent.Provider,
+		Payload:   payload,
+		Signature: signature,
+	}, nil
+}
+
+// paymentProvider returns the provider of a payment method.
+func (or *orderUseCase) paymentProvider(paymentMethodID int) (interfacePayment.Provider, error) {
+	method, err := or.orderRepository.GetPaymentMethod(paymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := or.payments[method.Payment_Name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not accepted", domain.ErrPaymentMethodNotFound, method.Payment_Name)
+	}
+	return provider, nil
+}
+
+func (or *orderUseCase) providerByName(name string) interfacePayment.Provider {
+	for _, provider := range or.payments {
+		if provider.Name() == name {
+			return provider
+		}
+	}
+	return nil
+}
+
+// startPayment opens a payment intent for an order with the provider. A
+// payment the provider declines at once is recorded as failed and returned as
+// ErrPaymentDeclined.
+func (or *orderUseCase) startPayment(provider interfacePayment.Provider, orderID, userID int, amount float64) (domain.PaymentIntent, error) {
+	intent, err := or.orderRepository.CreatePaymentIntent(domain.PaymentIntent{
+		OrderID:  uint(orderID),
+		UserID:   userID,
+		Method:   provider.Method(),
+		Provider: provider.Name(),
+		Amount:   amount,
+		Status:   domain.IntentStatusPending,
+	})
+	if err != nil {
+		return domain.PaymentIntent{}, err
+	}
+
+	result, err := provider.CreatePayment(intent)
+	if err != nil {
+		if _, serr := or.orderRepository.SettlePayment(int(intent.ID), domain.IntentStatusFailed, err.Error(), nil); serr != nil {
+			return domain.PaymentIntent{}, serr
+		}
+		return domain.PaymentIntent{}, err
+	}
+	if err := or.recordPayment(intent, result); err != nil {
+		// The provider may already hold the customer's money for a payment
+		// that stays pending, so it is given back.
+		if rerr := or.reversePendingPayments(orderID); rerr != nil {
+			log.Printf("payment %d: reversing it after %v: %v", intent.ID, err, rerr)
+		}
+		return domain.PaymentIntent{}, err
+	}
+	if result.Status == domain.IntentStatusFailed {
+		return domain.PaymentIntent{}, fmt.Errorf("%w: %s", domain.ErrPaymentDeclined, result.Reason)
+	}
+	return or.orderRepository.GetPaymentIntent(int(intent.ID))
+}
+
+// recordPayment stores what the provider answered for a new payment.
+func (or *orderUseCase) recordPayment(intent domain.PaymentIntent, result models.ProviderPayment) error {
+	if err := or.orderRepository.SetPaymentReference(int(intent.ID), result.Reference, result.CheckoutURL); err != nil {
+		return err
+	}
+	if result.Status == domain.IntentStatusPending {
+		return nil
+	}
+	_, err := or.orderRepository.SettlePayment(int(intent.ID), result.Status, result.Reason, nil)
+	return err
+}
+
+// reversePayment gives back a payment whose provider settles at once. Other
+// providers only take money once their outcome is recorded.
+func (or *orderUseCase) reversePayment(intent domain.PaymentIntent) error {
+	reverser, ok := or.providerByName(intent.Provider).(interfacePayment.Reverser)
+	if !ok {
+		return nil
+	}
+	return reverser.ReversePayment(intent)
+}
+
+// reversePendingPayments gives back the payments of an order that are still
+// pending although their provider may have taken the money.
+func (or *orderUseCase) reversePendingPayments(orderID int) error {
+	intents, err := or.orderRepository.ListOrderPayments(orderID)
+	if err != nil {
+		return err
+	}
+	for _, intent := range intents {
+		if intent.Status != domain.IntentStatusPending {
+			continue
+		}
+		if err := or.reversePayment(intent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectCashOnDelivery records the cash of a delivered order as paid.
+func (or *orderUseCase) collectCashOnDelivery(orderID int) error {
+	intents, err := or.orderRepository.ListOrderPayments(orderID)
+	if err != nil {
+		return err
+	}
+	for _, intent := range intents {
+		if intent.Method != domain.PaymentMethodCOD || intent.Status != domain.IntentStatusPending {
+			continue
+		}
+		if _, err := or.orderRepository.SettlePayment(int(intent.ID), domain.IntentStatusSucceeded, "", nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
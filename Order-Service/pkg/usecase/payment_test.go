@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"order-service/pkg/payment"
+	interfacePayment "order-service/pkg/payment/interfaces"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+const testUserID = 42
+
+func newPaymentTest(order domain.Order) (*orderUseCase, *fakeOrderRepository) {
+	repo := newFakeOrderRepository()
+	repo.addOrder(order)
+	useCase := NewOrderUseCase(repo, &fakeCart{}, &fakeProduct{}, []interfacePayment.Provider{
+		payment.NewMockProvider([]byte("webhook-secret")),
+		payment.NewCashOnDelivery(),
+		payment.NewWalletProvider(repo),
+	}).(*orderUseCase)
+	return useCase, repo
+}
+
+func unpaidOrder(id uint, price float64) domain.Order {
+	return domain.Order{
+		Model:          gorm.Model{ID: id},
+		UserID:         testUserID,
+		ShipmentStatus: domain.OrderStatusPending,
+		PaymentStatus:  domain.PaymentStatusNotPaid,
+		FinalPrice:     price,
+	}
+}
+
+// TestPaymentWebhookFlow pays an order through the mock provider and delivers
+// its webhooks, some of them more than once.
+func TestPaymentWebhookFlow(t *testing.T) {
+	tests := []struct {
+		name string
+		// cancel cancels the order after the payment was opened.
+		cancel      bool
+		outcome     string
+		deliveries  int
+		wantIntent  string
+		wantPayment string
+		wantWallet  float64
+	}{
+		{"payment succeeds", false, domain.IntentStatusSucceeded, 1, domain.IntentStatusSucceeded, domain.PaymentStatusPaid, 0},
+		{"success delivered twice", false, domain.IntentStatusSucceeded, 2, domain.IntentStatusSucceeded, domain.PaymentStatusPaid, 0},
+		{"payment fails", false, domain.IntentStatusFailed, 1, domain.IntentStatusFailed, domain.PaymentStatusFailed, 0},
+		{"failure delivered twice", false, domain.IntentStatusFailed, 2, domain.IntentStatusFailed, domain.PaymentStatusFailed, 0},
+		{"paid after the order was cancelled", true, domain.IntentStatusSucceeded, 1, domain.IntentStatusSucceeded, domain.PaymentStatusRefunded, 1299.99},
+		{"paid after cancelling, delivered three times", true, domain.IntentStatusSucceeded, 3, domain.IntentStatusSucceeded, domain.PaymentStatusRefunded, 1299.99},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, repo := newPaymentTest(unpaidOrder(1, 1299.99))
+			intent, err := useCase.PayOrder(1, testUserID, 1)
+			if err != nil {
+				t.Fatalf("PayOrder: %v", err)
+			}
+			if intent.Status != domain.IntentStatusPending || intent.CheckoutURL == "" {
+				t.Fatalf("PayOrder = %+v, want a pending payment with a checkout URL", intent)
+			}
+			if tt.cancel {
+				repo.orders[1].ShipmentStatus = domain.OrderStatusCancelled
+				if err := repo.CancelPendingPayments(1); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			webhook, err := useCase.SimulatePayment(int(intent.ID), tt.outcome)
+			if err != nil {
+				t.Fatalf("SimulatePayment: %v", err)
+			}
+			for i := 0; i < tt.deliveries; i++ {
+				if _, err := useCase.HandlePaymentWebhook(webhook); err != nil {
+					t.Fatalf("delivery %d: HandlePaymentWebhook: %v", i+1, err)
+				}
+			}
+
+			got, _ := repo.GetPaymentIntent(int(intent.ID))
+			if got.Status != tt.wantIntent {
+				t.Errorf("payment status = %q, want %q", got.Status, tt.wantIntent)
+			}
+			if status := repo.orders[1].PaymentStatus; status != tt.wantPayment {
+				t.Errorf("order payment status = %q, want %q", status, tt.wantPayment)
+			}
+			if balance, _ := repo.WalletBalance(testUserID); balance != tt.wantWallet {
+				t.Errorf("wallet balance = %.2f, want %.2f", balance, tt.wantWallet)
+			}
+			if len(repo.refunds) > 1 {
+				t.Errorf("%d refunds recorded, want at most one", len(repo.refunds))
+			}
+		})
+	}
+}
+
+func TestPaymentWebhookRejected(t *testing.T) {
+	useCase, repo := newPaymentTest(unpaidOrder(1, 500))
+	intent, err := useCase.PayOrder(1, testUserID, 1)
+	if err != nil {
+		t.Fatalf("PayOrder: %v", err)
+	}
+	webhook, err := useCase.SimulatePayment(int(intent.ID), domain.IntentStatusSucceeded)
+	if err != nil {
+		t.Fatalf("SimulatePayment: %v", err)
+	}
+	forged := webhook
+	forged.Signature = "00" + webhook.Signature[2:]
+	tampered := webhook
+	tampered.Payload = bytes.Replace(webhook.Payload, []byte(`"amount":500`), []byte(`"amount":5`), 1)
+	unknown := webhook
+	unknown.Provider = "stripe"
+
+	tests := []struct {
+		name    string
+		webhook models.PaymentWebhook
+		wantErr error
+	}{
+		{"forged signature", forged, domain.ErrInvalidWebhookSignature},
+		{"payload changed after signing", tampered, domain.ErrInvalidWebhookSignature},
+		{"unknown provider", unknown, domain.ErrUnknownPaymentProvider},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := useCase.HandlePaymentWebhook(tt.webhook); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("HandlePaymentWebhook error = %v, want %v", err, tt.wantErr)
+			}
+			if status := repo.orders[1].PaymentStatus; status != domain.PaymentStatusNotPaid {
+				t.Errorf("order payment status = %q after a rejected webhook", status)
+			}
+		})
+	}
+}
+
+func TestPayOrderFromWallet(t *testing.T) {
+	tests := []struct {
+		name        string
+		balance     float64
+		wantErr     error
+		wantPayment string
+		wantWallet  float64
+	}{
+		{"balance covers the order", 1000, nil, domain.PaymentStatusPaid, 250},
+		{"balance is short", 100, domain.ErrPaymentDeclined, domain.PaymentStatusFailed, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, repo := newPaymentTest(unpaidOrder(1, 750))
+			if _, err := repo.AddWalletTransaction(domain.WalletTransaction{
+				UserID: testUserID, Type: domain.WalletCredit, Amount: tt.balance, Reference: "topup",
+			}); err != nil {
+				t.Fatal(err)
+			}
+			_, err := useCase.PayOrder(1, testUserID, 3)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PayOrder error = %v, want %v", err, tt.wantErr)
+			}
+			if status := repo.orders[1].PaymentStatus; status != tt.wantPayment {
+				t.Errorf("order payment status = %q, want %q", status, tt.wantPayment)
+			}
+			if balance, _ := repo.WalletBalance(testUserID); balance != tt.wantWallet {
+				t.Errorf("wallet balance = %.2f, want %.2f", balance, tt.wantWallet)
+			}
+		})
+	}
+}
+
+// TestPayOrderFromWalletUnrecorded pays from the wallet while the payment
+// cannot be settled, which must not cost the customer the debit.
+func TestPayOrderFromWalletUnrecorded(t *testing.T) {
+	useCase, repo := newPaymentTest(unpaidOrder(1, 750))
+	if _, err := repo.AddWalletTransaction(domain.WalletTransaction{
+		UserID: testUserID, Type: domain.WalletCredit, Amount: 1000, Reference: "topup",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	outage := errors.New("database unavailable")
+	repo.settleErr = outage
+
+	for i := 0; i < 2; i++ {
+		if _, err := useCase.PayOrder(1, testUserID, 3); !errors.Is(err, outage) {
+			t.Fatalf("attempt %d: PayOrder error = %v, want %v", i+1, err, outage)
+		}
+		if balance, _ := repo.WalletBalance(testUserID); balance != 1000 {
+			t.Errorf("attempt %d: wallet balance = %.2f, want 1000.00", i+1, balance)
+		}
+	}
+	if status := repo.orders[1].PaymentStatus; status != domain.PaymentStatusNotPaid {
+		t.Errorf("order payment status = %q, want %q", status, domain.PaymentStatusNotPaid)
+	}
+
+	repo.settleErr = nil
+	if _, err := useCase.PayOrder(1, testUserID, 3); err != nil {
+		t.Fatalf("PayOrder after the outage: %v", err)
+	}
+	if balance, _ := repo.WalletBalance(testUserID); balance != 250 {
+		t.Errorf("wallet balance = %.2f, want 250.00", balance)
+	}
+}
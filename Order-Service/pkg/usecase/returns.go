@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+)
+
+// RequestReturn opens a return request for one item of a delivered order.
+func (or *orderUseCase) RequestReturn(input models.ReturnRequestInput) (domain.ReturnRequest, error) {
+	if _, ok := domain.ReturnReasons[input.ReasonCode]; !ok {
+		return domain.ReturnRequest{}, domain.ErrInvalidReturnReason
+	}
+	order, err := or.orderRepository.GetOrder(input.OrderID)
+	if err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	if order.UserID != input.UserID {
+		return domain.ReturnRequest{}, domain.ErrOrderNotFound
+	}
+	if order.ShipmentStatus != domain.OrderStatusDelivered {
+		return domain.ReturnRequest{}, domain.ErrOrderNotDelivered
+	}
+	if !domain.PaymentCaptured(order.PaymentStatus) {
+		return domain.ReturnRequest{}, domain.ErrOrderNotPaid
+	}
+	item, err := or.orderRepository.GetOrderItem(input.OrderID, input.ProductID)
+	if err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	open, err := or.orderRepository.HasOpenReturn(int(item.ID))
+	if err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	if open {
+		return domain.ReturnRequest{}, domain.ErrReturnAlreadyRequested
+	}
+
+	return or.orderRepository.CreateReturnRequest(domain.ReturnRequest{
+		OrderID:     order.ID,
+		OrderItemID: item.ID,
+		UserID:      input.UserID,
+		ProductID:   item.ProductID,
+		Quantity:    item.Quantity,
+		Amount:      refundAmount(order, item),
+		ReasonCode:  input.ReasonCode,
+		Comment:     input.Comment,
+	})
+}
+
+func (or *orderUseCase) ListReturnRequests(userID int, status string, page, count int) ([]domain.ReturnRequest, error) {
+	return or.orderRepository.ListReturnRequests(userID, status, page, count)
+}
+
+// ReviewReturnRequest approves or rejects a return. An approved return puts
+// the item back into stock and refunds what was paid for it to the user's
+// wallet. Approving a return that is already approved finishes whatever an
+// earlier approval left undone.
+func (or *orderUseCase) ReviewReturnRequest(review models.ReturnReview) (domain.ReturnRequest, error) {
+	request, err := or.orderRepository.GetReturnRequest(review.ReturnID)
+	if err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	if review.Approve && request.Status == domain.ReturnStatusApproved {
+		if err := or.finishReturn(request, review.Actor); err != nil {
+			return domain.ReturnRequest{}, err
+		}
+		return or.orderRepository.GetReturnRequest(review.ReturnID)
+	}
+	if request.Status != domain.ReturnStatusRequested {
+		return domain.ReturnRequest{}, domain.ErrReturnAlreadyReviewed
+	}
+
+	if !review.Approve {
+		if err := or.orderRepository.RejectReturn(review.ReturnID, review.Actor, review.Note); err != nil {
+			return domain.ReturnRequest{}, err
+		}
+		return or.orderRepository.GetReturnRequest(review.ReturnID)
+	}
+
+	if err := or.orderRepository.ApproveReturn(request, review.Actor, review.Note); err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	if err := or.finishReturn(request, review.Actor); err != nil {
+		return domain.ReturnRequest{}, err
+	}
+	return or.orderRepository.GetReturnRequest(review.ReturnID)
+}
+
+// finishReturn refunds an approved return to the user's wallet and puts the
+// item back into stock. The restock runs only for the caller that claims the
+// return's restock mark and gives the mark back when it fails, so approving
+// the return again retries what failed without restocking twice.
+func (or *orderUseCase) finishReturn(request domain.ReturnRequest, actor string) error {
+	if err := or.creditRefunds(int(request.OrderID)); err != nil {
+		return fmt.Errorf("return approved but refunding it to the wallet failed: %w; approve it again to retry", err)
+	}
+	claimed, err := or.orderRepository.ClaimReturnRestock(int(request.ID))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+	change := models.StockChange{
+		Reason:    "return",
+		Reference: fmt.Sprintf("return:%d", request.ID),
+		Actor:     actor,
+	}
+	if err := or.productRepository.ProductStockPlus(int(request.ProductID), int(request.Quantity), change); err != nil {
+		if uerr := or.orderRepository.UnclaimReturnRestock(int(request.ID)); uerr != nil {
+			log.Printf("return %d: marking it for restocking again: %v", request.ID, uerr)
+		}
+		return fmt.Errorf("return approved but restocking product %d failed: %w; approve it again to retry", request.ProductID, err)
+	}
+	return nil
+}
+
+// refundAmount is what the customer paid for an order item: its price less
+// its share of the order's coupon discount.
+func refundAmount(order domain.Order, item domain.OrderItem) float64 {
+	if order.Discount <= 0 {
+		return item.TotalPrice
+	}
+	gross := order.FinalPrice + order.Discount
+	if gross <= 0 {
+		return 0
+	}
+	share := order.Discount * item.TotalPrice / gross
+	return math.Round((item.TotalPrice-share)*100) / 100
+}
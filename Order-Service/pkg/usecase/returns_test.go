@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"errors"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"testing"
+)
+
+func TestReviewReturnRequest(t *testing.T) {
+	approve := models.ReturnReview{ReturnID: 1, Approve: true, Actor: "admin:ops@example.com"}
+	reject := models.ReturnReview{ReturnID: 1, Approve: false, Actor: "admin:ops@example.com"}
+	tests := []struct {
+		name string
+		// failures is how many restock calls fail before one succeeds.
+		failures    int
+		reviews     []models.ReturnReview
+		wantErrs    []bool
+		wantStatus  string
+		wantRestock int
+		wantWallet  float64
+	}{
+		{"approved", 0, []models.ReturnReview{approve}, []bool{false}, domain.ReturnStatusApproved, 1, 120},
+		{"approved twice", 0, []models.ReturnReview{approve, approve}, []bool{false, false}, domain.ReturnStatusApproved, 1, 120},
+		{"restock fails, approving again retries it", 1, []models.ReturnReview{approve, approve}, []bool{true, false}, domain.ReturnStatusApproved, 1, 120},
+		{"rejected", 0, []models.ReturnReview{reject}, []bool{false}, domain.ReturnStatusRejected, 0, 0},
+		{"approved after it was rejected", 0, []models.ReturnReview{reject, approve}, []bool{false, true}, domain.ReturnStatusRejected, 0, 0},
+		{"rejected after it was approved", 0, []models.ReturnReview{approve, reject}, []bool{false, true}, domain.ReturnStatusApproved, 1, 120},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeOrderRepository()
+			order := unpaidOrder(1, 120)
+			order.ShipmentStatus = domain.OrderStatusDelivered
+			order.PaymentStatus = domain.PaymentStatusPaid
+			repo.addOrder(order, domain.OrderItem{ID: 1, OrderID: 1, ProductID: 7, Quantity: 1, TotalPrice: 120})
+			repo.intents = append(repo.intents, &domain.PaymentIntent{ID: 1, OrderID: 1, Amount: 120, Status: domain.IntentStatusSucceeded})
+			repo.returns[1] = &domain.ReturnRequest{
+				ID: 1, OrderID: 1, OrderItemID: 1, UserID: testUserID, ProductID: 7, Quantity: 1, Amount: 120,
+				Status: domain.ReturnStatusRequested,
+			}
+			product := &fakeProduct{}
+			useCase := NewOrderUseCase(repo, &fakeCart{}, product, nil)
+
+			for i, review := range tt.reviews {
+				product.restockErr = nil
+				if i < tt.failures {
+					product.restockErr = errors.New("product service unavailable")
+				}
+				_, err := useCase.ReviewReturnRequest(review)
+				if (err != nil) != tt.wantErrs[i] {
+					t.Errorf("review %d: error = %v, want error %v", i+1, err, tt.wantErrs[i])
+				}
+			}
+			if status := repo.returns[1].Status; status != tt.wantStatus {
+				t.Errorf("return status = %q, want %q", status, tt.wantStatus)
+			}
+			if len(product.restocked) != tt.wantRestock {
+				t.Errorf("item put back %d times, want %d", len(product.restocked), tt.wantRestock)
+			}
+			if balance, _ := repo.WalletBalance(testUserID); balance != tt.wantWallet {
+				t.Errorf("wallet balance = %.2f, want %.2f", balance, tt.wantWallet)
+			}
+		})
+	}
+}
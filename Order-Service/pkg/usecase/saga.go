@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+)
+
+// sagaStep is one step of a saga. compensate undoes action and may be nil for
+// steps that have nothing to undo. settles names an earlier step that is no
+// longer undone once this step succeeds, because this step consumed what the
+// earlier one left behind and its own compensate covers it.
+type sagaStep struct {
+	name       string
+	action     func() error
+	compensate func() error
+	settles    string
+}
+
+// runSaga runs the steps in order. When a step fails, the compensations of
+// the steps that still need undoing run in reverse order and the error of the
+// failed step is returned.
+func runSaga(steps []sagaStep) error {
+	var done []sagaStep
+	for _, step := range steps {
+		err := step.action()
+		if err == nil {
+			if step.settles != "" {
+				done = settleStep(done, step.settles)
+			}
+			done = append(done, step)
+			continue
+		}
+		for j := len(done) - 1; j >= 0; j-- {
+			if done[j].compensate == nil {
+				continue
+			}
+			if cerr := done[j].compensate(); cerr != nil {
+				log.Printf("saga: compensating %q after %q failed: %v", done[j].name, step.name, cerr)
+			}
+		}
+		return fmt.Errorf("%s: %w", step.name, err)
+	}
+	return nil
+}
+
+// settleStep drops the step called name from the steps to undo.
+func settleStep(done []sagaStep, name string) []sagaStep {
+	for i, step := range done {
+		if step.name == name {
+			return append(done[:i:i], done[i+1:]...)
+		}
+	}
+	return done
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"errors"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+	"order-service/pkg/payment"
+	interfacePayment "order-service/pkg/payment/interfaces"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunSaga(t *testing.T) {
+	tests := []struct {
+		name     string
+		failAt   string
+		wantErr  bool
+		wantRuns []string
+	}{
+		{"all steps succeed", "", false, []string{"do hold", "do commit", "do pay"}},
+		{"first step fails", "hold", true, []string{"do hold"}},
+		{"step before the settling one fails", "commit", true, []string{"do hold", "do commit", "undo hold"}},
+		{"step after the settling one fails", "pay", true, []string{"do hold", "do commit", "do pay", "undo commit"}},
+		{"last step fails", "notify", true, []string{"do hold", "do commit", "do pay", "do notify", "undo pay", "undo commit"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var runs []string
+			step := func(name, settles string, undo bool) sagaStep {
+				s := sagaStep{
+					name:    name,
+					settles: settles,
+					action: func() error {
+						runs = append(runs, "do "+name)
+						if name == tt.failAt {
+							return errors.New("failed")
+						}
+						return nil
+					},
+				}
+				if undo {
+					s.compensate = func() error {
+						runs = append(runs, "undo "+name)
+						return errors.New("compensation errors are only logged")
+					}
+				}
+				return s
+			}
+			steps := []sagaStep{
+				step("hold", "", true),
+				step("commit", "hold", true),
+				step("pay", "", true),
+			}
+			if tt.failAt == "notify" {
+				steps = append(steps, step("notify", "", false))
+			}
+
+			err := runSaga(steps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runSaga error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.HasPrefix(err.Error(), tt.failAt+": ") {
+				t.Errorf("runSaga error = %q, want it to name step %q", err, tt.failAt)
+			}
+			if !reflect.DeepEqual(runs, tt.wantRuns) {
+				t.Errorf("runs = %q, want %q", runs, tt.wantRuns)
+			}
+		})
+	}
+}
+
+// TestOrderItemsFromCart places orders from a cart paid from the wallet and
+// checks what each failing step leaves behind.
+func TestOrderItemsFromCart(t *testing.T) {
+	outage := errors.New("service unavailable")
+	tests := []struct {
+		name       string
+		balance    float64
+		commitErr  error
+		clearErr   error
+		wantErr    error
+		wantStatus string
+		wantHolds  []string
+		wantWallet float64
+		// wantRestock is whether the sold stock was put back.
+		wantRestock bool
+	}{
+		{
+			name: "order placed", balance: 1000,
+			wantStatus: domain.OrderStatusPending, wantHolds: []string{"reserve cart:42", "commit cart:42"},
+			wantWallet: 100,
+		},
+		{
+			name: "stock cannot be committed", balance: 1000, commitErr: outage, wantErr: outage,
+			wantStatus: domain.OrderStatusFailed, wantHolds: []string{"reserve cart:42", "release cart:42"},
+			wantWallet: 1000,
+		},
+		{
+			name: "payment declined", balance: 100, wantErr: domain.ErrPaymentDeclined,
+			wantStatus: domain.OrderStatusFailed, wantHolds: []string{"reserve cart:42", "commit cart:42"},
+			wantWallet: 100, wantRestock: true,
+		},
+		{
+			name: "cart cannot be cleared", balance: 1000, clearErr: outage, wantErr: outage,
+			wantStatus: domain.OrderStatusFailed, wantHolds: []string{"reserve cart:42", "commit cart:42"},
+			wantWallet: 1000, wantRestock: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeOrderRepository()
+			cart := &fakeCart{
+				items:    []models.Cart{{ProductID: 7, Quantity: 2, TotalPrice: 1000}},
+				total:    models.CartTotal{TotalPrice: 1000, Discount: 100, FinalPrice: 900, CouponCode: "SAVE10"},
+				clearErr: tt.clearErr,
+			}
+			product := &fakeProduct{commitErr: tt.commitErr}
+			useCase := NewOrderUseCase(repo, cart, product, []interfacePayment.Provider{
+				payment.NewWalletProvider(repo),
+			}).(*orderUseCase)
+			if _, err := repo.AddWalletTransaction(domain.WalletTransaction{
+				UserID: testUserID, Type: domain.WalletCredit, Amount: tt.balance, Reference: "topup",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := useCase.OrderItemsFromCart(models.OrderFromCart{AddressID: 1, PaymentID: 3}, testUserID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OrderItemsFromCart error = %v, want %v", err, tt.wantErr)
+			}
+
+			order := repo.orders[1]
+			if order.ShipmentStatus != tt.wantStatus {
+				t.Errorf("order status = %q, want %q", order.ShipmentStatus, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(product.holds, tt.wantHolds) {
+				t.Errorf("stock holds = %q, want %q", product.holds, tt.wantHolds)
+			}
+			if restocked := len(product.restocked) > 0; restocked != tt.wantRestock {
+				t.Errorf("stock put back = %v, want %v", restocked, tt.wantRestock)
+			}
+			if balance, _ := repo.WalletBalance(testUserID); balance != tt.wantWallet {
+				t.Errorf("wallet balance = %.2f, want %.2f", balance, tt.wantWallet)
+			}
+			if released := len(cart.released) > 0; released != (tt.wantErr != nil) {
+				t.Errorf("coupon released = %v, want %v", released, tt.wantErr != nil)
+			}
+			if cart.cleared != (tt.wantErr == nil) {
+				t.Errorf("cart cleared = %v, want %v", cart.cleared, tt.wantErr == nil)
+			}
+		})
+	}
+}
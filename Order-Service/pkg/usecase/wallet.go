@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"order-service/pkg/domain"
+	"order-service/pkg/models"
+)
+
+func (or *orderUseCase) GetWallet(userID int) (models.Wallet, error) {
+	balance, err := or.orderRepository.WalletBalance(userID)
+	if err != nil {
+		return models.Wallet{}, err
+	}
+	return models.Wallet{
+		UserID:  userID,
+		Balance: balance,
+	}, nil
+}
+
+func (or *orderUseCase) ListWalletTransactions(userID, page, count int) ([]domain.WalletTransaction, error) {
+	return or.orderRepository.ListWalletTransactions(userID, page, count)
+}
+
+// creditRefunds pays the pending refunds of an order out to the customer's
+// wallet. A refund that no captured payment covers stays pending for an admin
+// to look into.
+func (or *orderUseCase) creditRefunds(orderID int) error {
+	refunds, err := or.orderRepository.PendingRefunds(orderID)
+	if err != nil {
+		return err
+	}
+	for _, refund := range refunds {
+		err := or.orderRepository.CreditRefund(int(refund.ID))
+		if errors.Is(err, domain.ErrRefundNotCaptured) {
+			log.Println("wallet:", err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"log"
+	"net/http"
+	"strings"
+)
+
+// StartMediaServer serves the files of the media directory under /media/.
+// Directory listings are not served.
+func StartMediaServer(addr, dir string) {
+	files := http.StripPrefix("/media/", http.FileServer(http.Dir(dir)))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/media/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		files.ServeHTTP(w, r)
+	})
+	log.Printf("media server listening on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Printf("media server stopped: %v", err)
+	}
+}
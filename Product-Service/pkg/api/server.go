@@ -5,6 +5,7 @@ import (
 	"net"
 	"product-service/pkg/config"
 	"product-service/pkg/pb"
+	"product-service/pkg/usecase"
 
 	"google.golang.org/grpc"
 )
@@ -21,7 +22,8 @@ func NewGRPCServer(cfg config.Config, server pb.ProductServer) (*Server, error)
 		return nil, err
 	}
 
-	newServer := grpc.NewServer()
+	// Leave room above the largest image upload for the rest of the message.
+	newServer := grpc.NewServer(grpc.MaxRecvMsgSize(usecase.MaxImageBytes + 1<<20))
 	pb.RegisterProductServer(newServer, server)
 
 	return &Server{
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"product-service/pkg/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *ProductServer) AddCategory(ctx context.Context, req *pb.CategoryRequest) (*pb.CategoryResponse, error) {
+	category, err := p.productUseCase.AddCategory(categoryInputFromProto(req))
+	if err != nil {
+		return &pb.CategoryResponse{Error: err.Error()}, categoryError(err)
+	}
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (p *ProductServer) UpdateCategory(ctx context.Context, req *pb.CategoryRequest) (*pb.CategoryResponse, error) {
+	category, err := p.productUseCase.UpdateCategory(int(req.ID), categoryInputFromProto(req))
+	if err != nil {
+		return &pb.CategoryResponse{Error: err.Error()}, categoryError(err)
+	}
+	return &pb.CategoryResponse{Category: categoryToProto(category)}, nil
+}
+
+func (p *ProductServer) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	if err := p.productUseCase.DeleteCategory(int(req.ID)); err != nil {
+		return &pb.DeleteCategoryResponse{Error: err.Error()}, categoryError(err)
+	}
+	return &pb.DeleteCategoryResponse{}, nil
+}
+
+func (p *ProductServer) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	categories, err := p.productUseCase.ListCategories()
+	if err != nil {
+		return &pb.ListCategoriesResponse{}, categoryError(err)
+	}
+	var result pb.ListCategoriesResponse
+	for _, c := range categories {
+		result.Categories = append(result.Categories, categoryToProto(c))
+	}
+	return &result, nil
+}
+
+func (p *ProductServer) GetCategoryTree(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.CategoryTreeResponse, error) {
+	tree, err := p.productUseCase.CategoryTree()
+	if err != nil {
+		return &pb.CategoryTreeResponse{}, categoryError(err)
+	}
+	return &pb.CategoryTreeResponse{Categories: categoryNodesToProto(tree)}, nil
+}
+
+// categoryError maps category errors to gRPC status codes.
+func categoryError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrCategoryExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, domain.ErrCategoryInUse), errors.Is(err, domain.ErrCategoryCycle):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, domain.ErrInvalidCategory):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func categoryInputFromProto(req *pb.CategoryRequest) models.CategoryInput {
+	return models.CategoryInput{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		ParentID:    uint(req.ParentID),
+	}
+}
+
+func categoryToProto(c domain.Category) *pb.Category {
+	category := &pb.Category{
+		ID:          int64(c.ID),
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+	}
+	if c.ParentID != nil {
+		category.ParentID = int64(*c.ParentID)
+	}
+	return category
+}
+
+func categoryNodesToProto(nodes []models.CategoryNode) []*pb.CategoryNode {
+	result := []*pb.CategoryNode{}
+	for _, n := range nodes {
+		result = append(result, &pb.CategoryNode{
+			Category: categoryToProto(n.Category),
+			Children: categoryNodesToProto(n.Children),
+		})
+	}
+	return result
+}
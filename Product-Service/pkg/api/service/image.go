@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"product-service/pkg/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *ProductServer) UploadProductImage(ctx context.Context, req *pb.UploadProductImageRequest) (*pb.ProductImageResponse, error) {
+	image, err := p.productUseCase.UploadProductImage(int(req.ProductID), req.Data, req.Primary)
+	if err != nil {
+		return &pb.ProductImageResponse{Error: err.Error()}, imageError(err)
+	}
+	return &pb.ProductImageResponse{Image: imageToProto(image)}, nil
+}
+
+func (p *ProductServer) ListProductImages(ctx context.Context, req *pb.ProductIDRequest) (*pb.ProductImagesResponse, error) {
+	images, err := p.productUseCase.ListProductImages(int(req.ID))
+	if err != nil {
+		return &pb.ProductImagesResponse{Error: err.Error()}, imageError(err)
+	}
+	return &pb.ProductImagesResponse{Images: imagesToProto(images)}, nil
+}
+
+func (p *ProductServer) SetPrimaryImage(ctx context.Context, req *pb.ProductImageRequest) (*pb.ProductImagesResponse, error) {
+	images, err := p.productUseCase.SetPrimaryImage(int(req.ProductID), int(req.ImageID))
+	if err != nil {
+		return &pb.ProductImagesResponse{Error: err.Error()}, imageError(err)
+	}
+	return &pb.ProductImagesResponse{Images: imagesToProto(images)}, nil
+}
+
+func (p *ProductServer) ReorderProductImages(ctx context.Context, req *pb.ReorderProductImagesRequest) (*pb.ProductImagesResponse, error) {
+	ids := make([]int, 0, len(req.ImageIDs))
+	for _, id := range req.ImageIDs {
+		ids = append(ids, int(id))
+	}
+	images, err := p.productUseCase.ReorderProductImages(int(req.ProductID), ids)
+	if err != nil {
+		return &pb.ProductImagesResponse{Error: err.Error()}, imageError(err)
+	}
+	return &pb.ProductImagesResponse{Images: imagesToProto(images)}, nil
+}
+
+func (p *ProductServer) DeleteProductImage(ctx context.Context, req *pb.ProductImageRequest) (*pb.ProductImagesResponse, error) {
+	images, err := p.productUseCase.DeleteProductImage(int(req.ProductID), int(req.ImageID))
+	if err != nil {
+		return &pb.ProductImagesResponse{Error: err.Error()}, imageError(err)
+	}
+	return &pb.ProductImagesResponse{Images: imagesToProto(images)}, nil
+}
+
+// imageError maps image errors to gRPC status codes.
+func imageError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound), errors.Is(err, domain.ErrImageNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrInvalidImage):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, domain.ErrTooManyImages):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func imageToProto(image models.ProductImage) *pb.ProductImage {
+	return &pb.ProductImage{
+		ID:           int64(image.ID),
+		ProductID:    int64(image.ProductID),
+		URL:          image.URL,
+		ThumbnailURL: image.ThumbnailURL,
+		Position:     int64(image.Position),
+		IsPrimary:    image.IsPrimary,
+	}
+}
+
+func imagesToProto(images []models.ProductImage) []*pb.ProductImage {
+	result := []*pb.ProductImage{}
+	for _, image := range images {
+		result = append(result, imageToProto(image))
+	}
+	return result
+}
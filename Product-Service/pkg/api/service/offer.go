@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"product-service/pkg/pb"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *ProductServer) AddOffer(ctx context.Context, req *pb.AddOfferRequest) (*pb.OfferResponse, error) {
+	input, err := offerInputFromProto(req.Offer)
+	if err != nil {
+		return &pb.OfferResponse{Error: err.Error()}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	input.Actor = req.Actor
+	offer, err := p.productUseCase.AddOffer(input)
+	if err != nil {
+		return &pb.OfferResponse{Error: err.Error()}, offerError(err)
+	}
+	return &pb.OfferResponse{Offer: offerToProto(offer)}, nil
+}
+
+func (p *ProductServer) UpdateOffer(ctx context.Context, req *pb.UpdateOfferRequest) (*pb.OfferResponse, error) {
+	input, err := offerInputFromProto(req.Offer)
+	if err != nil {
+		return &pb.OfferResponse{Error: err.Error()}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	offer, err := p.productUseCase.UpdateOffer(int(req.ID), input)
+	if err != nil {
+		return &pb.OfferResponse{Error: err.Error()}, offerError(err)
+	}
+	return &pb.OfferResponse{Offer: offerToProto(offer)}, nil
+}
+
+func (p *ProductServer) ListOffers(ctx context.Context, req *pb.ListOffersRequest) (*pb.ListOffersResponse, error) {
+	offers, err := p.productUseCase.ListOffers(int(req.Page), int(req.Count))
+	if err != nil {
+		return &pb.ListOffersResponse{}, offerError(err)
+	}
+	var result pb.ListOffersResponse
+	for _, o := range offers {
+		result.Offers = append(result.Offers, offerToProto(o))
+	}
+	return &result, nil
+}
+
+func (p *ProductServer) DeleteOffer(ctx context.Context, req *pb.DeleteOfferRequest) (*pb.DeleteOfferResponse, error) {
+	if err := p.productUseCase.DeleteOffer(int(req.ID)); err != nil {
+		return &pb.DeleteOfferResponse{Error: err.Error()}, offerError(err)
+	}
+	return &pb.DeleteOfferResponse{}, nil
+}
+
+func (p *ProductServer) GetProductOffers(ctx context.Context, req *pb.ProductOffersRequest) (*pb.ProductOffersResponse, error) {
+	var productIDs []int
+	for _, id := range req.ProductIDs {
+		productIDs = append(productIDs, int(id))
+	}
+	offers, err := p.productUseCase.ProductOffers(productIDs)
+	if err != nil {
+		return &pb.ProductOffersResponse{}, offerError(err)
+	}
+	var result pb.ProductOffersResponse
+	for _, id := range productIDs {
+		product := &pb.ProductOffers{ProductID: int64(id)}
+		for _, o := range offers[id] {
+			product.Offers = append(product.Offers, offerToProto(o))
+		}
+		result.Products = append(result.Products, product)
+	}
+	return &result, nil
+}
+
+// offerError maps offer errors to gRPC status codes.
+func offerError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOfferNotFound), errors.Is(err, domain.ErrProductNotFound),
+		errors.Is(err, domain.ErrCategoryNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrInvalidOffer):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func offerInputFromProto(o *pb.Offer) (models.OfferInput, error) {
+	input := models.OfferInput{
+		Name:          o.GetName(),
+		ProductID:     uint(o.GetProductID()),
+		CategoryID:    uint(o.GetCategoryID()),
+		DiscountType:  o.GetDiscountType(),
+		DiscountValue: float64(o.GetDiscountValue()),
+		Active:        o.GetActive(),
+	}
+	if o.GetValidFrom() != "" {
+		validFrom, err := time.Parse(time.RFC3339, o.GetValidFrom())
+		if err != nil {
+			return input, err
+		}
+		input.ValidFrom = validFrom
+	}
+	if o.GetValidUntil() != "" {
+		validUntil, err := time.Parse(time.RFC3339, o.GetValidUntil())
+		if err != nil {
+			return input, err
+		}
+		input.ValidUntil = &validUntil
+	}
+	return input, nil
+}
+
+func offerToProto(o domain.Offer) *pb.Offer {
+	offer := &pb.Offer{
+		ID:            int64(o.ID),
+		Name:          o.Name,
+		DiscountType:  o.DiscountType,
+		DiscountValue: float32(o.DiscountValue),
+		ValidFrom:     o.ValidFrom.Format(time.RFC3339),
+		Active:        o.Active,
+		CreatedBy:     o.CreatedBy,
+	}
+	if o.ProductID != nil {
+		offer.ProductID = int64(*o.ProductID)
+	}
+	if o.CategoryID != nil {
+		offer.CategoryID = int64(*o.CategoryID)
+	}
+	if o.ValidUntil != nil {
+		offer.ValidUntil = o.ValidUntil.Format(time.RFC3339)
+	}
+	return offer
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"product-service/pkg/pb"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *ProductServer) SchedulePriceChange(ctx context.Context, req *pb.SchedulePriceRequest) (*pb.PriceScheduleResponse, error) {
+	input := models.PriceScheduleInput{
+		ProductID: int(req.ProductID),
+		Label:     req.Label,
+		Price:     float64(req.Price),
+		Actor:     req.Actor,
+	}
+	if req.StartsAt != "" {
+		startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return &pb.PriceScheduleResponse{Error: err.Error()}, status.Errorf(codes.InvalidArgument, "starts_at: %v", err)
+		}
+		input.StartsAt = startsAt
+	}
+	if req.EndsAt != "" {
+		endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+		if err != nil {
+			return &pb.PriceScheduleResponse{Error: err.Error()}, status.Errorf(codes.InvalidArgument, "ends_at: %v", err)
+		}
+		input.EndsAt = &endsAt
+	}
+	schedule, err := p.productUseCase.SchedulePriceChange(input)
+	if err != nil {
+		return &pb.PriceScheduleResponse{Error: err.Error()}, priceError(err)
+	}
+	return &pb.PriceScheduleResponse{Schedule: scheduleToProto(schedule)}, nil
+}
+
+func (p *ProductServer) CancelPriceSchedule(ctx context.Context, req *pb.CancelPriceScheduleRequest) (*pb.PriceScheduleResponse, error) {
+	schedule, err := p.productUseCase.CancelPriceSchedule(int(req.ID), req.Actor)
+	if err != nil {
+		return &pb.PriceScheduleResponse{Error: err.Error()}, priceError(err)
+	}
+	return &pb.PriceScheduleResponse{Schedule: scheduleToProto(schedule)}, nil
+}
+
+func (p *ProductServer) ListPriceSchedules(ctx context.Context, req *pb.ProductIDRequest) (*pb.ListPriceSchedulesResponse, error) {
+	schedules, err := p.productUseCase.ListPriceSchedules(int(req.ID))
+	if err != nil {
+		return &pb.ListPriceSchedulesResponse{}, priceError(err)
+	}
+	var result pb.ListPriceSchedulesResponse
+	for _, s := range schedules {
+		result.Schedules = append(result.Schedules, scheduleToProto(s))
+	}
+	return &result, nil
+}
+
+func (p *ProductServer) ListPriceHistory(ctx context.Context, req *pb.ListPriceHistoryRequest) (*pb.ListPriceHistoryResponse, error) {
+	history, err := p.productUseCase.ListPriceHistory(int(req.ProductID), int(req.Page), int(req.Count))
+	if err != nil {
+		return &pb.ListPriceHistoryResponse{}, priceError(err)
+	}
+	var result pb.ListPriceHistoryResponse
+	for _, h := range history {
+		change := &pb.PriceChange{
+			ID:        int64(h.ID),
+			ProductID: int64(h.ProductID),
+			OldPrice:  float32(h.OldPrice),
+			NewPrice:  float32(h.NewPrice),
+			Reason:    h.Reason,
+			Actor:     h.Actor,
+			CreatedAt: h.CreatedAt.Format(time.RFC3339),
+		}
+		if h.ScheduleID != nil {
+			change.ScheduleID = int64(*h.ScheduleID)
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return &result, nil
+}
+
+// priceError maps price schedule errors to gRPC status codes.
+func priceError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound), errors.Is(err, domain.ErrScheduleNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrInvalidSchedule):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, domain.ErrScheduleOverlap), errors.Is(err, domain.ErrScheduleFinished):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func scheduleToProto(s domain.PriceSchedule) *pb.PriceSchedule {
+	schedule := &pb.PriceSchedule{
+		ID:            int64(s.ID),
+		ProductID:     int64(s.ProductID),
+		Label:         s.Label,
+		Price:         float32(s.Price),
+		PreviousPrice: float32(s.PreviousPrice),
+		StartsAt:      s.StartsAt.Format(time.RFC3339),
+		Status:        s.Status,
+		CreatedBy:     s.CreatedBy,
+	}
+	if s.EndsAt != nil {
+		schedule.EndsAt = s.EndsAt.Format(time.RFC3339)
+	}
+	return schedule
+}
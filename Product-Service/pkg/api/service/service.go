@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
+	"product-service/pkg/domain"
 	"product-service/pkg/models"
 	"product-service/pkg/pb"
 	interfaceUse "product-service/pkg/usecase/interface"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ProductServer struct {
@@ -25,9 +31,13 @@ func (p *ProductServer) AddProduct(ctx context.Context, details *pb.AddProductRe
 		Size:        int(details.Size),
 		Stock:       int(details.Stock),
 		Price:       float64(details.Price),
+		LaptopSpecs: specsFromProto(details.Specs),
 	}
 	data, err := p.productUseCase.AddProducts(model)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSpecs) || errors.Is(err, domain.ErrCategoryNotFound) {
+			return &pb.AddProductResponse{}, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return &pb.AddProductResponse{}, err
 	}
 	return &pb.AddProductResponse{
@@ -38,20 +48,35 @@ func (p *ProductServer) AddProduct(ctx context.Context, details *pb.AddProductRe
 		Size:        int64(data.Size),
 		Stock:       int64(data.Stock),
 		Price:       float32(data.Price),
+		Specs:       specsToProto(data.LaptopSpecs),
 	}, nil
 }
 func (p *ProductServer) ListProducts(ctx context.Context, details *pb.ListProductRequest) (*pb.ListProductResponse, error) {
 	page := int(details.Page)
 	count := int(details.Count)
 
-	products, err := p.productUseCase.ShowAllProducts(page, count)
+	products, err := p.productUseCase.ShowAllProducts(page, count, filterFromProto(details.Filter), details.Sort)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSort) {
+			err = status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return &pb.ListProductResponse{
 			Details: []*pb.ProductDetails{},
 		}, err
 	}
-	var result pb.ListProductResponse
-	for _, v := range products {
+	result := pb.ListProductResponse{Total: int64(products.Total)}
+	for _, f := range products.Facets {
+		facet := &pb.Facet{Name: f.Name}
+		for _, v := range f.Values {
+			facet.Values = append(facet.Values, &pb.FacetValue{Value: v.Value, Count: int64(v.Count)})
+		}
+		result.Facets = append(result.Facets, facet)
+	}
+	for _, v := range products.Products {
+		var deletedAt string
+		if v.DeletedAt != nil {
+			deletedAt = v.DeletedAt.Format(time.RFC3339)
+		}
 		result.Details = append(result.Details, &pb.ProductDetails{
 			ID:            int64(v.ID),
 			Name:          v.Name,
@@ -61,6 +86,10 @@ func (p *ProductServer) ListProducts(ctx context.Context, details *pb.ListProduc
 			Stock:         int64(v.Stock),
 			Price:         float32(v.Price),
 			ProductStatus: v.ProductStatus,
+			Specs:         specsToProto(v.LaptopSpecs),
+			Status:        v.Status,
+			DeletedAt:     deletedAt,
+			Images:        imagesToProto(v.Images),
 		})
 	}
 	return &result, nil
@@ -68,7 +97,7 @@ func (p *ProductServer) ListProducts(ctx context.Context, details *pb.ListProduc
 func (p *ProductServer) UpdateProducts(ctx context.Context, details *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
 	id := int(details.ID)
 	stock := int(details.Stock)
-	update, err := p.productUseCase.UpdateProduct(id, stock)
+	update, err := p.productUseCase.UpdateProduct(id, stock, details.Actor)
 	if err != nil {
 		return &pb.UpdateProductResponse{}, err
 	}
@@ -83,7 +112,7 @@ func (p *ProductServer) DeleteProduct(ctx context.Context, details *pb.DeletePro
 	if err != nil {
 		return &pb.DeleteProductResponse{
 			Error: err.Error(),
-		}, err
+		}, patchError(err)
 	}
 	return &pb.DeleteProductResponse{}, nil
 }
@@ -114,14 +143,237 @@ func (p *ProductServer) GetPriceofProductFromID(ctx context.Context, Req *pb.Get
 func (p *ProductServer) ProductStockMinus(ctx context.Context, Req *pb.ProductStockMinusRequest) (*pb.ProductStockMinusReponse, error) {
 	id := int(Req.ID)
 	stock := int(Req.Stock)
-	err := p.productUseCase.ProductStockMinus(id, stock)
+	change := models.StockChange{Reason: Req.Reason, Reference: Req.Reference, Actor: Req.Actor}
+	err := p.productUseCase.ProductStockMinus(id, stock, change)
 	if err != nil {
 		return &pb.ProductStockMinusReponse{
 			Error: err.Error(),
-		}, err
+		}, stockError(err)
 	}
 	return &pb.ProductStockMinusReponse{}, nil
 }
+func (p *ProductServer) ProductStockPlus(ctx context.Context, Req *pb.ProductStockPlusRequest) (*pb.ProductStockPlusResponse, error) {
+	id := int(Req.ID)
+	stock := int(Req.Stock)
+	change := models.StockChange{Reason: Req.Reason, Reference: Req.Reference, Actor: Req.Actor}
+	err := p.productUseCase.ProductStockPlus(id, stock, change)
+	if err != nil {
+		return &pb.ProductStockPlusResponse{
+			Error: err.Error(),
+		}, stockError(err)
+	}
+	return &pb.ProductStockPlusResponse{}, nil
+}
+func (p *ProductServer) ProductStockMinusBulk(ctx context.Context, Req *pb.ProductStockBulkRequest) (*pb.ProductStockBulkResponse, error) {
+	err := p.productUseCase.ProductStockMinusBulk(stockItems(Req), bulkStockChange(Req))
+	if err != nil {
+		return &pb.ProductStockBulkResponse{
+			Error: err.Error(),
+		}, stockError(err)
+	}
+	return &pb.ProductStockBulkResponse{}, nil
+}
+func (p *ProductServer) ProductStockPlusBulk(ctx context.Context, Req *pb.ProductStockBulkRequest) (*pb.ProductStockBulkResponse, error) {
+	err := p.productUseCase.ProductStockPlusBulk(stockItems(Req), bulkStockChange(Req))
+	if err != nil {
+		return &pb.ProductStockBulkResponse{
+			Error: err.Error(),
+		}, stockError(err)
+	}
+	return &pb.ProductStockBulkResponse{}, nil
+}
+func (p *ProductServer) ReserveStock(ctx context.Context, Req *pb.ReserveStockRequest) (*pb.ReservationResponse, error) {
+	res, err := p.productUseCase.ReserveStock(Req.Reference, protoStockItems(Req.Items), time.Duration(Req.TTLSeconds)*time.Second)
+	if err != nil {
+		return &pb.ReservationResponse{
+			Error: err.Error(),
+		}, stockError(err)
+	}
+	return reservationResponse(res), nil
+}
+func (p *ProductServer) ReleaseStock(ctx context.Context, Req *pb.ReservationRequest) (*pb.ReservationResponse, error) {
+	res, err := p.productUseCase.ReleaseStock(Req.Reference, productIDs(Req.ProductIDs))
+	if err != nil {
+		return &pb.ReservationResponse{
+			Error: err.Error(),
+		}, stockError(err)
+	}
+	return reservationResponse(res), nil
+}
+func (p *ProductServer) CommitReservation(ctx context.Context, Req *pb.ReservationRequest) (*pb.ReservationResponse, error) {
+	res, err := p.productUseCase.CommitReservation(Req.Reference, productIDs(Req.ProductIDs))
+	if err != nil {
+		return &pb.ReservationResponse{
+			Error: err.Error(),
+		}, stockError(err)
+	}
+	return reservationResponse(res), nil
+}
+func reservationResponse(reservations []domain.StockReservation) *pb.ReservationResponse {
+	var result pb.ReservationResponse
+	for _, r := range reservations {
+		result.Reservations = append(result.Reservations, &pb.Reservation{
+			ID:        int64(r.ID),
+			Reference: r.Reference,
+			ProductID: int64(r.ProductID),
+			Quantity:  int64(r.Quantity),
+			Status:    r.Status,
+			ExpiresAt: r.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+	return &result
+}
+func productIDs(ids []int64) []int {
+	var result []int
+	for _, id := range ids {
+		result = append(result, int(id))
+	}
+	return result
+}
+
+func (p *ProductServer) PatchProduct(ctx context.Context, req *pb.PatchProductRequest) (*pb.PatchProductResponse, error) {
+	patch := req.GetProduct()
+	model := models.Product{
+		Name:        patch.GetName(),
+		Description: patch.GetDescription(),
+		CategoryID:  uint(patch.GetCategoryID()),
+		Size:        int(patch.GetSize()),
+		Stock:       int(patch.GetStock()),
+		Price:       float64(patch.GetPrice()),
+		LaptopSpecs: specsFromProto(patch.GetSpecs()),
+	}
+	product, err := p.productUseCase.PatchProduct(int(req.ID), model, req.GetUpdateMask().GetPaths(), req.Actor)
+	if err != nil {
+		return &pb.PatchProductResponse{Error: err.Error()}, patchError(err)
+	}
+	return &pb.PatchProductResponse{Product: productToProto(product)}, nil
+}
+
+func (p *ProductServer) GetProduct(ctx context.Context, req *pb.ProductIDRequest) (*pb.ProductResponse, error) {
+	product, err := p.productUseCase.GetProduct(int(req.ID))
+	if err != nil {
+		return &pb.ProductResponse{Error: err.Error()}, patchError(err)
+	}
+	images, err := p.productUseCase.ListProductImages(int(req.ID))
+	if err != nil {
+		return &pb.ProductResponse{Error: err.Error()}, imageError(err)
+	}
+	details := productToProto(product)
+	details.Images = imagesToProto(images)
+	return &pb.ProductResponse{Product: details}, nil
+}
+
+func (p *ProductServer) RestoreProduct(ctx context.Context, req *pb.ProductIDRequest) (*pb.ProductResponse, error) {
+	product, err := p.productUseCase.RestoreProduct(int(req.ID))
+	if err != nil {
+		return &pb.ProductResponse{Error: err.Error()}, patchError(err)
+	}
+	return &pb.ProductResponse{Product: productToProto(product)}, nil
+}
+
+func productToProto(product domain.Product) *pb.ProductDetails {
+	details := &pb.ProductDetails{
+		ID:          int64(product.ID),
+		Name:        product.Name,
+		Description: product.Description,
+		CategoryID:  int64(product.CategoryID),
+		Size:        int64(product.Size),
+		Stock:       int64(product.Stock),
+		Price:       float32(product.Price),
+		Specs:       specsToProto(product.LaptopSpecs),
+		Status:      product.Status,
+	}
+	if product.DeletedAt != nil {
+		details.DeletedAt = product.DeletedAt.Format(time.RFC3339)
+	}
+	return details
+}
+
+// patchError maps product update errors to gRPC status codes.
+func patchError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrProductExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, domain.ErrProductArchived), errors.Is(err, domain.ErrProductNotArchived):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, domain.ErrInvalidFieldMask), errors.Is(err, domain.ErrInvalidProduct),
+		errors.Is(err, domain.ErrInvalidSpecs), errors.Is(err, domain.ErrCategoryNotFound):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+// stockError maps stock errors to gRPC status codes.
+func stockError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInsufficientStock), errors.Is(err, domain.ErrProductArchived):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, domain.ErrProductNotFound), errors.Is(err, domain.ErrReservationNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrInvalidReservation), errors.Is(err, domain.ErrInvalidMovement):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+func (p *ProductServer) ListStockMovements(ctx context.Context, Req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	movements, err := p.productUseCase.ListStockMovements(int(Req.ProductID), int(Req.Page), int(Req.Count))
+	if err != nil {
+		return &pb.ListStockMovementsResponse{
+			Error: err.Error(),
+		}, err
+	}
+	var result pb.ListStockMovementsResponse
+	for _, m := range movements {
+		result.Movements = append(result.Movements, &pb.StockMovement{
+			ID:        int64(m.ID),
+			ProductID: int64(m.ProductID),
+			Delta:     int64(m.Delta),
+			Reason:    m.Reason,
+			Reference: m.ReferenceID,
+			Actor:     m.Actor,
+			CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return &result, nil
+}
+func (p *ProductServer) ReconcileStock(ctx context.Context, Req *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	mismatches, err := p.productUseCase.ReconcileStock(int(Req.ProductID), Req.Fix, Req.Actor)
+	if err != nil {
+		return &pb.ReconcileStockResponse{
+			Error: err.Error(),
+		}, err
+	}
+	var result pb.ReconcileStockResponse
+	for _, m := range mismatches {
+		result.Mismatches = append(result.Mismatches, &pb.StockReconciliation{
+			ProductID:   int64(m.ProductID),
+			Stock:       int64(m.Stock),
+			LedgerStock: int64(m.LedgerStock),
+			Difference:  int64(m.Difference),
+		})
+	}
+	return &result, nil
+}
+func bulkStockChange(Req *pb.ProductStockBulkRequest) models.StockChange {
+	return models.StockChange{Reason: Req.Reason, Reference: Req.Reference, Actor: Req.Actor}
+}
+func stockItems(Req *pb.ProductStockBulkRequest) []models.ProductStock {
+	return protoStockItems(Req.Items)
+}
+func protoStockItems(stock []*pb.ProductStock) []models.ProductStock {
+	var items []models.ProductStock
+	for _, v := range stock {
+		items = append(items, models.ProductStock{
+			ProductID: int(v.ID),
+			Stock:     int(v.Stock),
+		})
+	}
+	return items
+}
 func (p *ProductServer) CheckProduct(ctx context.Context, Req *pb.CheckProductRequest) (*pb.CheckProductResponse, error) {
 	id := int(Req.ProductID)
 	ok, err := p.productUseCase.CheckProduct(id)
@@ -139,3 +391,55 @@ func (p *ProductServer) CheckProduct(ctx context.Context, Req *pb.CheckProductRe
 		Bool: true,
 	}, nil
 }
+
+func specsFromProto(s *pb.LaptopSpecs) domain.LaptopSpecs {
+	return domain.LaptopSpecs{
+		Brand:            s.GetBrand(),
+		CPU:              s.GetCPU(),
+		RAMGB:            int(s.GetRAMGB()),
+		StorageType:      s.GetStorageType(),
+		StorageGB:        int(s.GetStorageGB()),
+		GPU:              s.GetGPU(),
+		ScreenResolution: s.GetScreenResolution(),
+		WeightKg:         float64(s.GetWeightKg()),
+		BatteryWh:        float64(s.GetBatteryWh()),
+		OS:               s.GetOS(),
+	}
+}
+
+func specsToProto(s domain.LaptopSpecs) *pb.LaptopSpecs {
+	return &pb.LaptopSpecs{
+		Brand:            s.Brand,
+		CPU:              s.CPU,
+		RAMGB:            int64(s.RAMGB),
+		StorageType:      s.StorageType,
+		StorageGB:        int64(s.StorageGB),
+		GPU:              s.GPU,
+		ScreenResolution: s.ScreenResolution,
+		WeightKg:         float32(s.WeightKg),
+		BatteryWh:        float32(s.BatteryWh),
+		OS:               s.OS,
+	}
+}
+
+func filterFromProto(f *pb.ProductFilter) models.ProductFilter {
+	return models.ProductFilter{
+		Brand:            f.GetBrand(),
+		CPU:              f.GetCPU(),
+		MinRAMGB:         int(f.GetMinRAMGB()),
+		MaxRAMGB:         int(f.GetMaxRAMGB()),
+		StorageType:      f.GetStorageType(),
+		MinStorageGB:     int(f.GetMinStorageGB()),
+		GPU:              f.GetGPU(),
+		ScreenResolution: f.GetScreenResolution(),
+		MaxWeightKg:      float64(f.GetMaxWeightKg()),
+		MinBatteryWh:     float64(f.GetMinBatteryWh()),
+		OS:               f.GetOS(),
+		Query:            f.GetQuery(),
+		CategoryID:       int(f.GetCategoryID()),
+		MinPrice:         float64(f.GetMinPrice()),
+		MaxPrice:         float64(f.GetMaxPrice()),
+		InStock:          f.GetInStock(),
+		Status:           f.GetStatus(),
+	}
+}
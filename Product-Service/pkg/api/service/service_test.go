@@ -0,0 +1,31 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"product-service/pkg/domain"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStockError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("product 3: %w", domain.ErrInsufficientStock), codes.FailedPrecondition},
+		{domain.ErrProductArchived, codes.FailedPrecondition},
+		{domain.ErrProductNotFound, codes.NotFound},
+		{domain.ErrReservationNotFound, codes.NotFound},
+		{domain.ErrInvalidReservation, codes.InvalidArgument},
+		{domain.ErrInvalidMovement, codes.InvalidArgument},
+		{errors.New("connection reset"), codes.Internal},
+	}
+	for _, tt := range tests {
+		if got := status.Code(stockError(tt.err)); got != tt.want {
+			t.Errorf("stockError(%q) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
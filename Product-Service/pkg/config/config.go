@@ -9,10 +9,17 @@ type Config struct {
 	DBPort     string `mapstructure:"DB_PORT"`
 	DBPassword string `mapstructure:"DB_PASSWORD"`
 	Port       string `mapstructure:"PORT"`
+
+	// Product images are kept in MediaDir, served over HTTP on MediaPort
+	// and linked to under MediaBaseURL.
+	MediaDir     string `mapstructure:"MEDIA_DIR"`
+	MediaPort    string `mapstructure:"MEDIA_PORT"`
+	MediaBaseURL string `mapstructure:"MEDIA_BASE_URL"`
 }
 
 var envs = []string{
 	"DB_HOST", "DB_NAME", "DB_USER", "DB_PORT", "DB_PASSWORD", "PORT",
+	"MEDIA_DIR", "MEDIA_PORT", "MEDIA_BASE_URL",
 }
 
 func LoadConfig() (Config, error) {
@@ -21,6 +28,9 @@ func LoadConfig() (Config, error) {
 	viper.AddConfigPath("./")
 	viper.SetConfigFile(".env")
 	viper.ReadInConfig()
+	viper.SetDefault("MEDIA_DIR", "./media")
+	viper.SetDefault("MEDIA_PORT", ":8084")
+	viper.SetDefault("MEDIA_BASE_URL", "http://localhost:8084/media")
 
 	for _, env := range envs {
 		if err := viper.BindEnv(env); err != nil {
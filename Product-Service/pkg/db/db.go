@@ -15,7 +15,21 @@ func ConnectDatabase(cfg config.Config) (*gorm.DB, error) {
 		SkipDefaultTransaction: true,
 	})
 
+	db.AutoMigrate(&domain.Category{})
 	db.AutoMigrate(&domain.Product{})
+	db.AutoMigrate(&domain.StockReservation{})
+	db.AutoMigrate(&domain.InventoryMovement{})
+	db.AutoMigrate(&domain.ProductImage{})
+	db.AutoMigrate(&domain.PriceHistory{})
+	db.AutoMigrate(&domain.PriceSchedule{})
+	db.AutoMigrate(&domain.Offer{})
+
+	// Products added before the inventory ledger have no movements, so their
+	// whole stock would show up as a mismatch. They get the initial movement a
+	// new product is given.
+	db.Exec(`INSERT INTO inventory_movements (product_id, delta, reason, reference_id, actor, created_at)
+	SELECT p.id, p.stock, ?, 'backfill', 'system', NOW() FROM products p
+	WHERE p.stock <> 0 AND NOT EXISTS (SELECT 1 FROM inventory_movements m WHERE m.product_id = p.id)`, domain.MovementInitial)
 	return db, dbErr
 
 }
@@ -6,7 +6,9 @@ import (
 	"product-service/pkg/config"
 	"product-service/pkg/db"
 	"product-service/pkg/repository"
+	"product-service/pkg/storage"
 	"product-service/pkg/usecase"
+	"time"
 )
 
 func InitializeAPI(cfg config.Config) (*server.Server, error) {
@@ -17,7 +19,11 @@ func InitializeAPI(cfg config.Config) (*server.Server, error) {
 	}
 
 	adminRepository := repository.NewProductRepository(gormDB)
-	adminUseCase := usecase.NewProductUseCase(adminRepository)
+	mediaStorage := storage.NewLocalStorage(cfg.MediaDir, cfg.MediaBaseURL)
+	adminUseCase := usecase.NewProductUseCase(adminRepository, mediaStorage)
+	go usecase.StartReservationSweeper(adminUseCase, time.Minute)
+	go usecase.StartPriceScheduler(adminUseCase, time.Minute)
+	go server.StartMediaServer(cfg.MediaPort, cfg.MediaDir)
 
 	adminServiceServer := service.NewProductServer(adminUseCase)
 	grpcServer, err := server.NewGRPCServer(cfg, adminServiceServer)
@@ -1,11 +1,259 @@
 package domain
 
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrProductNotFound     = errors.New("product not found")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrReservationNotFound = errors.New("no active reservation found")
+	ErrInvalidReservation  = errors.New("reservation needs a reference and positive quantities")
+	ErrInvalidMovement     = errors.New("unknown stock movement reason")
+	ErrInvalidSpecs        = errors.New("invalid laptop specifications")
+	ErrInvalidSort         = errors.New("unknown sort option")
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryExists      = errors.New("a category with this slug already exists")
+	ErrCategoryInUse       = errors.New("category still has products or subcategories")
+	ErrCategoryCycle       = errors.New("a category cannot be moved below itself")
+	ErrInvalidCategory     = errors.New("invalid category")
+	ErrInvalidProduct      = errors.New("invalid product")
+	ErrProductExists       = errors.New("product already exist")
+	ErrInvalidFieldMask    = errors.New("invalid update mask")
+	ErrProductArchived     = errors.New("product is archived")
+	ErrProductNotArchived  = errors.New("product is not archived")
+	ErrInvalidImage        = errors.New("invalid image")
+	ErrImageNotFound       = errors.New("image not found")
+	ErrTooManyImages       = errors.New("product already has the maximum number of images")
+	ErrInvalidSchedule     = errors.New("invalid price schedule")
+	ErrScheduleOverlap     = errors.New("price schedule overlaps another schedule of the product")
+	ErrScheduleNotFound    = errors.New("price schedule not found")
+	ErrScheduleFinished    = errors.New("price schedule has already ended or was cancelled")
+	ErrInvalidOffer        = errors.New("invalid offer")
+	ErrOfferNotFound       = errors.New("offer not found")
+)
+
+// Category groups products. Categories form a tree through ParentID; root
+// categories have no parent.
+type Category struct {
+	ID          uint      `json:"id" gorm:"primaryKey;not null"`
+	Name        string    `json:"name" gorm:"not null"`
+	Slug        string    `json:"slug" gorm:"uniqueIndex;not null"`
+	Description string    `json:"description"`
+	ParentID    *uint     `json:"parent_id" gorm:"index"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Reasons recorded for stock movements.
+const (
+	MovementInitial      = "initial"
+	MovementRestock      = "restock"
+	MovementSale         = "sale"
+	MovementCancellation = "cancellation"
+	MovementReturn       = "return"
+	MovementAdjustment   = "adjustment"
+	MovementReservation  = "reservation"
+	MovementRelease      = "release"
+)
+
+var movementReasons = map[string]bool{
+	MovementInitial:      true,
+	MovementRestock:      true,
+	MovementSale:         true,
+	MovementCancellation: true,
+	MovementReturn:       true,
+	MovementAdjustment:   true,
+	MovementReservation:  true,
+	MovementRelease:      true,
+}
+
+// IsValidMovementReason reports whether reason is a known stock movement reason.
+func IsValidMovementReason(reason string) bool {
+	return movementReasons[reason]
+}
+
+// InventoryMovement is one entry of the append-only stock ledger. The deltas of
+// a product add up to its stock.
+type InventoryMovement struct {
+	ID          uint      `json:"id" gorm:"primaryKey;not null"`
+	ProductID   uint      `json:"product_id" gorm:"index;not null"`
+	Delta       int       `json:"delta" gorm:"not null"`
+	Reason      string    `json:"reason" gorm:"not null"`
+	ReferenceID string    `json:"reference_id" gorm:"index"`
+	Actor       string    `json:"actor"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Statuses of a stock reservation. Reserved stock is taken from the product
+// while the reservation is active; it goes back when the reservation is
+// released or expires and stays taken once it is committed.
+const (
+	ReservationActive    = "active"
+	ReservationReleased  = "released"
+	ReservationCommitted = "committed"
+	ReservationExpired   = "expired"
+)
+
+// StockReservation holds stock of a product for a reference such as a cart or
+// an order until it is committed, released or expires.
+type StockReservation struct {
+	ID        uint      `json:"id" gorm:"primaryKey;not null"`
+	Reference string    `json:"reference" gorm:"index;not null"`
+	ProductID uint      `json:"product_id" gorm:"index;not null"`
+	Quantity  int       `json:"quantity"`
+	Status    string    `json:"status" gorm:"index;default:'active'"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"index"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type Product struct {
-	ID          uint    `json:"id" gorm:"unique;not null"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	CategoryID  uint    `json:"category_id"`
-	Size        int     `json:"size"`
-	Stock       int     `json:"stock"`
-	Price       float64 `json:"price"`
+	ID          uint       `json:"id" gorm:"unique;not null"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CategoryID  uint       `json:"category_id"`
+	Size        int        `json:"size"`
+	Stock       int        `json:"stock"`
+	Price       float64    `json:"price"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"default:CURRENT_TIMESTAMP"`
+	Status      string     `json:"status" gorm:"index;not null;default:'active'"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	LaptopSpecs `gorm:"embedded"`
+}
+
+// Statuses of a product. Archived products are hidden from listings and
+// cannot be sold, but keep their row so orders and carts that point at them
+// still resolve.
+const (
+	ProductStatusActive   = "active"
+	ProductStatusArchived = "archived"
+)
+
+// Reasons recorded for price changes.
+const (
+	PriceChangeInitial       = "initial"
+	PriceChangeManual        = "manual"
+	PriceChangeScheduleStart = "schedule_start"
+	PriceChangeScheduleEnd   = "schedule_end"
+)
+
+// PriceHistory is one change of the price of a product.
+type PriceHistory struct {
+	ID         uint      `json:"id" gorm:"primaryKey;not null"`
+	ProductID  uint      `json:"product_id" gorm:"index;not null"`
+	OldPrice   float64   `json:"old_price"`
+	NewPrice   float64   `json:"new_price" gorm:"not null"`
+	Reason     string    `json:"reason" gorm:"not null"`
+	ScheduleID *uint     `json:"schedule_id"`
+	Actor      string    `json:"actor"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Statuses of a price schedule. A scheduled change becomes active when the
+// scheduler applies its price and completed when it puts the previous price
+// back.
+const (
+	ScheduleScheduled = "scheduled"
+	ScheduleActive    = "active"
+	ScheduleCompleted = "completed"
+	ScheduleCancelled = "cancelled"
+)
+
+// PriceSchedule changes the price of a product for a period of time, such as
+// a festival sale. Without an end the new price stays. PreviousPrice is the
+// price the product had when the schedule started.
+type PriceSchedule struct {
+	ID            uint       `json:"id" gorm:"primaryKey;not null"`
+	ProductID     uint       `json:"product_id" gorm:"index;not null"`
+	Label         string     `json:"label"`
+	Price         float64    `json:"price" gorm:"not null"`
+	PreviousPrice float64    `json:"previous_price"`
+	StartsAt      time.Time  `json:"starts_at" gorm:"index;not null"`
+	EndsAt        *time.Time `json:"ends_at" gorm:"index"`
+	Status        string     `json:"status" gorm:"index;not null;default:'scheduled'"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// MaxProductImages is the number of images a product can have.
+const MaxProductImages = 10
+
+// ProductImage is an uploaded picture of a product. Images are shown in order
+// of Position and exactly one image of a product with images is primary.
+type ProductImage struct {
+	ID           uint      `json:"id" gorm:"primaryKey;not null"`
+	ProductID    uint      `json:"product_id" gorm:"index;not null"`
+	StorageKey   string    `json:"storage_key" gorm:"not null"`
+	ThumbnailKey string    `json:"thumbnail_key" gorm:"not null"`
+	ContentType  string    `json:"content_type"`
+	Position     int       `json:"position"`
+	IsPrimary    bool      `json:"is_primary"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Sort options of a product listing.
+const (
+	SortRelevance  = "relevance"
+	SortPriceAsc   = "price_asc"
+	SortPriceDesc  = "price_desc"
+	SortNewest     = "newest"
+	SortPopularity = "popularity"
+)
+
+// IsValidSort reports whether sortBy is a known product listing sort.
+func IsValidSort(sortBy string) bool {
+	switch sortBy {
+	case SortRelevance, SortPriceAsc, SortPriceDesc, SortNewest, SortPopularity:
+		return true
+	}
+	return false
+}
+
+// LaptopSpecs are the structured specifications of a laptop.
+type LaptopSpecs struct {
+	Brand            string  `json:"brand" gorm:"column:brand;index"`
+	CPU              string  `json:"cpu" gorm:"column:cpu"`
+	RAMGB            int     `json:"ram_gb" gorm:"column:ram_gb"`
+	StorageType      string  `json:"storage_type" gorm:"column:storage_type"`
+	StorageGB        int     `json:"storage_gb" gorm:"column:storage_gb"`
+	GPU              string  `json:"gpu" gorm:"column:gpu"`
+	ScreenResolution string  `json:"screen_resolution" gorm:"column:screen_resolution"`
+	WeightKg         float64 `json:"weight_kg" gorm:"column:weight_kg"`
+	BatteryWh        float64 `json:"battery_wh" gorm:"column:battery_wh"`
+	OS               string  `json:"os" gorm:"column:os"`
+}
+
+// Accepted values of the enumerated laptop specifications.
+var (
+	StorageTypes = []string{"ssd", "nvme", "hdd", "emmc"}
+	OSTypes      = []string{"windows", "macos", "linux", "chromeos", "none"}
+	RAMSizesGB   = []int{4, 8, 12, 16, 24, 32, 48, 64, 96, 128}
+)
+
+// Discount types of an offer. A percentage offer takes DiscountValue percent
+// off the price, a flat offer takes DiscountValue off every unit.
+const (
+	OfferTypePercentage = "percentage"
+	OfferTypeFlat       = "flat"
+)
+
+// Offer is a discount that applies without a code to one product, or to every
+// product in a category and its subcategories. Exactly one of ProductID and
+// CategoryID is set. A nil ValidUntil means the offer does not expire.
+type Offer struct {
+	ID            uint       `json:"id" gorm:"primaryKey;not null"`
+	Name          string     `json:"name" gorm:"not null"`
+	ProductID     *uint      `json:"product_id" gorm:"index"`
+	CategoryID    *uint      `json:"category_id" gorm:"index"`
+	DiscountType  string     `json:"discount_type" gorm:"not null"`
+	DiscountValue float64    `json:"discount_value" gorm:"not null"`
+	ValidFrom     time.Time  `json:"valid_from" gorm:"not null"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	Active        bool       `json:"active" gorm:"not null;default:true"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
@@ -1,14 +1,23 @@
 package models
 
+import (
+	"product-service/pkg/domain"
+	"time"
+)
+
 type ProductBrief struct {
-	ID            uint    `json:"id" gorm:"unique;not null"`
-	Name          string  `json:"name"`
-	Description   string  `json:"description"`
-	CategoryID    int     `json:"category_id"`
-	Size          int     `json:"size"`
-	Stock         int     `json:"stock"`
-	Price         float64 `json:"price"`
-	ProductStatus string  `json:"product_status"`
+	ID            uint           `json:"id" gorm:"unique;not null"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	CategoryID    int            `json:"category_id"`
+	Size          int            `json:"size"`
+	Stock         int            `json:"stock"`
+	Price         float64        `json:"price"`
+	ProductStatus string         `json:"product_status"`
+	Status        string         `json:"status"`
+	DeletedAt     *time.Time     `json:"deleted_at"`
+	Images        []ProductImage `json:"images" gorm:"-"`
+	domain.LaptopSpecs
 }
 
 type ProductUpdateReciever struct {
@@ -22,4 +31,115 @@ type Product struct {
 	Size        int     `json:"size" validate:"required"`
 	Stock       int     `json:"stock" validate:"required"`
 	Price       float64 `json:"price" validate:"required"`
+	domain.LaptopSpecs
+}
+
+// ProductFilter narrows a product listing down by free text, category, price,
+// availability and laptop specifications. Zero values do not filter.
+type ProductFilter struct {
+	Query            string  `json:"q"`
+	CategoryID       int     `json:"category_id"`
+	MinPrice         float64 `json:"min_price"`
+	MaxPrice         float64 `json:"max_price"`
+	InStock          bool    `json:"in_stock"`
+	Brand            string  `json:"brand"`
+	CPU              string  `json:"cpu"`
+	MinRAMGB         int     `json:"min_ram_gb"`
+	MaxRAMGB         int     `json:"max_ram_gb"`
+	StorageType      string  `json:"storage_type"`
+	MinStorageGB     int     `json:"min_storage_gb"`
+	GPU              string  `json:"gpu"`
+	ScreenResolution string  `json:"screen_resolution"`
+	MaxWeightKg      float64 `json:"max_weight_kg"`
+	MinBatteryWh     float64 `json:"min_battery_wh"`
+	OS               string  `json:"os"`
+	// Status lists active products when empty; "archived" lists archived
+	// products and "all" lists both.
+	Status string `json:"status"`
+}
+
+type ProductStock struct {
+	ProductID int `json:"product_id"`
+	Stock     int `json:"stock"`
+}
+
+// StockChange describes why stock moves, for the inventory ledger.
+type StockChange struct {
+	Reason    string `json:"reason"`
+	Reference string `json:"reference"`
+	Actor     string `json:"actor"`
+}
+
+type StockReconciliation struct {
+	ProductID   int `json:"product_id"`
+	Stock       int `json:"stock"`
+	LedgerStock int `json:"ledger_stock"`
+	Difference  int `json:"difference"`
+}
+
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type Facet struct {
+	Name   string       `json:"name"`
+	Values []FacetValue `json:"values"`
+}
+
+// ProductSearchResult is one page of a product listing together with the
+// total number of matches and facet counts over all of them.
+type ProductSearchResult struct {
+	Products []ProductBrief `json:"products"`
+	Total    int            `json:"total"`
+	Facets   []Facet        `json:"facets"`
+}
+
+// CategoryInput holds the editable fields of a category. A zero ParentID makes
+// it a root category and an empty Slug is derived from the name.
+type CategoryInput struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	ParentID    uint   `json:"parent_id"`
+}
+
+// CategoryNode is a category together with its subcategories.
+type CategoryNode struct {
+	domain.Category
+	Children []CategoryNode `json:"children"`
+}
+
+type ProductImage struct {
+	ID           uint   `json:"id"`
+	ProductID    uint   `json:"product_id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Position     int    `json:"position"`
+	IsPrimary    bool   `json:"is_primary"`
+}
+
+// PriceScheduleInput schedules a price for a product from StartsAt until
+// EndsAt. A zero StartsAt starts it now and a nil EndsAt keeps the price.
+type PriceScheduleInput struct {
+	ProductID int        `json:"product_id"`
+	Label     string     `json:"label"`
+	Price     float64    `json:"price"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+	Actor     string     `json:"actor"`
+}
+
+// OfferInput holds the editable fields of an offer. Set either ProductID or
+// CategoryID. A zero ValidFrom starts the offer now.
+type OfferInput struct {
+	Name          string     `json:"name"`
+	ProductID     uint       `json:"product_id"`
+	CategoryID    uint       `json:"category_id"`
+	DiscountType  string     `json:"discount_type"`
+	DiscountValue float64    `json:"discount_value"`
+	ValidFrom     time.Time  `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	Active        bool       `json:"active"`
+	Actor         string     `json:"actor"`
 }
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"product-service/pkg/domain"
+
+	"gorm.io/gorm"
+)
+
+// categorySubtree selects the id of a category and of every category below it.
+const categorySubtree = `WITH RECURSIVE subtree AS (
+	SELECT id FROM categories WHERE id = ?
+	UNION ALL
+	SELECT c.id FROM categories c JOIN subtree ON c.parent_id = subtree.id
+) SELECT id FROM subtree`
+
+func (pr *productRepository) CreateCategory(category domain.Category) (domain.Category, error) {
+	if err := pr.DB.Create(&category).Error; err != nil {
+		return domain.Category{}, err
+	}
+	return category, nil
+}
+
+func (pr *productRepository) GetCategory(id int) (domain.Category, error) {
+	var category domain.Category
+	result := pr.DB.Raw("SELECT * FROM categories WHERE id = ?", id).Scan(&category)
+	if result.Error != nil {
+		return domain.Category{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Category{}, domain.ErrCategoryNotFound
+	}
+	return category, nil
+}
+
+func (pr *productRepository) ListCategories() ([]domain.Category, error) {
+	var categories []domain.Category
+	if err := pr.DB.Order("name, id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (pr *productRepository) UpdateCategory(category domain.Category) (domain.Category, error) {
+	err := pr.DB.Model(&category).Updates(map[string]interface{}{
+		"name":        category.Name,
+		"slug":        category.Slug,
+		"description": category.Description,
+		"parent_id":   category.ParentID,
+	}).Error
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return pr.GetCategory(int(category.ID))
+}
+
+// DeleteCategory deletes a category that has neither products nor
+// subcategories.
+func (pr *productRepository) DeleteCategory(id int) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		var category domain.Category
+		result := tx.Raw("SELECT * FROM categories WHERE id = ? FOR UPDATE", id).Scan(&category)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrCategoryNotFound
+		}
+		var used int
+		err := tx.Raw(`SELECT (SELECT COUNT(*) FROM products WHERE category_id = ?)
+		+ (SELECT COUNT(*) FROM categories WHERE parent_id = ?)`, id, id).Scan(&used).Error
+		if err != nil {
+			return err
+		}
+		if used > 0 {
+			return domain.ErrCategoryInUse
+		}
+		return tx.Exec("DELETE FROM categories WHERE id = ?", id).Error
+	})
+}
+
+// CategorySlugTaken reports whether a category other than exceptID uses slug.
+func (pr *productRepository) CategorySlugTaken(slug string, exceptID int) (bool, error) {
+	var count int
+	if err := pr.DB.Raw("SELECT COUNT(*) FROM categories WHERE slug = ? AND id <> ?", slug, exceptID).Scan(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CategoryInSubtree reports whether id is rootID or one of its descendants.
+func (pr *productRepository) CategoryInSubtree(rootID, id int) (bool, error) {
+	var count int
+	if err := pr.DB.Raw("SELECT COUNT(*) FROM ("+categorySubtree+") s WHERE id = ?", rootID, id).Scan(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
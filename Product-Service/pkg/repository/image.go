@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"fmt"
+	"product-service/pkg/domain"
+
+	"gorm.io/gorm"
+)
+
+// AddProductImage stores a new image after the existing images of its
+// product. The first image of a product always becomes its primary image.
+func (pr *productRepository) AddProductImage(image domain.ProductImage) (domain.ProductImage, error) {
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		// Lock the product so concurrent uploads get distinct positions.
+		var id int
+		result := tx.Raw("SELECT id FROM products WHERE id = ? FOR UPDATE", image.ProductID).Scan(&id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %d", domain.ErrProductNotFound, image.ProductID)
+		}
+		var existing struct {
+			Count    int
+			Position int
+		}
+		err := tx.Raw("SELECT COUNT(*) AS count, COALESCE(MAX(position), -1) AS position FROM product_images WHERE product_id = ?",
+			image.ProductID).Scan(&existing).Error
+		if err != nil {
+			return err
+		}
+		if existing.Count >= domain.MaxProductImages {
+			return domain.ErrTooManyImages
+		}
+		image.Position = existing.Position + 1
+		if existing.Count == 0 {
+			image.IsPrimary = true
+		} else if image.IsPrimary {
+			if err := tx.Exec("UPDATE product_images SET is_primary = false WHERE product_id = ?", image.ProductID).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&image).Error
+	})
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+	return image, nil
+}
+
+func (pr *productRepository) ListProductImages(productID int) ([]domain.ProductImage, error) {
+	var images []domain.ProductImage
+	if err := pr.DB.Where("product_id = ?", productID).Order("position, id").Find(&images).Error; err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// ImagesForProducts returns the images of every listed product in display
+// order.
+func (pr *productRepository) ImagesForProducts(productIDs []uint) ([]domain.ProductImage, error) {
+	var images []domain.ProductImage
+	if len(productIDs) == 0 {
+		return images, nil
+	}
+	if err := pr.DB.Where("product_id IN ?", productIDs).Order("product_id, position, id").Find(&images).Error; err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// DeleteProductImage removes an image of a product and returns it so its
+// files can be removed. When the primary image is deleted, the first of the
+// remaining images takes its place.
+func (pr *productRepository) DeleteProductImage(productID, imageID int) (domain.ProductImage, error) {
+	var image domain.ProductImage
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Raw("DELETE FROM product_images WHERE id = ? AND product_id = ? RETURNING *", imageID, productID).Scan(&image)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrImageNotFound
+		}
+		if !image.IsPrimary {
+			return nil
+		}
+		return tx.Exec(`UPDATE product_images SET is_primary = true WHERE id =
+		(SELECT id FROM product_images WHERE product_id = ? ORDER BY position, id LIMIT 1)`, productID).Error
+	})
+	if err != nil {
+		return domain.ProductImage{}, err
+	}
+	return image, nil
+}
+
+func (pr *productRepository) SetPrimaryImage(productID, imageID int) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		var count int
+		if err := tx.Raw("SELECT COUNT(*) FROM product_images WHERE id = ? AND product_id = ?", imageID, productID).Scan(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return domain.ErrImageNotFound
+		}
+		return tx.Exec("UPDATE product_images SET is_primary = (id = ?) WHERE product_id = ?", imageID, productID).Error
+	})
+}
+
+// ReorderProductImages puts the images of a product in the given order.
+// imageIDs must list every image of the product exactly once.
+func (pr *productRepository) ReorderProductImages(productID int, imageIDs []int) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		var current []int
+		if err := tx.Raw("SELECT id FROM product_images WHERE product_id = ? FOR UPDATE", productID).Scan(&current).Error; err != nil {
+			return err
+		}
+		if !sameIDs(current, imageIDs) {
+			return fmt.Errorf("%w: the order must list every image of the product once", domain.ErrInvalidImage)
+		}
+		for position, id := range imageIDs {
+			if err := tx.Exec("UPDATE product_images SET position = ? WHERE id = ?", position, id).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func sameIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+		delete(seen, id)
+	}
+	return true
+}
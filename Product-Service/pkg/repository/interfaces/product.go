@@ -3,17 +3,64 @@ package interfaces
 import (
 	"product-service/pkg/domain"
 	"product-service/pkg/models"
+	"time"
 )
 
 type ProductRepository interface {
-	ShowAllProducts(page int, count int) ([]models.ProductBrief, error)
+	ShowAllProducts(page int, count int, filter models.ProductFilter, sortBy string) ([]models.ProductBrief, error)
+	CountProducts(filter models.ProductFilter) (int, error)
+	ProductFacets(filter models.ProductFilter) ([]models.Facet, error)
 	AddProducts(product models.Product) (domain.Product, error)
 	ProductAlreadyExist(Name string) bool
 	StockInvalid(Name string) bool
-	DeleteProducts(id int) error
+	ArchiveProduct(id int) error
+	RestoreProduct(id int) error
 	CheckProduct(pid int) (bool, error)
-	UpdateProduct(pid int, stock int) (models.ProductUpdateReciever, error)
+	GetProduct(id int) (domain.Product, error)
+	SaveProduct(product domain.Product, columns []string, actor string) (domain.Product, error)
+	UpdateProduct(pid int, stock int, actor string) (models.ProductUpdateReciever, error)
 	GetQuantityFromProductID(id int) (int, error)
-	GetPriceOfProductFromID(prodcut_id int) (float64, error)
-	ProductStockMinus(productID, stock int) error
+	ProductStockMinus(productID, stock int, change models.StockChange) error
+	ProductStockPlus(productID, stock int, change models.StockChange) error
+	ProductStockMinusBulk(items []models.ProductStock, change models.StockChange) error
+	ProductStockPlusBulk(items []models.ProductStock, change models.StockChange) error
+
+	ReserveStock(reference string, items []models.ProductStock, expiresAt time.Time) ([]domain.StockReservation, error)
+	ReleaseReservations(reference string, productIDs []int) ([]domain.StockReservation, error)
+	CommitReservations(reference string, productIDs []int) ([]domain.StockReservation, error)
+	ExpireReservations(now time.Time) (int, error)
+
+	ListStockMovements(productID int, page, count int) ([]domain.InventoryMovement, error)
+	StockMismatches(productID int) ([]models.StockReconciliation, error)
+	RecordAdjustment(productID, delta int, actor string) error
+
+	AddProductImage(image domain.ProductImage) (domain.ProductImage, error)
+	ListProductImages(productID int) ([]domain.ProductImage, error)
+	ImagesForProducts(productIDs []uint) ([]domain.ProductImage, error)
+	DeleteProductImage(productID, imageID int) (domain.ProductImage, error)
+	SetPrimaryImage(productID, imageID int) error
+	ReorderProductImages(productID int, imageIDs []int) error
+
+	EffectivePrice(productID int, at time.Time) (float64, error)
+	CreatePriceSchedule(schedule domain.PriceSchedule) (domain.PriceSchedule, error)
+	ListPriceSchedules(productID int) ([]domain.PriceSchedule, error)
+	ListPriceHistory(productID int, page, count int) ([]domain.PriceHistory, error)
+	CancelPriceSchedule(id int, actor string) (domain.PriceSchedule, error)
+	StartPriceSchedules(now time.Time) (int, error)
+	EndPriceSchedules(now time.Time) (int, error)
+
+	CreateCategory(category domain.Category) (domain.Category, error)
+	GetCategory(id int) (domain.Category, error)
+	ListCategories() ([]domain.Category, error)
+	UpdateCategory(category domain.Category) (domain.Category, error)
+	DeleteCategory(id int) error
+	CategorySlugTaken(slug string, exceptID int) (bool, error)
+	CategoryInSubtree(rootID, id int) (bool, error)
+
+	CreateOffer(offer domain.Offer) (domain.Offer, error)
+	GetOffer(id int) (domain.Offer, error)
+	ListOffers(page, count int) ([]domain.Offer, error)
+	UpdateOffer(offer domain.Offer) (domain.Offer, error)
+	DeleteOffer(id int) error
+	OffersForProducts(productIDs []int, at time.Time) (map[int][]domain.Offer, error)
 }
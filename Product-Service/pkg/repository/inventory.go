@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+)
+
+// ListStockMovements lists the ledger entries of a product, newest first. A
+// zero productID lists the movements of every product.
+func (pr *productRepository) ListStockMovements(productID int, page, count int) ([]domain.InventoryMovement, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	query := pr.DB.Model(&domain.InventoryMovement{})
+	if productID != 0 {
+		query = query.Where("product_id = ?", productID)
+	}
+	var movements []domain.InventoryMovement
+	if err := query.Order("id DESC").Limit(count).Offset(offset).Find(&movements).Error; err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+// StockMismatches recomputes stock from the ledger and returns the products
+// whose stock differs from it. A zero productID checks every product.
+func (pr *productRepository) StockMismatches(productID int) ([]models.StockReconciliation, error) {
+	query := `SELECT p.id AS product_id, p.stock, COALESCE(SUM(m.delta), 0) AS ledger_stock,
+	p.stock - COALESCE(SUM(m.delta), 0) AS difference
+	FROM products p LEFT JOIN inventory_movements m ON m.product_id = p.id
+	WHERE ? = 0 OR p.id = ?
+	GROUP BY p.id, p.stock
+	HAVING p.stock <> COALESCE(SUM(m.delta), 0)
+	ORDER BY p.id`
+	var mismatches []models.StockReconciliation
+	if err := pr.DB.Raw(query, productID, productID).Scan(&mismatches).Error; err != nil {
+		return nil, err
+	}
+	return mismatches, nil
+}
+
+// RecordAdjustment appends a ledger entry without touching the stock, to bring
+// the ledger in line with stock that was changed outside of it.
+func (pr *productRepository) RecordAdjustment(productID, delta int, actor string) error {
+	return recordMovement(pr.DB, productID, delta, models.StockChange{
+		Reason:    domain.MovementAdjustment,
+		Reference: "reconciliation",
+		Actor:     actor,
+	})
+}
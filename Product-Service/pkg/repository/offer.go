@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"product-service/pkg/domain"
+	"time"
+)
+
+// productCategories lists every product of the given IDs together with its
+// category and each ancestor of that category.
+const productCategories = `WITH RECURSIVE product_categories AS (
+	SELECT products.id AS product_id, categories.id AS category_id, categories.parent_id
+	FROM products JOIN categories ON categories.id = products.category_id
+	WHERE products.id IN ?
+	UNION ALL
+	SELECT pc.product_id, categories.id, categories.parent_id
+	FROM product_categories pc JOIN categories ON categories.id = pc.parent_id
+)`
+
+func (pr *productRepository) CreateOffer(offer domain.Offer) (domain.Offer, error) {
+	if err := pr.DB.Create(&offer).Error; err != nil {
+		return domain.Offer{}, err
+	}
+	return offer, nil
+}
+
+func (pr *productRepository) GetOffer(id int) (domain.Offer, error) {
+	var offer domain.Offer
+	result := pr.DB.Raw("SELECT * FROM offers WHERE id = ?", id).Scan(&offer)
+	if result.Error != nil {
+		return domain.Offer{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Offer{}, domain.ErrOfferNotFound
+	}
+	return offer, nil
+}
+
+func (pr *productRepository) ListOffers(page, count int) ([]domain.Offer, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	var offers []domain.Offer
+	if err := pr.DB.Order("created_at DESC, id DESC").Limit(count).Offset(offset).Find(&offers).Error; err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+func (pr *productRepository) UpdateOffer(offer domain.Offer) (domain.Offer, error) {
+	err := pr.DB.Model(&offer).Updates(map[string]interface{}{
+		"name":           offer.Name,
+		"product_id":     offer.ProductID,
+		"category_id":    offer.CategoryID,
+		"discount_type":  offer.DiscountType,
+		"discount_value": offer.DiscountValue,
+		"valid_from":     offer.ValidFrom,
+		"valid_until":    offer.ValidUntil,
+		"active":         offer.Active,
+	}).Error
+	if err != nil {
+		return domain.Offer{}, err
+	}
+	return pr.GetOffer(int(offer.ID))
+}
+
+func (pr *productRepository) DeleteOffer(id int) error {
+	result := pr.DB.Exec("DELETE FROM offers WHERE id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrOfferNotFound
+	}
+	return nil
+}
+
+// OffersForProducts returns the offers in effect at the given time for each
+// of the products, keyed by product ID. An offer on a category applies to the
+// products of its subcategories too.
+func (pr *productRepository) OffersForProducts(productIDs []int, at time.Time) (map[int][]domain.Offer, error) {
+	offers := make(map[int][]domain.Offer)
+	if len(productIDs) == 0 {
+		return offers, nil
+	}
+	var matches []struct {
+		ProductID int
+		OfferID   int
+	}
+	err := pr.DB.Raw(productCategories+`
+	SELECT matched.product_id, offers.id AS offer_id FROM offers
+	JOIN (
+		SELECT id AS product_id, NULL::bigint AS category_id FROM products WHERE id IN ?
+		UNION ALL
+		SELECT product_id, category_id FROM product_categories
+	) matched ON offers.product_id = matched.product_id AND matched.category_id IS NULL
+		OR offers.category_id = matched.category_id
+	WHERE offers.active AND offers.valid_from <= ? AND (offers.valid_until IS NULL OR offers.valid_until > ?)
+	ORDER BY matched.product_id, offers.id`, productIDs, productIDs, at, at).Scan(&matches).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return offers, nil
+	}
+
+	ids := make([]int, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, m.OfferID)
+	}
+	var found []domain.Offer
+	if err := pr.DB.Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[int]domain.Offer, len(found))
+	for _, o := range found {
+		byID[int(o.ID)] = o
+	}
+	for _, m := range matches {
+		offers[m.ProductID] = append(offers[m.ProductID], byID[m.OfferID])
+	}
+	return offers, nil
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"fmt"
+	"product-service/pkg/domain"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EffectivePrice is the price of a product at the given time. A schedule that
+// is due but not yet applied by the scheduler already counts, and one that
+// has ended but is not yet reverted no longer does.
+func (pr *productRepository) EffectivePrice(productID int, at time.Time) (float64, error) {
+	var price float64
+	err := pr.DB.Raw(`SELECT COALESCE(
+		(SELECT price FROM price_schedules WHERE product_id = ? AND status IN (?, ?)
+			AND starts_at <= ? AND (ends_at IS NULL OR ends_at > ?) ORDER BY starts_at DESC LIMIT 1),
+		(SELECT previous_price FROM price_schedules WHERE product_id = ? AND status = ?
+			AND ends_at <= ? ORDER BY ends_at DESC LIMIT 1),
+		(SELECT price FROM products WHERE id = ?), 0)`,
+		productID, domain.ScheduleScheduled, domain.ScheduleActive, at, at,
+		productID, domain.ScheduleActive, at,
+		productID).Scan(&price).Error
+	if err != nil {
+		return 0, err
+	}
+	return price, nil
+}
+
+// CreatePriceSchedule stores a price schedule unless it overlaps a pending or
+// running schedule of the same product.
+func (pr *productRepository) CreatePriceSchedule(schedule domain.PriceSchedule) (domain.PriceSchedule, error) {
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		var id int
+		result := tx.Raw("SELECT id FROM products WHERE id = ? FOR UPDATE", schedule.ProductID).Scan(&id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %d", domain.ErrProductNotFound, schedule.ProductID)
+		}
+		var overlapping int
+		err := tx.Raw(`SELECT COUNT(*) FROM price_schedules WHERE product_id = ? AND status IN (?, ?)
+		AND (ends_at IS NULL OR ends_at > ?) AND (CAST(? AS timestamptz) IS NULL OR starts_at < ?)`,
+			schedule.ProductID, domain.ScheduleScheduled, domain.ScheduleActive,
+			schedule.StartsAt, schedule.EndsAt, schedule.EndsAt).Scan(&overlapping).Error
+		if err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return domain.ErrScheduleOverlap
+		}
+		schedule.Status = domain.ScheduleScheduled
+		return tx.Create(&schedule).Error
+	})
+	if err != nil {
+		return domain.PriceSchedule{}, err
+	}
+	return schedule, nil
+}
+
+func (pr *productRepository) ListPriceSchedules(productID int) ([]domain.PriceSchedule, error) {
+	var schedules []domain.PriceSchedule
+	if err := pr.DB.Where("product_id = ?", productID).Order("starts_at DESC, id DESC").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// ListPriceHistory lists the price changes of a product, newest first.
+func (pr *productRepository) ListPriceHistory(productID int, page, count int) ([]domain.PriceHistory, error) {
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * count
+	var history []domain.PriceHistory
+	err := pr.DB.Where("product_id = ?", productID).Order("id DESC").Limit(count).Offset(offset).Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// CancelPriceSchedule cancels a schedule that has not finished. A running
+// schedule puts the previous price back first.
+func (pr *productRepository) CancelPriceSchedule(id int, actor string) (domain.PriceSchedule, error) {
+	var schedule domain.PriceSchedule
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Limit(1).Find(&schedule)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrScheduleNotFound
+		}
+		switch schedule.Status {
+		case domain.ScheduleActive:
+			if err := revertSchedule(tx, &schedule, actor); err != nil {
+				return err
+			}
+		case domain.ScheduleScheduled:
+		default:
+			return domain.ErrScheduleFinished
+		}
+		schedule.Status = domain.ScheduleCancelled
+		return tx.Model(&schedule).Update("status", schedule.Status).Error
+	})
+	if err != nil {
+		return domain.PriceSchedule{}, err
+	}
+	return schedule, nil
+}
+
+// StartPriceSchedules applies the price of every schedule that is due. A
+// schedule without an end is a permanent price change and completes at once;
+// one whose whole period was missed completes without changing the price.
+func (pr *productRepository) StartPriceSchedules(now time.Time) (int, error) {
+	var schedules []domain.PriceSchedule
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND starts_at <= ?", domain.ScheduleScheduled, now).
+			Order("starts_at").Find(&schedules).Error
+		if err != nil {
+			return err
+		}
+		for i := range schedules {
+			s := &schedules[i]
+			if s.EndsAt != nil && !s.EndsAt.After(now) {
+				s.Status = domain.ScheduleCompleted
+				if err := tx.Model(s).Update("status", s.Status).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			previous, err := setPrice(tx, s.ProductID, s.Price, domain.PriceChangeScheduleStart, &s.ID, s.CreatedBy)
+			if err != nil {
+				return err
+			}
+			s.PreviousPrice = previous
+			s.Status = domain.ScheduleActive
+			if s.EndsAt == nil {
+				s.Status = domain.ScheduleCompleted
+			}
+			if err := tx.Model(s).Updates(map[string]interface{}{"status": s.Status, "previous_price": s.PreviousPrice}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(schedules), nil
+}
+
+// EndPriceSchedules puts back the previous price of every running schedule
+// that has ended.
+func (pr *productRepository) EndPriceSchedules(now time.Time) (int, error) {
+	var schedules []domain.PriceSchedule
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND ends_at <= ?", domain.ScheduleActive, now).
+			Order("ends_at").Find(&schedules).Error
+		if err != nil {
+			return err
+		}
+		for i := range schedules {
+			s := &schedules[i]
+			if err := revertSchedule(tx, s, "system"); err != nil {
+				return err
+			}
+			s.Status = domain.ScheduleCompleted
+			if err := tx.Model(s).Update("status", s.Status).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(schedules), nil
+}
+
+func revertSchedule(tx *gorm.DB, schedule *domain.PriceSchedule, actor string) error {
+	_, err := setPrice(tx, schedule.ProductID, schedule.PreviousPrice, domain.PriceChangeScheduleEnd, &schedule.ID, actor)
+	return err
+}
+
+// setPrice changes the price of a product, records the change and returns
+// the price it had before.
+func setPrice(tx *gorm.DB, productID uint, price float64, reason string, scheduleID *uint, actor string) (float64, error) {
+	var previous float64
+	result := tx.Raw("SELECT price FROM products WHERE id = ? FOR UPDATE", productID).Scan(&previous)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, fmt.Errorf("%w: %d", domain.ErrProductNotFound, productID)
+	}
+	if err := tx.Exec("UPDATE products SET price = ? WHERE id = ?", price, productID).Error; err != nil {
+		return 0, err
+	}
+	return previous, recordPriceChange(tx, productID, previous, price, reason, scheduleID, actor)
+}
+
+// recordPriceChange appends a price change to the price history.
+func recordPriceChange(tx *gorm.DB, productID uint, oldPrice, newPrice float64, reason string, scheduleID *uint, actor string) error {
+	return tx.Create(&domain.PriceHistory{
+		ProductID:  productID,
+		OldPrice:   oldPrice,
+		NewPrice:   newPrice,
+		Reason:     reason,
+		ScheduleID: scheduleID,
+		Actor:      actor,
+	}).Error
+}
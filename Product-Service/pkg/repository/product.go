@@ -2,10 +2,13 @@ package repository
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"product-service/pkg/domain"
 	"product-service/pkg/models"
 	"product-service/pkg/repository/interfaces"
+	"sort"
+	"strings"
 
 	"gorm.io/gorm"
 )
@@ -20,13 +23,14 @@ func NewProductRepository(DB *gorm.DB) interfaces.ProductRepository {
 	}
 }
 
-func (pr *productRepository) ShowAllProducts(page int, count int) ([]models.ProductBrief, error) {
+func (pr *productRepository) ShowAllProducts(page int, count int, filter models.ProductFilter, sortBy string) ([]models.ProductBrief, error) {
 	if page <= 0 {
 		page = 1
 	}
 	offset := (page - 1) * count
 	var productBrief []models.ProductBrief
-	err := pr.DB.Raw(`SELECT * FROM products limit ? offset ?`, count, offset).Scan(&productBrief).Error
+	query := sortProducts(filterProducts(pr.DB.Table("products"), filter), filter, sortBy)
+	err := query.Limit(count).Offset(offset).Scan(&productBrief).Error
 	if err != nil {
 		return nil, err
 	}
@@ -49,18 +53,28 @@ func (pr *productRepository) StockInvalid(Name string) bool {
 	return count > 0
 }
 func (pr *productRepository) AddProducts(product models.Product) (domain.Product, error) {
-	var p domain.Product
-	query := `
-    INSERT INTO products (name, description, category_id, size, stock, price)
-    VALUES ($1, $2, $3, $4, $5, $6)
-    RETURNING name, description, category_id, size, stock, price`
-	err := pr.DB.Raw(query, product.Name, product.Description, product.CategoryID, product.Size, product.Stock, product.Price).Scan(&p).Error
-	if err != nil {
-		log.Println(err.Error())
-		return domain.Product{}, err
-	}
 	var ProductResponses domain.Product
-	err = pr.DB.Raw("SELECT * FROM products WHERE name = ?", p.Name).Scan(&ProductResponses).Error
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		var p domain.Product
+		query := `
+    INSERT INTO products (name, description, category_id, size, stock, price,
+        brand, cpu, ram_gb, storage_type, storage_gb, gpu, screen_resolution, weight_kg, battery_wh, os)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+    RETURNING id, name, description, category_id, size, stock, price`
+		s := product.LaptopSpecs
+		err := tx.Raw(query, product.Name, product.Description, product.CategoryID, product.Size, product.Stock, product.Price,
+			s.Brand, s.CPU, s.RAMGB, s.StorageType, s.StorageGB, s.GPU, s.ScreenResolution, s.WeightKg, s.BatteryWh, s.OS).Scan(&p).Error
+		if err != nil {
+			return err
+		}
+		if err := recordMovement(tx, int(p.ID), p.Stock, models.StockChange{Reason: domain.MovementInitial}); err != nil {
+			return err
+		}
+		if err := recordPriceChange(tx, p.ID, 0, p.Price, domain.PriceChangeInitial, nil, ""); err != nil {
+			return err
+		}
+		return tx.Raw("SELECT * FROM products WHERE name = ?", p.Name).Scan(&ProductResponses).Error
+	})
 	if err != nil {
 		log.Println(err.Error())
 		return domain.Product{}, err
@@ -68,22 +82,41 @@ func (pr *productRepository) AddProducts(product models.Product) (domain.Product
 	return ProductResponses, nil
 }
 
-func (pr *productRepository) DeleteProducts(product_id int) error {
-	var count int
-	if err := pr.DB.Raw("SELECT COUNT(*) FROM products WHERE id=?", product_id).Scan(&count).Error; err != nil {
-		return err
+// ArchiveProduct hides a product from listings and stops it from being sold.
+// The row stays so orders and carts that point at it still resolve.
+func (pr *productRepository) ArchiveProduct(product_id int) error {
+	return pr.setProductStatus(product_id, domain.ProductStatusActive, domain.ProductStatusArchived, domain.ErrProductArchived)
+}
+
+// RestoreProduct makes an archived product active again.
+func (pr *productRepository) RestoreProduct(product_id int) error {
+	return pr.setProductStatus(product_id, domain.ProductStatusArchived, domain.ProductStatusActive, domain.ErrProductNotArchived)
+}
+
+// setProductStatus moves a product from one status to another. wrongStatus is
+// returned when the product exists but is not in the from status.
+func (pr *productRepository) setProductStatus(product_id int, from, to string, wrongStatus error) error {
+	deletedAt := "NULL"
+	if to == domain.ProductStatusArchived {
+		deletedAt = "NOW()"
+	}
+	result := pr.DB.Exec("UPDATE products SET status = ?, deleted_at = "+deletedAt+" WHERE id = ? AND status = ?", to, product_id, from)
+	if result.Error != nil {
+		return result.Error
 	}
-	if count < 1 {
-		return errors.New("product for given id does not exist")
+	if result.RowsAffected > 0 {
+		return nil
 	}
-	if err := pr.DB.Exec("DELETE FROM products WHERE id=?", product_id).Error; err != nil {
+	if _, err := pr.GetProduct(product_id); err != nil {
 		return err
 	}
-	return nil
+	return wrongStatus
 }
+
+// CheckProduct reports whether a product exists and can be sold.
 func (pr *productRepository) CheckProduct(pid int) (bool, error) {
 	var a int
-	err := pr.DB.Raw("SELECT COUNT(*) FROM products WHERE id=?", pid).Scan(&a).Error
+	err := pr.DB.Raw("SELECT COUNT(*) FROM products WHERE id=? AND status = ?", pid, domain.ProductStatusActive).Scan(&a).Error
 	if err != nil {
 		return false, err
 	}
@@ -92,14 +125,17 @@ func (pr *productRepository) CheckProduct(pid int) (bool, error) {
 	}
 	return true, err
 }
-func (pr *productRepository) UpdateProduct(pid int, stock int) (models.ProductUpdateReciever, error) {
+func (pr *productRepository) UpdateProduct(pid int, stock int, actor string) (models.ProductUpdateReciever, error) {
 	if stock <= 0 {
 		return models.ProductUpdateReciever{}, errors.New("stock doesnot update invalid input")
 	}
 	if pr.DB == nil {
 		return models.ProductUpdateReciever{}, errors.New("database connection is nil")
 	}
-	if err := pr.DB.Exec("UPDATE products SET stock = stock + $1 WHERE id = $2", stock, pid).Error; err != nil {
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		return incrementStock(tx, pid, stock, models.StockChange{Reason: domain.MovementRestock, Actor: actor})
+	})
+	if err != nil {
 		return models.ProductUpdateReciever{}, err
 	}
 	var newdetails models.ProductUpdateReciever
@@ -111,6 +147,80 @@ func (pr *productRepository) UpdateProduct(pid int, stock int) (models.ProductUp
 	newdetails.Stock = newQuantity
 	return newdetails, nil
 }
+func (pr *productRepository) GetProduct(id int) (domain.Product, error) {
+	var product domain.Product
+	result := pr.DB.Raw("SELECT * FROM products WHERE id = ?", id).Scan(&product)
+	if result.Error != nil {
+		return domain.Product{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.Product{}, fmt.Errorf("%w: %d", domain.ErrProductNotFound, id)
+	}
+	return product, nil
+}
+
+// SaveProduct writes the given columns of a product and leaves the others as
+// they are, so stock taken by orders since the product was read is kept. A
+// change of stock is recorded in the inventory ledger as an adjustment by the
+// actor and a change of price in the price history. While a scheduled price
+// is running, a new price becomes the price the product returns to when the
+// schedule ends.
+func (pr *productRepository) SaveProduct(product domain.Product, columns []string, actor string) (domain.Product, error) {
+	values := make(map[string]interface{}, len(columns))
+	all := productColumns(product)
+	for _, column := range columns {
+		value, ok := all[column]
+		if !ok {
+			return domain.Product{}, fmt.Errorf("%w: unknown field %q", domain.ErrInvalidFieldMask, column)
+		}
+		values[column] = value
+	}
+
+	var saved domain.Product
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		var current struct {
+			Stock int
+			Price float64
+		}
+		result := tx.Raw("SELECT stock, price FROM products WHERE id = ? FOR UPDATE", product.ID).Scan(&current)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %d", domain.ErrProductNotFound, product.ID)
+		}
+		if _, ok := values["price"]; ok && product.Price != current.Price {
+			running := tx.Model(&domain.PriceSchedule{}).
+				Where("product_id = ? AND status = ?", product.ID, domain.ScheduleActive).
+				Update("previous_price", product.Price)
+			if running.Error != nil {
+				return running.Error
+			}
+			if running.RowsAffected > 0 {
+				delete(values, "price")
+			} else if err := recordPriceChange(tx, product.ID, current.Price, product.Price, domain.PriceChangeManual, nil, actor); err != nil {
+				return err
+			}
+		}
+		if len(values) > 0 {
+			if err := tx.Table("products").Where("id = ?", product.ID).Updates(values).Error; err != nil {
+				return err
+			}
+		}
+		if _, ok := values["stock"]; ok && product.Stock != current.Stock {
+			change := models.StockChange{Reason: domain.MovementAdjustment, Actor: actor}
+			if err := recordMovement(tx, int(product.ID), product.Stock-current.Stock, change); err != nil {
+				return err
+			}
+		}
+		return tx.Raw("SELECT * FROM products WHERE id = ?", product.ID).Scan(&saved).Error
+	})
+	if err != nil {
+		return domain.Product{}, err
+	}
+	return saved, nil
+}
+
 func (pr *productRepository) GetQuantityFromProductID(id int) (int, error) {
 	var quantity int
 	err := pr.DB.Raw("SELECT stock FROM products WHERE id= ?", id).Scan(&quantity).Error
@@ -120,18 +230,182 @@ func (pr *productRepository) GetQuantityFromProductID(id int) (int, error) {
 
 	return quantity, nil
 }
-func (pr *productRepository) GetPriceOfProductFromID(prodcut_id int) (float64, error) {
-	var productPrice float64
+func (pr *productRepository) ProductStockMinus(productID, stock int, change models.StockChange) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		return decrementStock(tx, productID, stock, change)
+	})
+}
+func (pr *productRepository) ProductStockPlus(productID, stock int, change models.StockChange) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		return incrementStock(tx, productID, stock, change)
+	})
+}
 
-	if err := pr.DB.Raw("SELECT price FROM products WHERE id = ?", prodcut_id).Scan(&productPrice).Error; err != nil {
-		return 0.0, err
-	}
-	return productPrice, nil
+// ProductStockMinusBulk takes stock for every item in one transaction. If any
+// product does not have enough stock, nothing is taken.
+func (pr *productRepository) ProductStockMinusBulk(items []models.ProductStock, change models.StockChange) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		for _, item := range sortedStockItems(items) {
+			if err := decrementStock(tx, item.ProductID, item.Stock, change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
-func (pr *productRepository) ProductStockMinus(productID, stock int) error {
-	err := pr.DB.Exec("UPDATE products SET stock = stock - ? WHERE id = ?", stock, productID).Error
-	if err != nil {
+
+// ProductStockPlusBulk puts stock back for every item in one transaction.
+func (pr *productRepository) ProductStockPlusBulk(items []models.ProductStock, change models.StockChange) error {
+	return pr.DB.Transaction(func(tx *gorm.DB) error {
+		for _, item := range sortedStockItems(items) {
+			if err := incrementStock(tx, item.ProductID, item.Stock, change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// decrementStock takes stock from a product while holding its row lock, so
+// concurrent orders cannot take more than is left, and records the movement.
+func decrementStock(tx *gorm.DB, productID, quantity int, change models.StockChange) error {
+	var product struct {
+		Stock  int
+		Status string
+	}
+	result := tx.Raw("SELECT stock, status FROM products WHERE id = ? FOR UPDATE", productID).Scan(&product)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %d", domain.ErrProductNotFound, productID)
+	}
+	if product.Status == domain.ProductStatusArchived {
+		return fmt.Errorf("%w: %d", domain.ErrProductArchived, productID)
+	}
+	if product.Stock < quantity {
+		return fmt.Errorf("%w for product %d: %d left, %d requested", domain.ErrInsufficientStock, productID, product.Stock, quantity)
+	}
+	if err := tx.Exec("UPDATE products SET stock = stock - ? WHERE id = ?", quantity, productID).Error; err != nil {
 		return err
 	}
-	return nil
+	return recordMovement(tx, productID, -quantity, change)
+}
+
+func incrementStock(tx *gorm.DB, productID, quantity int, change models.StockChange) error {
+	result := tx.Exec("UPDATE products SET stock = stock + ? WHERE id = ?", quantity, productID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %d", domain.ErrProductNotFound, productID)
+	}
+	return recordMovement(tx, productID, quantity, change)
+}
+
+// recordMovement appends a stock change to the inventory ledger.
+func recordMovement(tx *gorm.DB, productID, delta int, change models.StockChange) error {
+	return tx.Create(&domain.InventoryMovement{
+		ProductID:   uint(productID),
+		Delta:       delta,
+		Reason:      change.Reason,
+		ReferenceID: change.Reference,
+		Actor:       change.Actor,
+	}).Error
+}
+
+// sortedStockItems orders items by product so that transactions touching
+// several products always lock the rows in the same order.
+func sortedStockItems(items []models.ProductStock) []models.ProductStock {
+	sorted := append([]models.ProductStock(nil), items...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ProductID < sorted[j].ProductID })
+	return sorted
+}
+
+// filterProducts adds a condition for every field set in the filter.
+func filterProducts(query *gorm.DB, filter models.ProductFilter) *gorm.DB {
+	switch filter.Status {
+	case "all":
+	case domain.ProductStatusArchived:
+		query = query.Where("status = ?", domain.ProductStatusArchived)
+	default:
+		query = query.Where("status = ?", domain.ProductStatusActive)
+	}
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		// Full text search matches whole (stemmed) words; the ILIKE fallback
+		// lets a partly typed word such as "thinkp" match as well.
+		query = query.Where("("+searchDocument+" @@ plainto_tsquery('english', ?) OR name ILIKE ? OR description ILIKE ?)",
+			q, "%"+q+"%", "%"+q+"%")
+	}
+	if filter.CategoryID > 0 {
+		// A category also lists the products of its subcategories.
+		query = query.Where("category_id IN ("+categorySubtree+")", filter.CategoryID)
+	}
+	if filter.MinPrice > 0 {
+		query = query.Where("price >= ?", filter.MinPrice)
+	}
+	if filter.MaxPrice > 0 {
+		query = query.Where("price <= ?", filter.MaxPrice)
+	}
+	if filter.InStock {
+		query = query.Where("stock > 0")
+	}
+	if filter.Brand != "" {
+		query = query.Where("LOWER(brand) = LOWER(?)", filter.Brand)
+	}
+	if filter.CPU != "" {
+		query = query.Where("cpu ILIKE ?", "%"+filter.CPU+"%")
+	}
+	if filter.MinRAMGB > 0 {
+		query = query.Where("ram_gb >= ?", filter.MinRAMGB)
+	}
+	if filter.MaxRAMGB > 0 {
+		query = query.Where("ram_gb <= ?", filter.MaxRAMGB)
+	}
+	if filter.StorageType != "" {
+		query = query.Where("storage_type = ?", strings.ToLower(filter.StorageType))
+	}
+	if filter.MinStorageGB > 0 {
+		query = query.Where("storage_gb >= ?", filter.MinStorageGB)
+	}
+	if filter.GPU != "" {
+		query = query.Where("gpu ILIKE ?", "%"+filter.GPU+"%")
+	}
+	if filter.ScreenResolution != "" {
+		query = query.Where("screen_resolution = ?", filter.ScreenResolution)
+	}
+	if filter.MaxWeightKg > 0 {
+		query = query.Where("weight_kg <= ?", filter.MaxWeightKg)
+	}
+	if filter.MinBatteryWh > 0 {
+		query = query.Where("battery_wh >= ?", filter.MinBatteryWh)
+	}
+	if filter.OS != "" {
+		query = query.Where("os = ?", strings.ToLower(filter.OS))
+	}
+	return query
+}
+
+// productColumns maps the columns SaveProduct can write to their value in the
+// product.
+func productColumns(product domain.Product) map[string]interface{} {
+	s := product.LaptopSpecs
+	return map[string]interface{}{
+		"name":              product.Name,
+		"description":       product.Description,
+		"category_id":       product.CategoryID,
+		"size":              product.Size,
+		"stock":             product.Stock,
+		"price":             product.Price,
+		"brand":             s.Brand,
+		"cpu":               s.CPU,
+		"ram_gb":            s.RAMGB,
+		"storage_type":      s.StorageType,
+		"storage_gb":        s.StorageGB,
+		"gpu":               s.GPU,
+		"screen_resolution": s.ScreenResolution,
+		"weight_kg":         s.WeightKg,
+		"battery_wh":        s.BatteryWh,
+		"os":                s.OS,
+	}
 }
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReserveStock sets the quantity held for each product under the reference.
+// Stock is taken or given back for the difference to any active reservation,
+// and the expiry of the reservations is moved to expiresAt. Nothing is
+// reserved if any product does not have enough stock.
+func (pr *productRepository) ReserveStock(reference string, items []models.ProductStock, expiresAt time.Time) ([]domain.StockReservation, error) {
+	var reservations []domain.StockReservation
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		for _, item := range sortedStockItems(items) {
+			var reservation domain.StockReservation
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("reference = ? AND product_id = ? AND status = ?", reference, item.ProductID, domain.ReservationActive).
+				Limit(1).Find(&reservation).Error
+			if err != nil {
+				return err
+			}
+
+			delta := item.Stock - reservation.Quantity
+			if delta > 0 {
+				change := models.StockChange{Reason: domain.MovementReservation, Reference: reference, Actor: "system"}
+				if err := decrementStock(tx, item.ProductID, delta, change); err != nil {
+					return err
+				}
+			} else if delta < 0 {
+				change := models.StockChange{Reason: domain.MovementRelease, Reference: reference, Actor: "system"}
+				if err := incrementStock(tx, item.ProductID, -delta, change); err != nil {
+					return err
+				}
+			}
+
+			reservation.Reference = reference
+			reservation.ProductID = uint(item.ProductID)
+			reservation.Quantity = item.Stock
+			reservation.Status = domain.ReservationActive
+			reservation.ExpiresAt = expiresAt
+			if err := tx.Save(&reservation).Error; err != nil {
+				return err
+			}
+			reservations = append(reservations, reservation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// ReleaseReservations gives the stock of the active reservations of the
+// reference back. An empty productIDs releases every product.
+func (pr *productRepository) ReleaseReservations(reference string, productIDs []int) ([]domain.StockReservation, error) {
+	return pr.closeReservations(reference, productIDs, domain.ReservationReleased)
+}
+
+// CommitReservations makes the active reservations of the reference
+// permanent. The stock was already taken when it was reserved.
+func (pr *productRepository) CommitReservations(reference string, productIDs []int) ([]domain.StockReservation, error) {
+	return pr.closeReservations(reference, productIDs, domain.ReservationCommitted)
+}
+
+// ExpireReservations gives back the stock of every active reservation that
+// expired before now and returns how many were expired.
+func (pr *productRepository) ExpireReservations(now time.Time) (int, error) {
+	var reservations []domain.StockReservation
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND expires_at < ?", domain.ReservationActive, now).
+			Order("product_id").Find(&reservations).Error
+		if err != nil {
+			return err
+		}
+		return finishReservations(tx, reservations, domain.ReservationExpired)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(reservations), nil
+}
+
+func (pr *productRepository) closeReservations(reference string, productIDs []int, status string) ([]domain.StockReservation, error) {
+	var reservations []domain.StockReservation
+	err := pr.DB.Transaction(func(tx *gorm.DB) error {
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("reference = ? AND status = ?", reference, domain.ReservationActive)
+		if len(productIDs) > 0 {
+			query = query.Where("product_id IN ?", productIDs)
+		}
+		if err := query.Order("product_id").Find(&reservations).Error; err != nil {
+			return err
+		}
+		if len(reservations) == 0 {
+			return domain.ErrReservationNotFound
+		}
+		return finishReservations(tx, reservations, status)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// finishReservations moves reservations out of active, giving their stock back
+// unless they are committed.
+func finishReservations(tx *gorm.DB, reservations []domain.StockReservation, status string) error {
+	for i := range reservations {
+		r := &reservations[i]
+		if status != domain.ReservationCommitted {
+			change := models.StockChange{Reason: domain.MovementRelease, Reference: r.Reference, Actor: "system"}
+			if err := incrementStock(tx, int(r.ProductID), r.Quantity, change); err != nil {
+				return err
+			}
+		}
+		r.Status = status
+		if err := tx.Model(r).Update("status", status).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// searchDocument is the text of a product that free text search runs over.
+const searchDocument = "to_tsvector('english', name || ' ' || COALESCE(description, ''))"
+
+// unitsSold counts the units of each product that were sold, either straight
+// from stock or through a committed reservation.
+const unitsSold = `(SELECT product_id, SUM(units) AS sold FROM (
+	SELECT product_id, -delta AS units FROM inventory_movements WHERE reason = ?
+	UNION ALL
+	SELECT product_id, quantity AS units FROM stock_reservations WHERE status = ?
+) sales GROUP BY product_id) popularity`
+
+// priceBucket labels the price range a product falls into.
+const priceBucket = `CASE
+	WHEN price < 50000 THEN '0-50000'
+	WHEN price < 100000 THEN '50000-100000'
+	WHEN price < 150000 THEN '100000-150000'
+	ELSE '150000+' END`
+
+// facetColumns are the facets counted for a listing, by name.
+var facetColumns = []struct {
+	name   string
+	column string
+}{
+	{"category_id", "CAST(category_id AS TEXT)"},
+	{"brand", "brand"},
+	{"ram_gb", "CAST(ram_gb AS TEXT)"},
+	{"storage_type", "storage_type"},
+	{"os", "os"},
+	{"price", priceBucket},
+	{"availability", "CASE WHEN stock > 0 THEN 'in_stock' ELSE 'out_of_stock' END"},
+}
+
+// sortProducts orders a listing. Ties, and listings without a sort, fall back
+// to the product id so that pages do not overlap.
+func sortProducts(query *gorm.DB, filter models.ProductFilter, sortBy string) *gorm.DB {
+	switch sortBy {
+	case domain.SortPriceAsc:
+		query = query.Order("price ASC")
+	case domain.SortPriceDesc:
+		query = query.Order("price DESC")
+	case domain.SortNewest:
+		query = query.Order("created_at DESC")
+	case domain.SortPopularity:
+		query = query.Select("products.*").
+			Joins("LEFT JOIN "+unitsSold+" ON popularity.product_id = products.id", domain.MovementSale, domain.ReservationCommitted).
+			Order("COALESCE(popularity.sold, 0) DESC")
+	case domain.SortRelevance:
+		if q := strings.TrimSpace(filter.Query); q != "" {
+			query = query.Order(clause.OrderBy{Expression: clause.Expr{
+				SQL:                "ts_rank(" + searchDocument + ", plainto_tsquery('english', ?)) DESC",
+				Vars:               []interface{}{q},
+				WithoutParentheses: true,
+			}})
+		}
+	}
+	return query.Order("products.id")
+}
+
+// CountProducts counts every product that matches the filter.
+func (pr *productRepository) CountProducts(filter models.ProductFilter) (int, error) {
+	var total int64
+	if err := filterProducts(pr.DB.Table("products"), filter).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return int(total), nil
+}
+
+// ProductFacets counts the products that match the filter by each facet
+// value, most common value first.
+func (pr *productRepository) ProductFacets(filter models.ProductFilter) ([]models.Facet, error) {
+	facets := make([]models.Facet, 0, len(facetColumns))
+	for _, f := range facetColumns {
+		var values []models.FacetValue
+		err := filterProducts(pr.DB.Table("products"), filter).
+			Select(f.column + " AS value, COUNT(*) AS count").
+			Where(f.column + " IS NOT NULL AND " + f.column + " <> ''").
+			Group("value").Order("count DESC, value").
+			Scan(&values).Error
+		if err != nil {
+			return nil, err
+		}
+		facets = append(facets, models.Facet{Name: f.name, Values: values})
+	}
+	return facets, nil
+}
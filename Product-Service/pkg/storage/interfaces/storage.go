@@ -0,0 +1,8 @@
+package interfaces
+
+// Storage keeps media files under keys such as "products/12/3f9a.jpg".
+type Storage interface {
+	Save(key string, data []byte) error
+	Delete(key string) error
+	URL(key string) string
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"product-service/pkg/storage/interfaces"
+	"strings"
+)
+
+// localStorage keeps files in a directory on local disk.
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+func NewLocalStorage(dir, baseURL string) interfaces.Storage {
+	return &localStorage{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Save writes the file next to its final name first and renames it, so a
+// reader never sees a partly written file.
+func (s *localStorage) Save(key string, data []byte) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func (s *localStorage) Delete(key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localStorage) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// path turns a key into a file name inside the storage directory and refuses
+// keys that would point outside of it.
+func (s *localStorage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		return "", errors.New("invalid storage key: " + key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
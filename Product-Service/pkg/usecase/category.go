@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"fmt"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"regexp"
+	"strings"
+)
+
+var (
+	slugPattern  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	nonSlugRunes = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+func (pr *productUseCase) AddCategory(input models.CategoryInput) (domain.Category, error) {
+	category, err := pr.prepareCategory(0, input)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	return pr.productRepository.CreateCategory(category)
+}
+
+// UpdateCategory replaces the fields of a category. Moving a category below
+// itself or one of its subcategories is refused.
+func (pr *productUseCase) UpdateCategory(id int, input models.CategoryInput) (domain.Category, error) {
+	if _, err := pr.productRepository.GetCategory(id); err != nil {
+		return domain.Category{}, err
+	}
+	category, err := pr.prepareCategory(id, input)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	category.ID = uint(id)
+	return pr.productRepository.UpdateCategory(category)
+}
+
+func (pr *productUseCase) DeleteCategory(id int) error {
+	return pr.productRepository.DeleteCategory(id)
+}
+
+func (pr *productUseCase) ListCategories() ([]domain.Category, error) {
+	return pr.productRepository.ListCategories()
+}
+
+// CategoryTree returns the root categories with their subcategories nested
+// below them.
+func (pr *productUseCase) CategoryTree() ([]models.CategoryNode, error) {
+	categories, err := pr.productRepository.ListCategories()
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uint][]domain.Category)
+	for _, c := range categories {
+		var parent uint
+		if c.ParentID != nil {
+			parent = *c.ParentID
+		}
+		children[parent] = append(children[parent], c)
+	}
+	var build func(parent uint) []models.CategoryNode
+	build = func(parent uint) []models.CategoryNode {
+		nodes := []models.CategoryNode{}
+		for _, c := range children[parent] {
+			nodes = append(nodes, models.CategoryNode{Category: c, Children: build(c.ID)})
+		}
+		return nodes
+	}
+	return build(0), nil
+}
+
+// prepareCategory validates the input for the category id, which is zero for
+// a new category, and turns it into a category.
+func (pr *productUseCase) prepareCategory(id int, input models.CategoryInput) (domain.Category, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return domain.Category{}, fmt.Errorf("%w: name is required", domain.ErrInvalidCategory)
+	}
+	slug := strings.ToLower(strings.TrimSpace(input.Slug))
+	if slug == "" {
+		slug = strings.Trim(nonSlugRunes.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	}
+	if !slugPattern.MatchString(slug) {
+		return domain.Category{}, fmt.Errorf("%w: slug must be lower case words separated by dashes", domain.ErrInvalidCategory)
+	}
+	taken, err := pr.productRepository.CategorySlugTaken(slug, id)
+	if err != nil {
+		return domain.Category{}, err
+	}
+	if taken {
+		return domain.Category{}, domain.ErrCategoryExists
+	}
+
+	category := domain.Category{
+		Name:        name,
+		Slug:        slug,
+		Description: strings.TrimSpace(input.Description),
+	}
+	if input.ParentID == 0 {
+		return category, nil
+	}
+	if _, err := pr.productRepository.GetCategory(int(input.ParentID)); err != nil {
+		return domain.Category{}, fmt.Errorf("parent: %w", err)
+	}
+	if id != 0 {
+		cycle, err := pr.productRepository.CategoryInSubtree(id, int(input.ParentID))
+		if err != nil {
+			return domain.Category{}, err
+		}
+		if cycle {
+			return domain.Category{}, domain.ErrCategoryCycle
+		}
+	}
+	parentID := input.ParentID
+	category.ParentID = &parentID
+	return category, nil
+}
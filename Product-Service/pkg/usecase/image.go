@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+)
+
+// MaxImageBytes is the largest image that can be uploaded.
+const MaxImageBytes = 5 << 20
+
+// imageExtensions are the accepted image types by their detected content type.
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// UploadProductImage stores an image of a product together with a thumbnail.
+// A primary image replaces the current primary image of the product.
+func (pr *productUseCase) UploadProductImage(productID int, data []byte, primary bool) (models.ProductImage, error) {
+	if len(data) == 0 || len(data) > MaxImageBytes {
+		return models.ProductImage{}, fmt.Errorf("%w: images must be between 1 byte and %d MB", domain.ErrInvalidImage, MaxImageBytes>>20)
+	}
+	contentType := http.DetectContentType(data)
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return models.ProductImage{}, fmt.Errorf("%w: %s is not a JPEG, PNG or GIF image", domain.ErrInvalidImage, contentType)
+	}
+	if _, err := pr.productRepository.GetProduct(productID); err != nil {
+		return models.ProductImage{}, err
+	}
+	thumbnail, err := makeThumbnail(data)
+	if err != nil {
+		return models.ProductImage{}, fmt.Errorf("%w: %v", domain.ErrInvalidImage, err)
+	}
+
+	name, err := randomName()
+	if err != nil {
+		return models.ProductImage{}, err
+	}
+	image := domain.ProductImage{
+		ProductID:    uint(productID),
+		StorageKey:   fmt.Sprintf("products/%d/%s%s", productID, name, ext),
+		ThumbnailKey: fmt.Sprintf("products/%d/%s_thumb.jpg", productID, name),
+		ContentType:  contentType,
+		IsPrimary:    primary,
+	}
+	if err := pr.storage.Save(image.StorageKey, data); err != nil {
+		return models.ProductImage{}, err
+	}
+	if err := pr.storage.Save(image.ThumbnailKey, thumbnail); err != nil {
+		pr.removeImageFiles(image)
+		return models.ProductImage{}, err
+	}
+	image, err = pr.productRepository.AddProductImage(image)
+	if err != nil {
+		pr.removeImageFiles(image)
+		return models.ProductImage{}, err
+	}
+	return pr.imageModel(image), nil
+}
+
+func (pr *productUseCase) ListProductImages(productID int) ([]models.ProductImage, error) {
+	images, err := pr.productRepository.ListProductImages(productID)
+	if err != nil {
+		return nil, err
+	}
+	return pr.imageModels(images), nil
+}
+
+func (pr *productUseCase) SetPrimaryImage(productID, imageID int) ([]models.ProductImage, error) {
+	if err := pr.productRepository.SetPrimaryImage(productID, imageID); err != nil {
+		return nil, err
+	}
+	return pr.ListProductImages(productID)
+}
+
+func (pr *productUseCase) ReorderProductImages(productID int, imageIDs []int) ([]models.ProductImage, error) {
+	if err := pr.productRepository.ReorderProductImages(productID, imageIDs); err != nil {
+		return nil, err
+	}
+	return pr.ListProductImages(productID)
+}
+
+func (pr *productUseCase) DeleteProductImage(productID, imageID int) ([]models.ProductImage, error) {
+	image, err := pr.productRepository.DeleteProductImage(productID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	pr.removeImageFiles(image)
+	return pr.ListProductImages(productID)
+}
+
+// attachImages fills in the images of each listed product.
+func (pr *productUseCase) attachImages(products []models.ProductBrief) error {
+	ids := make([]uint, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+	images, err := pr.productRepository.ImagesForProducts(ids)
+	if err != nil {
+		return err
+	}
+	byProduct := make(map[uint][]models.ProductImage)
+	for _, image := range images {
+		byProduct[image.ProductID] = append(byProduct[image.ProductID], pr.imageModel(image))
+	}
+	for i := range products {
+		products[i].Images = byProduct[products[i].ID]
+	}
+	return nil
+}
+
+// removeImageFiles deletes the stored files of an image. A file left behind
+// only wastes space, so failures are logged rather than returned.
+func (pr *productUseCase) removeImageFiles(image domain.ProductImage) {
+	for _, key := range []string{image.StorageKey, image.ThumbnailKey} {
+		if err := pr.storage.Delete(key); err != nil {
+			log.Printf("removing image file %s: %v", key, err)
+		}
+	}
+}
+
+func (pr *productUseCase) imageModel(image domain.ProductImage) models.ProductImage {
+	return models.ProductImage{
+		ID:           image.ID,
+		ProductID:    image.ProductID,
+		URL:          pr.storage.URL(image.StorageKey),
+		ThumbnailURL: pr.storage.URL(image.ThumbnailKey),
+		Position:     image.Position,
+		IsPrimary:    image.IsPrimary,
+	}
+}
+
+func (pr *productUseCase) imageModels(images []domain.ProductImage) []models.ProductImage {
+	result := []models.ProductImage{}
+	for _, image := range images {
+		result = append(result, pr.imageModel(image))
+	}
+	return result
+}
+
+func randomName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -3,15 +3,48 @@ package interfaceUse
 import (
 	"product-service/pkg/domain"
 	"product-service/pkg/models"
+	"time"
 )
 
 type ProductUseCase interface {
-	ShowAllProducts(page int, count int) ([]models.ProductBrief, error)
+	ShowAllProducts(page int, count int, filter models.ProductFilter, sortBy string) (models.ProductSearchResult, error)
 	AddProducts(product models.Product) (domain.Product, error)
 	DeleteProducts(id int) error
-	UpdateProduct(pid int, stock int) (models.ProductUpdateReciever, error)
+	RestoreProduct(id int) (domain.Product, error)
+	GetProduct(id int) (domain.Product, error)
+	UpdateProduct(pid int, stock int, actor string) (models.ProductUpdateReciever, error)
+	PatchProduct(id int, patch models.Product, paths []string, actor string) (domain.Product, error)
 	GetQuantityFromProductID(id int) (int, error)
 	GetPriceOfProductFromID(prodcut_id int) (float64, error)
-	ProductStockMinus(productID, stock int) error
+	ProductStockMinus(productID, stock int, change models.StockChange) error
+	ProductStockPlus(productID, stock int, change models.StockChange) error
+	ProductStockMinusBulk(items []models.ProductStock, change models.StockChange) error
+	ProductStockPlusBulk(items []models.ProductStock, change models.StockChange) error
+	ReserveStock(reference string, items []models.ProductStock, ttl time.Duration) ([]domain.StockReservation, error)
+	ReleaseStock(reference string, productIDs []int) ([]domain.StockReservation, error)
+	CommitReservation(reference string, productIDs []int) ([]domain.StockReservation, error)
+	ExpireReservations() (int, error)
+	ListStockMovements(productID int, page, count int) ([]domain.InventoryMovement, error)
+	ReconcileStock(productID int, fix bool, actor string) ([]models.StockReconciliation, error)
 	CheckProduct(product_id int) (bool, error)
+	UploadProductImage(productID int, data []byte, primary bool) (models.ProductImage, error)
+	ListProductImages(productID int) ([]models.ProductImage, error)
+	SetPrimaryImage(productID, imageID int) ([]models.ProductImage, error)
+	ReorderProductImages(productID int, imageIDs []int) ([]models.ProductImage, error)
+	DeleteProductImage(productID, imageID int) ([]models.ProductImage, error)
+	SchedulePriceChange(input models.PriceScheduleInput) (domain.PriceSchedule, error)
+	CancelPriceSchedule(id int, actor string) (domain.PriceSchedule, error)
+	ListPriceSchedules(productID int) ([]domain.PriceSchedule, error)
+	ListPriceHistory(productID int, page, count int) ([]domain.PriceHistory, error)
+	ApplyPriceSchedules() (started int, ended int, err error)
+	AddCategory(input models.CategoryInput) (domain.Category, error)
+	UpdateCategory(id int, input models.CategoryInput) (domain.Category, error)
+	DeleteCategory(id int) error
+	ListCategories() ([]domain.Category, error)
+	CategoryTree() ([]models.CategoryNode, error)
+	AddOffer(input models.OfferInput) (domain.Offer, error)
+	UpdateOffer(id int, input models.OfferInput) (domain.Offer, error)
+	ListOffers(page, count int) ([]domain.Offer, error)
+	DeleteOffer(id int) error
+	ProductOffers(productIDs []int) (map[int][]domain.Offer, error)
 }
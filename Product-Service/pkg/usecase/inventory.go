@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+)
+
+func (pr *productUseCase) ListStockMovements(productID int, page, count int) ([]domain.InventoryMovement, error) {
+	return pr.productRepository.ListStockMovements(productID, page, count)
+}
+
+// ReconcileStock recomputes stock from the inventory ledger and returns the
+// products whose stock does not match. With fix set, an adjustment entry is
+// appended for each of them so the ledger agrees with the current stock.
+func (pr *productUseCase) ReconcileStock(productID int, fix bool, actor string) ([]models.StockReconciliation, error) {
+	mismatches, err := pr.productRepository.StockMismatches(productID)
+	if err != nil {
+		return nil, err
+	}
+	if !fix {
+		return mismatches, nil
+	}
+	for _, m := range mismatches {
+		if err := pr.productRepository.RecordAdjustment(m.ProductID, m.Difference, actor); err != nil {
+			return nil, err
+		}
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"fmt"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"strings"
+	"time"
+)
+
+func (pr *productUseCase) AddOffer(input models.OfferInput) (domain.Offer, error) {
+	offer, err := pr.prepareOffer(input)
+	if err != nil {
+		return domain.Offer{}, err
+	}
+	offer.CreatedBy = input.Actor
+	return pr.productRepository.CreateOffer(offer)
+}
+
+func (pr *productUseCase) UpdateOffer(id int, input models.OfferInput) (domain.Offer, error) {
+	if _, err := pr.productRepository.GetOffer(id); err != nil {
+		return domain.Offer{}, err
+	}
+	offer, err := pr.prepareOffer(input)
+	if err != nil {
+		return domain.Offer{}, err
+	}
+	offer.ID = uint(id)
+	return pr.productRepository.UpdateOffer(offer)
+}
+
+func (pr *productUseCase) ListOffers(page, count int) ([]domain.Offer, error) {
+	return pr.productRepository.ListOffers(page, count)
+}
+
+func (pr *productUseCase) DeleteOffer(id int) error {
+	return pr.productRepository.DeleteOffer(id)
+}
+
+// ProductOffers returns the offers in effect now for each of the products.
+func (pr *productUseCase) ProductOffers(productIDs []int) (map[int][]domain.Offer, error) {
+	return pr.productRepository.OffersForProducts(productIDs, time.Now())
+}
+
+func (pr *productUseCase) prepareOffer(input models.OfferInput) (domain.Offer, error) {
+	offer := domain.Offer{
+		Name:          strings.TrimSpace(input.Name),
+		DiscountType:  strings.ToLower(strings.TrimSpace(input.DiscountType)),
+		DiscountValue: input.DiscountValue,
+		ValidFrom:     input.ValidFrom,
+		ValidUntil:    input.ValidUntil,
+		Active:        input.Active,
+	}
+	if offer.Name == "" {
+		return offer, offerError("name is required")
+	}
+	switch {
+	case input.ProductID != 0 && input.CategoryID != 0:
+		return offer, offerError("set either product_id or category_id, not both")
+	case input.ProductID != 0:
+		if _, err := pr.productRepository.GetProduct(int(input.ProductID)); err != nil {
+			return offer, err
+		}
+		offer.ProductID = &input.ProductID
+	case input.CategoryID != 0:
+		if _, err := pr.productRepository.GetCategory(int(input.CategoryID)); err != nil {
+			return offer, err
+		}
+		offer.CategoryID = &input.CategoryID
+	default:
+		return offer, offerError("product_id or category_id is required")
+	}
+	switch offer.DiscountType {
+	case domain.OfferTypePercentage:
+		if offer.DiscountValue <= 0 || offer.DiscountValue > 100 {
+			return offer, offerError("a percentage discount must be between 0 and 100")
+		}
+	case domain.OfferTypeFlat:
+		if offer.DiscountValue <= 0 {
+			return offer, offerError("a flat discount must be positive")
+		}
+	default:
+		return offer, offerError("discount_type must be %q or %q", domain.OfferTypePercentage, domain.OfferTypeFlat)
+	}
+	if offer.ValidFrom.IsZero() {
+		offer.ValidFrom = time.Now()
+	}
+	if offer.ValidUntil != nil && !offer.ValidUntil.After(offer.ValidFrom) {
+		return offer, offerError("valid_until must be after valid_from")
+	}
+	return offer, nil
+}
+
+func offerError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{domain.ErrInvalidOffer}, args...)...)
+}
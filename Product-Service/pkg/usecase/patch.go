@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"fmt"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"strings"
+)
+
+// specFields sets a single laptop spec from a patch, by its update mask name.
+var specFields = map[string]func(dst *domain.LaptopSpecs, src domain.LaptopSpecs){
+	"brand":             func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.Brand = s.Brand },
+	"cpu":               func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.CPU = s.CPU },
+	"ram_gb":            func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.RAMGB = s.RAMGB },
+	"storage_type":      func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.StorageType = s.StorageType },
+	"storage_gb":        func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.StorageGB = s.StorageGB },
+	"gpu":               func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.GPU = s.GPU },
+	"screen_resolution": func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.ScreenResolution = s.ScreenResolution },
+	"weight_kg":         func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.WeightKg = s.WeightKg },
+	"battery_wh":        func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.BatteryWh = s.BatteryWh },
+	"os":                func(d *domain.LaptopSpecs, s domain.LaptopSpecs) { d.OS = s.OS },
+}
+
+// PatchProduct changes the fields of a product named in paths to their value
+// in patch and leaves every other field as it is. Only the named fields are
+// written back, so a patch cannot undo stock changes made while it ran. The
+// product keeps its id, so carts and orders that point at it stay valid.
+func (pr *productUseCase) PatchProduct(id int, patch models.Product, paths []string, actor string) (domain.Product, error) {
+	if len(paths) == 0 {
+		return domain.Product{}, fmt.Errorf("%w: no fields to update", domain.ErrInvalidFieldMask)
+	}
+	product, err := pr.productRepository.GetProduct(id)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	current := product
+
+	var columns []string
+	for _, path := range paths {
+		switch path {
+		case "name":
+			product.Name = strings.TrimSpace(patch.Name)
+		case "description":
+			product.Description = strings.TrimSpace(patch.Description)
+		case "category_id":
+			product.CategoryID = patch.CategoryID
+		case "size":
+			product.Size = patch.Size
+		case "stock":
+			product.Stock = patch.Stock
+		case "price":
+			product.Price = patch.Price
+		case "specs":
+			product.LaptopSpecs = patch.LaptopSpecs
+			for column := range specFields {
+				columns = append(columns, column)
+			}
+			continue
+		default:
+			set, ok := specFields[strings.TrimPrefix(path, "specs.")]
+			if !ok || !strings.HasPrefix(path, "specs.") {
+				return domain.Product{}, fmt.Errorf("%w: unknown field %q", domain.ErrInvalidFieldMask, path)
+			}
+			set(&product.LaptopSpecs, patch.LaptopSpecs)
+			columns = append(columns, strings.TrimPrefix(path, "specs."))
+			continue
+		}
+		columns = append(columns, path)
+	}
+
+	if product.Name == "" {
+		return domain.Product{}, fmt.Errorf("%w: name is required", domain.ErrInvalidProduct)
+	}
+	if product.Description == "" {
+		return domain.Product{}, fmt.Errorf("%w: description is required", domain.ErrInvalidProduct)
+	}
+	if product.Size <= 0 {
+		return domain.Product{}, fmt.Errorf("%w: size must be positive", domain.ErrInvalidProduct)
+	}
+	if product.Stock < 0 {
+		return domain.Product{}, fmt.Errorf("%w: stock cannot be negative", domain.ErrInvalidProduct)
+	}
+	if product.Price <= 0 {
+		return domain.Product{}, fmt.Errorf("%w: price must be positive", domain.ErrInvalidProduct)
+	}
+	if product.Name != current.Name && pr.productRepository.ProductAlreadyExist(product.Name) {
+		return domain.Product{}, domain.ErrProductExists
+	}
+	if product.CategoryID != current.CategoryID {
+		if _, err := pr.productRepository.GetCategory(int(product.CategoryID)); err != nil {
+			return domain.Product{}, err
+		}
+	}
+	if product.LaptopSpecs != current.LaptopSpecs {
+		if product.LaptopSpecs, err = validateSpecs(product.LaptopSpecs); err != nil {
+			return domain.Product{}, err
+		}
+	}
+
+	return pr.productRepository.SaveProduct(product, columns, actor)
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"fmt"
+	"log"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	interfaceUse "product-service/pkg/usecase/interface"
+	"strings"
+	"time"
+)
+
+// SchedulePriceChange schedules a new price for a product. The scheduler
+// applies it at StartsAt and, when the schedule has an end, puts the previous
+// price back at EndsAt.
+func (pr *productUseCase) SchedulePriceChange(input models.PriceScheduleInput) (domain.PriceSchedule, error) {
+	now := time.Now()
+	if input.Price <= 0 {
+		return domain.PriceSchedule{}, fmt.Errorf("%w: price must be positive", domain.ErrInvalidSchedule)
+	}
+	if input.StartsAt.IsZero() {
+		input.StartsAt = now
+	}
+	if input.EndsAt != nil {
+		if !input.EndsAt.After(input.StartsAt) {
+			return domain.PriceSchedule{}, fmt.Errorf("%w: ends_at must be after starts_at", domain.ErrInvalidSchedule)
+		}
+		if !input.EndsAt.After(now) {
+			return domain.PriceSchedule{}, fmt.Errorf("%w: ends_at is in the past", domain.ErrInvalidSchedule)
+		}
+	}
+	return pr.productRepository.CreatePriceSchedule(domain.PriceSchedule{
+		ProductID: uint(input.ProductID),
+		Label:     strings.TrimSpace(input.Label),
+		Price:     input.Price,
+		StartsAt:  input.StartsAt,
+		EndsAt:    input.EndsAt,
+		CreatedBy: input.Actor,
+	})
+}
+
+func (pr *productUseCase) CancelPriceSchedule(id int, actor string) (domain.PriceSchedule, error) {
+	return pr.productRepository.CancelPriceSchedule(id, actor)
+}
+
+func (pr *productUseCase) ListPriceSchedules(productID int) ([]domain.PriceSchedule, error) {
+	return pr.productRepository.ListPriceSchedules(productID)
+}
+
+func (pr *productUseCase) ListPriceHistory(productID int, page, count int) ([]domain.PriceHistory, error) {
+	return pr.productRepository.ListPriceHistory(productID, page, count)
+}
+
+// ApplyPriceSchedules ends the schedules whose period is over and starts the
+// ones that are due, in that order, so a sale that follows another takes
+// over from the restored price.
+func (pr *productUseCase) ApplyPriceSchedules() (started int, ended int, err error) {
+	now := time.Now()
+	if ended, err = pr.productRepository.EndPriceSchedules(now); err != nil {
+		return 0, 0, err
+	}
+	if started, err = pr.productRepository.StartPriceSchedules(now); err != nil {
+		return 0, ended, err
+	}
+	return started, ended, nil
+}
+
+// StartPriceScheduler applies due price schedules every interval. It blocks,
+// so run it in its own goroutine.
+func StartPriceScheduler(useCase interfaceUse.ProductUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		started, ended, err := useCase.ApplyPriceSchedules()
+		if err != nil {
+			log.Println("applying price schedules:", err)
+			continue
+		}
+		if started > 0 || ended > 0 {
+			log.Printf("price schedules: %d started, %d ended", started, ended)
+		}
+	}
+}
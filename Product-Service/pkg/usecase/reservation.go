@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"log"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	interfaceUse "product-service/pkg/usecase/interface"
+	"time"
+)
+
+// DefaultReservationTTL is how long stock stays reserved when the caller does
+// not ask for a specific duration.
+const DefaultReservationTTL = 15 * time.Minute
+
+func (pr *productUseCase) ReserveStock(reference string, items []models.ProductStock, ttl time.Duration) ([]domain.StockReservation, error) {
+	if reference == "" || len(items) == 0 {
+		return nil, domain.ErrInvalidReservation
+	}
+	for _, item := range items {
+		if item.Stock <= 0 {
+			return nil, domain.ErrInvalidReservation
+		}
+	}
+	if ttl <= 0 {
+		ttl = DefaultReservationTTL
+	}
+	return pr.productRepository.ReserveStock(reference, items, time.Now().Add(ttl))
+}
+
+func (pr *productUseCase) ReleaseStock(reference string, productIDs []int) ([]domain.StockReservation, error) {
+	if reference == "" {
+		return nil, domain.ErrInvalidReservation
+	}
+	return pr.productRepository.ReleaseReservations(reference, productIDs)
+}
+
+func (pr *productUseCase) CommitReservation(reference string, productIDs []int) ([]domain.StockReservation, error) {
+	if reference == "" {
+		return nil, domain.ErrInvalidReservation
+	}
+	return pr.productRepository.CommitReservations(reference, productIDs)
+}
+
+func (pr *productUseCase) ExpireReservations() (int, error) {
+	return pr.productRepository.ExpireReservations(time.Now())
+}
+
+// StartReservationSweeper gives back the stock of expired reservations every
+// interval. It blocks, so run it in its own goroutine.
+func StartReservationSweeper(useCase interfaceUse.ProductUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		expired, err := useCase.ExpireReservations()
+		if err != nil {
+			log.Println("expiring stock reservations:", err)
+			continue
+		}
+		if expired > 0 {
+			log.Printf("released %d expired stock reservations", expired)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"errors"
+	"product-service/pkg/domain"
+	"product-service/pkg/models"
+	"product-service/pkg/repository/interfaces"
+	"testing"
+	"time"
+)
+
+// fakeReservationRepository records the reservations the use case asks for.
+// Methods a test does not need panic through the embedded nil interface.
+type fakeReservationRepository struct {
+	interfaces.ProductRepository
+
+	reference string
+	items     []models.ProductStock
+	expiresAt time.Time
+}
+
+func (f *fakeReservationRepository) ReserveStock(reference string, items []models.ProductStock, expiresAt time.Time) ([]domain.StockReservation, error) {
+	f.reference, f.items, f.expiresAt = reference, items, expiresAt
+	return nil, nil
+}
+
+func (f *fakeReservationRepository) ReleaseReservations(reference string, productIDs []int) ([]domain.StockReservation, error) {
+	f.reference = reference
+	return nil, nil
+}
+
+func (f *fakeReservationRepository) CommitReservations(reference string, productIDs []int) ([]domain.StockReservation, error) {
+	f.reference = reference
+	return nil, nil
+}
+
+func TestReserveStock(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference string
+		items     []models.ProductStock
+		ttl       time.Duration
+		wantErr   error
+		wantTTL   time.Duration
+	}{
+		{"held for the asked duration", "cart:1", []models.ProductStock{{ProductID: 1, Stock: 2}}, time.Hour, nil, time.Hour},
+		{"held for the default duration", "cart:1", []models.ProductStock{{ProductID: 1, Stock: 2}}, 0, nil, DefaultReservationTTL},
+		{"no reference", "", []models.ProductStock{{ProductID: 1, Stock: 2}}, time.Hour, domain.ErrInvalidReservation, 0},
+		{"no items", "cart:1", nil, time.Hour, domain.ErrInvalidReservation, 0},
+		{"zero quantity", "cart:1", []models.ProductStock{{ProductID: 1, Stock: 2}, {ProductID: 2}}, time.Hour, domain.ErrInvalidReservation, 0},
+		{"negative quantity", "cart:1", []models.ProductStock{{ProductID: 1, Stock: -2}}, time.Hour, domain.ErrInvalidReservation, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeReservationRepository{}
+			useCase := NewProductUseCase(repo, nil)
+			before := time.Now()
+			_, err := useCase.ReserveStock(tt.reference, tt.items, tt.ttl)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReserveStock error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if repo.reference != "" {
+					t.Errorf("an invalid reservation reached the repository")
+				}
+				return
+			}
+			if repo.reference != tt.reference || len(repo.items) != len(tt.items) {
+				t.Errorf("reserved %q %v, want %q %v", repo.reference, repo.items, tt.reference, tt.items)
+			}
+			if ttl := repo.expiresAt.Sub(before); ttl < tt.wantTTL || ttl > tt.wantTTL+time.Minute {
+				t.Errorf("reservation held for %v, want %v", ttl, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestCloseReservationNeedsReference(t *testing.T) {
+	useCase := NewProductUseCase(&fakeReservationRepository{}, nil)
+	if _, err := useCase.ReleaseStock("", nil); !errors.Is(err, domain.ErrInvalidReservation) {
+		t.Errorf("ReleaseStock error = %v, want %v", err, domain.ErrInvalidReservation)
+	}
+	if _, err := useCase.CommitReservation("", []int{1}); !errors.Is(err, domain.ErrInvalidReservation) {
+		t.Errorf("CommitReservation error = %v, want %v", err, domain.ErrInvalidReservation)
+	}
+}
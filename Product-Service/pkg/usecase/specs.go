@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"fmt"
+	"product-service/pkg/domain"
+	"regexp"
+	"strings"
+)
+
+var resolutionPattern = regexp.MustCompile(`^[1-9][0-9]{2,4}x[1-9][0-9]{2,4}$`)
+
+// validateSpecs checks the specifications of a new laptop and returns them
+// normalised: enumerated values are lower case and a missing GPU is recorded
+// as integrated graphics.
+func validateSpecs(specs domain.LaptopSpecs) (domain.LaptopSpecs, error) {
+	specs.Brand = strings.TrimSpace(specs.Brand)
+	specs.CPU = strings.TrimSpace(specs.CPU)
+	specs.GPU = strings.TrimSpace(specs.GPU)
+	specs.StorageType = strings.ToLower(strings.TrimSpace(specs.StorageType))
+	specs.OS = strings.ToLower(strings.TrimSpace(specs.OS))
+	specs.ScreenResolution = strings.ToLower(strings.TrimSpace(specs.ScreenResolution))
+
+	if specs.Brand == "" {
+		return specs, specError("brand is required")
+	}
+	if specs.CPU == "" {
+		return specs, specError("cpu is required")
+	}
+	if !containsInt(domain.RAMSizesGB, specs.RAMGB) {
+		return specs, specError("ram_gb must be one of %v", domain.RAMSizesGB)
+	}
+	if !containsString(domain.StorageTypes, specs.StorageType) {
+		return specs, specError("storage_type must be one of %v", domain.StorageTypes)
+	}
+	if specs.StorageGB < 16 || specs.StorageGB > 16384 {
+		return specs, specError("storage_gb must be between 16 and 16384")
+	}
+	if specs.GPU == "" {
+		specs.GPU = "integrated"
+	}
+	if !resolutionPattern.MatchString(specs.ScreenResolution) {
+		return specs, specError("screen_resolution must look like 1920x1080")
+	}
+	if specs.WeightKg <= 0 || specs.WeightKg > 10 {
+		return specs, specError("weight_kg must be between 0 and 10")
+	}
+	if specs.BatteryWh <= 0 || specs.BatteryWh > 100 {
+		// 100 Wh is the largest battery airlines allow in the cabin.
+		return specs, specError("battery_wh must be between 0 and 100")
+	}
+	if !containsString(domain.OSTypes, specs.OS) {
+		return specs, specError("os must be one of %v", domain.OSTypes)
+	}
+	return specs, nil
+}
+
+func specError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{domain.ErrInvalidSpecs}, args...)...)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	// Register the decoders of the accepted upload formats.
+	_ "image/gif"
+	_ "image/png"
+)
+
+// thumbnailSize is the largest width or height of a thumbnail.
+const thumbnailSize = 320
+
+// maxImagePixels is the largest image, in pixels, a thumbnail is made of. A
+// small compressed file can decode to a huge image, so the size is checked
+// before the pixels are decoded.
+const maxImagePixels = 40_000_000
+
+// makeThumbnail scales an image down to fit within thumbnailSize, keeping its
+// aspect ratio, and encodes it as JPEG. Transparent areas become white.
+func makeThumbnail(data []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("images may have at most %d megapixels, this one is %dx%d", maxImagePixels/1_000_000, config.Width, config.Height)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	// The image is flattened onto white once, so the scaling below reads
+	// plain RGB bytes instead of converting every pixel through At.
+	b := decoded.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), decoded, b.Min, draw.Over)
+
+	w, h := b.Dx(), b.Dy()
+	if w > thumbnailSize || h > thumbnailSize {
+		if w >= h {
+			w, h = thumbnailSize, max(1, h*thumbnailSize/w)
+		} else {
+			w, h = max(1, w*thumbnailSize/h), thumbnailSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0 := y * b.Dy() / h
+		y1 := max(y0+1, (y+1)*b.Dy()/h)
+		for x := 0; x < w; x++ {
+			x0 := x * b.Dx() / w
+			x1 := max(x0+1, (x+1)*b.Dx()/w)
+			dst.SetRGBA(x, y, averageColor(src, x0, y0, x1, y1))
+		}
+	}
+
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// averageColor is the mean colour of the source pixels in [x0,x1)×[y0,y1).
+func averageColor(src *image.RGBA, x0, y0, x1, y1 int) color.RGBA {
+	var r, g, b, n uint64
+	for y := y0; y < y1; y++ {
+		row := src.Pix[src.PixOffset(x0, y):src.PixOffset(x1, y)]
+		for i := 0; i < len(row); i += 4 {
+			r += uint64(row[i])
+			g += uint64(row[i+1])
+			b += uint64(row[i+2])
+			n++
+		}
+	}
+	return color.RGBA{
+		R: uint8(r / n),
+		G: uint8(g / n),
+		B: uint8(b / n),
+		A: 0xff,
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestMakeThumbnail(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 1000, 500))
+	for y := 0; y < 500; y++ {
+		for x := 0; x < 500; x++ {
+			src.SetNRGBA(x, y, color.NRGBA{R: 0xff, A: 0xff})
+		}
+	}
+	// The right half stays transparent.
+	var data bytes.Buffer
+	if err := png.Encode(&data, src); err != nil {
+		t.Fatal(err)
+	}
+
+	thumbnail, err := makeThumbnail(data.Bytes())
+	if err != nil {
+		t.Fatalf("makeThumbnail: %v", err)
+	}
+	got, err := jpeg.Decode(bytes.NewReader(thumbnail))
+	if err != nil {
+		t.Fatalf("thumbnail is not a JPEG: %v", err)
+	}
+	if size := got.Bounds().Size(); size != image.Pt(thumbnailSize, thumbnailSize/2) {
+		t.Errorf("thumbnail is %v, want %dx%d", size, thumbnailSize, thumbnailSize/2)
+	}
+	tests := []struct {
+		name    string
+		x, y    int
+		r, g, b uint32
+	}{
+		{"opaque area", 40, 80, 0xff, 0, 0},
+		{"transparent area", 280, 80, 0xff, 0xff, 0xff},
+	}
+	for _, tt := range tests {
+		r, g, b, _ := got.At(tt.x, tt.y).RGBA()
+		if !near(r>>8, tt.r) || !near(g>>8, tt.g) || !near(b>>8, tt.b) {
+			t.Errorf("%s = (%d, %d, %d), want about (%d, %d, %d)", tt.name, r>>8, g>>8, b>>8, tt.r, tt.g, tt.b)
+		}
+	}
+}
+
+// TestMakeThumbnailTooLarge checks that an image claiming more than
+// maxImagePixels is refused from its header alone.
+func TestMakeThumbnailTooLarge(t *testing.T) {
+	var data bytes.Buffer
+	if err := gif.Encode(&data, image.NewPaletted(image.Rect(0, 0, 1, 1), color.Palette{color.Black}), nil); err != nil {
+		t.Fatal(err)
+	}
+	header := data.Bytes()
+	// The logical screen size follows the six byte signature.
+	copy(header[6:10], []byte{0xff, 0xff, 0xff, 0xff})
+
+	if _, err := makeThumbnail(header); err == nil {
+		t.Fatal("makeThumbnail accepted a 65535x65535 image")
+	}
+}
+
+// near allows for the rounding of JPEG compression.
+func near(got, want uint32) bool {
+	return got+8 >= want && got <= want+8
+}
@@ -2,25 +2,52 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	"product-service/pkg/domain"
 	"product-service/pkg/models"
 	"product-service/pkg/repository/interfaces"
+	storage "product-service/pkg/storage/interfaces"
 	interfaceUse "product-service/pkg/usecase/interface"
+	"strings"
+	"time"
 )
 
 type productUseCase struct {
 	productRepository interfaces.ProductRepository
+	storage           storage.Storage
 }
 
-func NewProductUseCase(repository interfaces.ProductRepository) interfaceUse.ProductUseCase {
+func NewProductUseCase(repository interfaces.ProductRepository, storage storage.Storage) interfaceUse.ProductUseCase {
 	return &productUseCase{
 		productRepository: repository,
+		storage:           storage,
 	}
 }
-func (pr *productUseCase) ShowAllProducts(page int, count int) ([]models.ProductBrief, error) {
-	productDetails, err := pr.productRepository.ShowAllProducts(page, count)
+
+// ShowAllProducts lists one page of the products matching the filter along
+// with the number of matches and their facet counts. Listings with a search
+// query are sorted by relevance unless another sort is asked for.
+func (pr *productUseCase) ShowAllProducts(page int, count int, filter models.ProductFilter, sortBy string) (models.ProductSearchResult, error) {
+	if sortBy == "" && strings.TrimSpace(filter.Query) != "" {
+		sortBy = domain.SortRelevance
+	}
+	if sortBy != "" && !domain.IsValidSort(sortBy) {
+		return models.ProductSearchResult{}, fmt.Errorf("%w: %q", domain.ErrInvalidSort, sortBy)
+	}
+	productDetails, err := pr.productRepository.ShowAllProducts(page, count, filter, sortBy)
+	if err != nil {
+		return models.ProductSearchResult{}, err
+	}
+	total, err := pr.productRepository.CountProducts(filter)
+	if err != nil {
+		return models.ProductSearchResult{}, err
+	}
+	facets, err := pr.productRepository.ProductFacets(filter)
 	if err != nil {
-		return []models.ProductBrief{}, err
+		return models.ProductSearchResult{}, err
+	}
+	if err := pr.attachImages(productDetails); err != nil {
+		return models.ProductSearchResult{}, err
 	}
 
 	for i := range productDetails {
@@ -32,13 +59,25 @@ func (pr *productUseCase) ShowAllProducts(page int, count int) ([]models.Product
 		}
 	}
 
-	return productDetails, nil
+	return models.ProductSearchResult{
+		Products: productDetails,
+		Total:    total,
+		Facets:   facets,
+	}, nil
 }
 
 func (pr *productUseCase) AddProducts(product models.Product) (domain.Product, error) {
+	specs, err := validateSpecs(product.LaptopSpecs)
+	if err != nil {
+		return domain.Product{}, err
+	}
+	product.LaptopSpecs = specs
+	if _, err := pr.productRepository.GetCategory(int(product.CategoryID)); err != nil {
+		return domain.Product{}, err
+	}
 	exist := pr.productRepository.ProductAlreadyExist(product.Name)
 	if exist {
-		return domain.Product{}, errors.New("product already exist")
+		return domain.Product{}, domain.ErrProductExists
 	}
 	productResponse, err := pr.productRepository.AddProducts(product)
 	if err != nil {
@@ -50,14 +89,29 @@ func (pr *productUseCase) AddProducts(product models.Product) (domain.Product, e
 	}
 	return productResponse, nil
 }
+
+// DeleteProducts archives a product rather than deleting it, so that orders
+// and carts referring to it keep working.
 func (pr *productUseCase) DeleteProducts(id int) error {
-	err := pr.productRepository.DeleteProducts(id)
+	err := pr.productRepository.ArchiveProduct(id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func (pr *productUseCase) UpdateProduct(pid int, stock int) (models.ProductUpdateReciever, error) {
+
+func (pr *productUseCase) RestoreProduct(id int) (domain.Product, error) {
+	if err := pr.productRepository.RestoreProduct(id); err != nil {
+		return domain.Product{}, err
+	}
+	return pr.productRepository.GetProduct(id)
+}
+
+// GetProduct returns a product by id whether or not it is archived.
+func (pr *productUseCase) GetProduct(id int) (domain.Product, error) {
+	return pr.productRepository.GetProduct(id)
+}
+func (pr *productUseCase) UpdateProduct(pid int, stock int, actor string) (models.ProductUpdateReciever, error) {
 	if stock <= 0 {
 		return models.ProductUpdateReciever{}, errors.New("stock doesnot update invalid input")
 	}
@@ -68,7 +122,7 @@ func (pr *productUseCase) UpdateProduct(pid int, stock int) (models.ProductUpdat
 	if !result {
 		return models.ProductUpdateReciever{}, errors.New("there is no product as you mentioned")
 	}
-	newcat, err := pr.productRepository.UpdateProduct(pid, stock)
+	newcat, err := pr.productRepository.UpdateProduct(pid, stock, actor)
 	if err != nil {
 		return models.ProductUpdateReciever{}, err
 	}
@@ -82,19 +136,90 @@ func (pr *productUseCase) GetQuantityFromProductID(id int) (int, error) {
 	}
 	return data, nil
 }
+
+// GetPriceOfProductFromID returns the price of a product right now, taking
+// running price schedules into account.
 func (pr *productUseCase) GetPriceOfProductFromID(prodcut_id int) (float64, error) {
-	data, err := pr.productRepository.GetPriceOfProductFromID(prodcut_id)
+	data, err := pr.productRepository.EffectivePrice(prodcut_id, time.Now())
 	if err != nil {
 		return 0.0, err
 	}
 	return data, nil
 }
 
-func (pr *productUseCase) ProductStockMinus(productID, stock int) error {
-	err := pr.productRepository.ProductStockMinus(productID, stock)
+func (pr *productUseCase) ProductStockMinus(productID, stock int, change models.StockChange) error {
+	change, err := stockChange(change, domain.MovementSale)
+	if err != nil {
+		return err
+	}
+	err = pr.productRepository.ProductStockMinus(productID, stock, change)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (pr *productUseCase) ProductStockPlus(productID, stock int, change models.StockChange) error {
+	change, err := stockChange(change, domain.MovementAdjustment)
+	if err != nil {
+		return err
+	}
+	if stock <= 0 {
+		return errors.New("stock to add back must be positive")
+	}
+	ok, err := pr.productRepository.CheckProduct(productID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("there is no product as you mentioned")
+	}
+	err = pr.productRepository.ProductStockPlus(productID, stock, change)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+func (pr *productUseCase) ProductStockMinusBulk(items []models.ProductStock, change models.StockChange) error {
+	if err := validateStockItems(items); err != nil {
+		return err
+	}
+	change, err := stockChange(change, domain.MovementSale)
+	if err != nil {
+		return err
+	}
+	return pr.productRepository.ProductStockMinusBulk(items, change)
+}
+func (pr *productUseCase) ProductStockPlusBulk(items []models.ProductStock, change models.StockChange) error {
+	if err := validateStockItems(items); err != nil {
+		return err
+	}
+	change, err := stockChange(change, domain.MovementAdjustment)
 	if err != nil {
 		return err
 	}
+	return pr.productRepository.ProductStockPlusBulk(items, change)
+}
+
+// stockChange fills in the default reason of a stock change and checks that
+// the reason is known.
+func stockChange(change models.StockChange, defaultReason string) (models.StockChange, error) {
+	if change.Reason == "" {
+		change.Reason = defaultReason
+	}
+	if !domain.IsValidMovementReason(change.Reason) {
+		return models.StockChange{}, domain.ErrInvalidMovement
+	}
+	return change, nil
+}
+func validateStockItems(items []models.ProductStock) error {
+	if len(items) == 0 {
+		return errors.New("no products given")
+	}
+	for _, item := range items {
+		if item.Stock <= 0 {
+			return errors.New("stock must be positive")
+		}
+	}
 	return nil
 }
 func (pr *productUseCase) CheckProduct(product_id int) (bool, error) {